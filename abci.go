@@ -0,0 +1,20 @@
+package onft
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/OmniFlix/onft/keeper"
+	"github.com/OmniFlix/onft/types"
+)
+
+// EndBlocker settles the auctions that ended in this block and expires
+// lapsed subscriptions
+func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyEndBlocker)
+
+	k.SettleAuctions(ctx)
+	k.ExpireSubscriptions(ctx)
+}
@@ -27,6 +27,7 @@ import (
 	"github.com/OmniFlix/onft"
 	appparams "github.com/OmniFlix/onft/app/params"
 	"github.com/OmniFlix/onft/docs"
+	onftindexer "github.com/OmniFlix/onft/indexer"
 	onftkeeper "github.com/OmniFlix/onft/keeper"
 	onfttypes "github.com/OmniFlix/onft/types"
 	tmjson "github.com/cometbft/cometbft/libs/json"
@@ -161,7 +162,7 @@ var (
 		stakingtypes.NotBondedPoolName: {authtypes.Burner, authtypes.Staking},
 		govtypes.ModuleName:            {authtypes.Burner},
 		ibctransfertypes.ModuleName:    {authtypes.Minter, authtypes.Burner},
-		onfttypes.ModuleName:           nil,
+		onfttypes.ModuleName:           {authtypes.Minter, authtypes.Burner},
 	}
 )
 
@@ -286,6 +287,11 @@ func New(
 		memKeys:           memKeys,
 	}
 
+	// register the onft index streaming service when enabled in app.toml
+	if err := onftindexer.RegisterStreamingService(bApp, appOpts, homePath, keys[onfttypes.StoreKey], appCodec, logger); err != nil {
+		tmos.Exit(fmt.Sprintf("failed to register onft index: %s", err))
+	}
+
 	app.ParamsKeeper = initParamsKeeper(appCodec, cdc, keys[paramstypes.StoreKey], tkeys[paramstypes.TStoreKey])
 
 	govModAddress := authtypes.NewModuleAddress(govtypes.ModuleName).String()
@@ -0,0 +1,116 @@
+package cli
+
+import (
+	"encoding/csv"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/OmniFlix/onft/types"
+)
+
+// AirdropTree is the merkle tree of a mint airdrop, with the proof of every leaf
+type AirdropTree struct {
+	MerkleRoot string        `json:"merkle_root"`
+	Leaves     []AirdropLeaf `json:"leaves"`
+}
+
+// AirdropLeaf holds the data a recipient needs to claim an airdrop
+type AirdropLeaf struct {
+	Address  string          `json:"address"`
+	OnftID   string          `json:"onft_id"`
+	Metadata *types.Metadata `json:"metadata,omitempty"`
+	LeafHash string          `json:"leaf_hash"`
+	Proof    []string        `json:"proof"`
+}
+
+// parseAirdropCSV reads airdrop leaves from csv rows of
+// address,onft_id[,name,description,media_uri,preview_uri]. Rows without
+// metadata columns mint with the airdrop template metadata. A header row
+// starting with "address" is skipped.
+func parseAirdropCSV(r io.Reader) ([]AirdropLeaf, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+
+	var leaves []AirdropLeaf
+	seen := make(map[string]bool)
+	for i, record := range records {
+		if i == 0 && strings.EqualFold(strings.TrimSpace(record[0]), "address") {
+			continue
+		}
+		if len(record) != 2 && len(record) != 6 {
+			return nil, fmt.Errorf("line %d: expected 2 or 6 columns, got %d", i+1, len(record))
+		}
+		address, err := sdk.AccAddressFromBech32(strings.TrimSpace(record[0]))
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", i+1, err)
+		}
+		onftID := strings.ToLower(strings.TrimSpace(record[1]))
+		if err := types.ValidateONFTID(onftID); err != nil {
+			return nil, fmt.Errorf("line %d: %w", i+1, err)
+		}
+		if seen[onftID] {
+			return nil, fmt.Errorf("line %d: duplicate onft id %s", i+1, onftID)
+		}
+		seen[onftID] = true
+
+		var metadata *types.Metadata
+		if len(record) == 6 {
+			metadata = &types.Metadata{
+				Name:        record[2],
+				Description: record[3],
+				MediaURI:    record[4],
+				PreviewURI:  record[5],
+			}
+			if err := types.ValidateMetadata(*metadata); err != nil {
+				return nil, fmt.Errorf("line %d: %w", i+1, err)
+			}
+		}
+		leaves = append(leaves, AirdropLeaf{
+			Address:  address.String(),
+			OnftID:   onftID,
+			Metadata: metadata,
+			LeafHash: hex.EncodeToString(types.AirdropLeafHash(address, onftID, metadata)),
+		})
+	}
+	if len(leaves) == 0 {
+		return nil, fmt.Errorf("no airdrop recipients found")
+	}
+	return leaves, nil
+}
+
+// buildAirdropTree computes the merkle root and the proofs of the leaves
+func buildAirdropTree(leaves []AirdropLeaf) AirdropTree {
+	hashes := make([][]byte, len(leaves))
+	for i, leaf := range leaves {
+		hashes[i], _ = hex.DecodeString(leaf.LeafHash)
+	}
+	root, proofs := types.BuildMerkleTree(hashes)
+	for i := range leaves {
+		leaves[i].Proof = types.EncodeMerkleProof(proofs[i])
+	}
+	return AirdropTree{
+		MerkleRoot: hex.EncodeToString(root),
+		Leaves:     leaves,
+	}
+}
+
+func readAirdropTree(path string) (AirdropTree, error) {
+	var tree AirdropTree
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return tree, err
+	}
+	err = json.Unmarshal(bz, &tree)
+	return tree, err
+}
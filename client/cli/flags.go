@@ -5,30 +5,107 @@ import (
 )
 
 const (
-	FlagName            = "name"
-	FlagDescription     = "description"
-	FlagMediaURI        = "media-uri"
-	FlagPreviewURI      = "preview-uri"
-	FlagData            = "data"
-	FlagNonTransferable = "non-transferable"
-	FlagInExtensible    = "inextensible"
-	FlagRecipient       = "recipient"
-	FlagOwner           = "owner"
-	FlagDenomID         = "denom-id"
-	FlagSchema          = "schema"
-	FlagNsfw            = "nsfw"
-	FlagRoyaltyShare    = "royalty-share"
-	FlagCreationFee     = "creation-fee"
+	FlagName               = "name"
+	FlagDescription        = "description"
+	FlagMediaURI           = "media-uri"
+	FlagPreviewURI         = "preview-uri"
+	FlagData               = "data"
+	FlagNonTransferable    = "non-transferable"
+	FlagInExtensible       = "inextensible"
+	FlagRecipient          = "recipient"
+	FlagOwner              = "owner"
+	FlagDenomID            = "denom-id"
+	FlagSchema             = "schema"
+	FlagNsfw               = "nsfw"
+	FlagRoyaltyShare       = "royalty-share"
+	FlagCreationFee        = "creation-fee"
+	FlagMaxEditions        = "max-editions"
+	FlagONFTID             = "onft-id"
+	FlagSecret             = "secret"
+	FlagSecretHash         = "secret-hash"
+	FlagExpiry             = "expiry"
+	FlagMaxClaims          = "max-claims"
+	FlagCreator            = "creator"
+	FlagOutput             = "output"
+	FlagOfferONFTs         = "offer-onfts"
+	FlagOfferCoins         = "offer-coins"
+	FlagRequestONFTs       = "request-onfts"
+	FlagRequestCoins       = "request-coins"
+	FlagCounterparty       = "counterparty"
+	FlagRoyaltyReceivers   = "royalty-receivers"
+	FlagSeller             = "seller"
+	FlagBuyer              = "buyer"
+	FlagPriceDenom         = "price-denom"
+	FlagAuctionType        = "type"
+	FlagStartPrice         = "start-price"
+	FlagFloorPrice         = "floor-price"
+	FlagMinIncrement       = "min-increment"
+	FlagDecrement          = "decrement"
+	FlagDecrementInterval  = "decrement-interval"
+	FlagExtensionWindow    = "extension-window"
+	FlagStartTime          = "start-time"
+	FlagEndTime            = "end-time"
+	FlagBorrower           = "borrower"
+	FlagLender             = "lender"
+	FlagStatus             = "status"
+	FlagInputs             = "inputs"
+	FlagCost               = "cost"
+	FlagOutputDenomID      = "output-denom-id"
+	FlagMetadataRoot       = "metadata-root"
+	FlagONFTIDs            = "onft-ids"
+	FlagCredential         = "credential"
+	FlagReason             = "reason"
+	FlagHolder             = "holder"
+	FlagRedeemer           = "redeemer"
+	FlagMaxUses            = "max-uses"
+	FlagSubscriptionPeriod = "subscription-period"
+	FlagSubscriptionPrice  = "subscription-price"
+	FlagPeriods            = "periods"
+	FlagMint               = "mint"
+	FlagTransfer           = "transfer"
+	FlagEdit               = "edit"
+	FlagClawback           = "clawback"
+	FlagDenomIDs           = "denom-ids"
+	FlagTransferable       = "transferable"
+	FlagMintedAfter        = "minted-after"
 )
 
 var (
-	FsCreateDenom   = flag.NewFlagSet("", flag.ContinueOnError)
-	FsUpdateDenom   = flag.NewFlagSet("", flag.ContinueOnError)
-	FsTransferDenom = flag.NewFlagSet("", flag.ContinueOnError)
-	FsMintONFT      = flag.NewFlagSet("", flag.ContinueOnError)
-	FsTransferONFT  = flag.NewFlagSet("", flag.ContinueOnError)
-	FsQuerySupply   = flag.NewFlagSet("", flag.ContinueOnError)
-	FsQueryOwner    = flag.NewFlagSet("", flag.ContinueOnError)
+	FsCreateDenom             = flag.NewFlagSet("", flag.ContinueOnError)
+	FsUpdateDenom             = flag.NewFlagSet("", flag.ContinueOnError)
+	FsTransferDenom           = flag.NewFlagSet("", flag.ContinueOnError)
+	FsMintONFT                = flag.NewFlagSet("", flag.ContinueOnError)
+	FsTransferONFT            = flag.NewFlagSet("", flag.ContinueOnError)
+	FsPrintEdition            = flag.NewFlagSet("", flag.ContinueOnError)
+	FsONFTTemplate            = flag.NewFlagSet("", flag.ContinueOnError)
+	FsCreateClaim             = flag.NewFlagSet("", flag.ContinueOnError)
+	FsQueryCreator            = flag.NewFlagSet("", flag.ContinueOnError)
+	FsAirdropTree             = flag.NewFlagSet("", flag.ContinueOnError)
+	FsCreateAirdrop           = flag.NewFlagSet("", flag.ContinueOnError)
+	FsClaimAirdrop            = flag.NewFlagSet("", flag.ContinueOnError)
+	FsCreateSwap              = flag.NewFlagSet("", flag.ContinueOnError)
+	FsQuerySwaps              = flag.NewFlagSet("", flag.ContinueOnError)
+	FsListONFT                = flag.NewFlagSet("", flag.ContinueOnError)
+	FsMakeOffer               = flag.NewFlagSet("", flag.ContinueOnError)
+	FsAcceptOffer             = flag.NewFlagSet("", flag.ContinueOnError)
+	FsQueryListings           = flag.NewFlagSet("", flag.ContinueOnError)
+	FsQueryOffers             = flag.NewFlagSet("", flag.ContinueOnError)
+	FsCreateAuction           = flag.NewFlagSet("", flag.ContinueOnError)
+	FsQueryAuctions           = flag.NewFlagSet("", flag.ContinueOnError)
+	FsQueryLoans              = flag.NewFlagSet("", flag.ContinueOnError)
+	FsQueryFractionalizations = flag.NewFlagSet("", flag.ContinueOnError)
+	FsCreateRecipe            = flag.NewFlagSet("", flag.ContinueOnError)
+	FsQueryRecipes            = flag.NewFlagSet("", flag.ContinueOnError)
+	FsRevealDenom             = flag.NewFlagSet("", flag.ContinueOnError)
+	FsRevokeONFT              = flag.NewFlagSet("", flag.ContinueOnError)
+	FsQueryCredential         = flag.NewFlagSet("", flag.ContinueOnError)
+	FsRedeemONFT              = flag.NewFlagSet("", flag.ContinueOnError)
+	FsRenewONFT               = flag.NewFlagSet("", flag.ContinueOnError)
+	FsPauseDenom              = flag.NewFlagSet("", flag.ContinueOnError)
+	FsClawbackONFT            = flag.NewFlagSet("", flag.ContinueOnError)
+	FsQuerySupply             = flag.NewFlagSet("", flag.ContinueOnError)
+	FsQueryOwner              = flag.NewFlagSet("", flag.ContinueOnError)
+	FsQueryOwnerONFTsV2       = flag.NewFlagSet("", flag.ContinueOnError)
 )
 
 func init() {
@@ -36,11 +113,22 @@ func init() {
 	FsCreateDenom.String(FlagName, "", "Name of the denom")
 	FsCreateDenom.String(FlagDescription, "", "Description for denom")
 	FsCreateDenom.String(FlagPreviewURI, "", "Preview image uri for denom")
-	FsCreateDenom.String(FlagCreationFee, "", "fee amount for creating denom")
+	FsCreateDenom.String(FlagCreationFee, "", "fee amount for creating denom, one of the accepted creation fees. default is the first accepted fee")
+	FsCreateDenom.StringSlice(FlagRoyaltyReceivers, nil, "comma separated royalty receivers as address:weight, weights add up to 1")
+	FsCreateDenom.String(FlagMetadataRoot, "", "metadata root built with build-reveal-tree, creates the denom in unrevealed mode (optional)")
+	FsCreateDenom.String(FlagRedeemer, "", "address redeeming tickets of the denom. default is the denom creator")
+	FsCreateDenom.Bool(FlagCredential, false, "create the denom in credential mode, its onfts are non-transferable and revocable")
+	FsCreateDenom.Duration(FlagSubscriptionPeriod, 0, "period after which onfts of a subscription denom expire unless renewed")
+	FsCreateDenom.String(FlagSubscriptionPrice, "", "price paid to the creator for renewing a subscription onft by one period")
+	FsCreateDenom.Bool(FlagClawback, false, "let the creator claw back or burn onfts of the denom at any time")
 
 	FsUpdateDenom.String(FlagName, "[do-not-modify]", "Name of the denom")
 	FsUpdateDenom.String(FlagDescription, "[do-not-modify]", "Description for denom")
 	FsUpdateDenom.String(FlagPreviewURI, "[do-not-modify]", "Preview image uri for denom")
+	FsUpdateDenom.StringSlice(FlagRoyaltyReceivers, nil, "comma separated royalty receivers as address:weight, replaces the current receivers")
+	FsUpdateDenom.String(FlagRedeemer, "", "address redeeming tickets of the denom, replaces the current redeemer")
+	FsUpdateDenom.Duration(FlagSubscriptionPeriod, 0, "subscription period, replaces the current period")
+	FsUpdateDenom.String(FlagSubscriptionPrice, "", "subscription price per period, replaces the current price")
 
 	FsTransferDenom.String(FlagRecipient, "", "recipient of the denom")
 
@@ -54,8 +142,97 @@ func init() {
 	FsMintONFT.Bool(FlagInExtensible, false, "To mint non-extensisble onft")
 	FsMintONFT.Bool(FlagNsfw, false, "not safe for work flag for onft")
 	FsMintONFT.String(FlagRoyaltyShare, "", "Royalty share value decimal value between 0 and 1")
+	FsMintONFT.Uint64(FlagMaxEditions, 0, "Number of editions that can be printed from the onft")
+	FsMintONFT.Uint64(FlagMaxUses, 0, "Number of times the onft can be redeemed as a ticket")
 
 	FsTransferONFT.String(FlagRecipient, "", "Receiver of the onft. default value is sender address of transaction")
+	FsPrintEdition.String(FlagRecipient, "", "Receiver of the edition. default value is sender address of transaction")
+
+	FsONFTTemplate.String(FlagMediaURI, "", "Media uri of onft")
+	FsONFTTemplate.String(FlagPreviewURI, "", "Preview uri of onft")
+	FsONFTTemplate.String(FlagName, "", "Name of onft")
+	FsONFTTemplate.String(FlagDescription, "", "Description of onft")
+	FsONFTTemplate.String(FlagData, "", "custom data of onft")
+	FsONFTTemplate.Bool(FlagNonTransferable, false, "To mint non-transferable onft")
+	FsONFTTemplate.Bool(FlagInExtensible, false, "To mint non-extensisble onft")
+	FsONFTTemplate.Bool(FlagNsfw, false, "not safe for work flag for onft")
+	FsONFTTemplate.String(FlagRoyaltyShare, "", "Royalty share value decimal value between 0 and 1")
+	FsONFTTemplate.Uint64(FlagMaxUses, 0, "Number of times the onft can be redeemed as a ticket")
+
+	FsCreateClaim.String(FlagONFTID, "", "id of an existing onft to escrow, instead of minting from the template")
+	FsCreateClaim.String(FlagSecret, "", "secret of the claim, hashed locally")
+	FsCreateClaim.String(FlagSecretHash, "", "hex encoded sha256 hash of the claim secret")
+	FsCreateClaim.String(FlagExpiry, "", "expiry time of the claim in RFC3339 format")
+	FsCreateClaim.Uint64(FlagMaxClaims, 1, "maximum number of claims")
+
+	FsQueryCreator.String(FlagCreator, "", "Filter by creator address")
+
+	FsAirdropTree.String(FlagOutput, "", "file to write the tree to. default is stdout")
+	FsCreateAirdrop.String(FlagExpiry, "", "expiry time of the airdrop in RFC3339 format")
+	FsCreateSwap.StringSlice(FlagOfferONFTs, nil, "comma separated onfts to offer as denom-id/onft-id")
+	FsCreateSwap.String(FlagOfferCoins, "", "coins to offer")
+	FsCreateSwap.StringSlice(FlagRequestONFTs, nil, "comma separated onfts to request as denom-id/onft-id")
+	FsCreateSwap.String(FlagRequestCoins, "", "coins to request")
+	FsCreateSwap.String(FlagCounterparty, "", "the only address that can accept the swap (optional)")
+	FsCreateSwap.String(FlagExpiry, "", "expiry time of the swap in RFC3339 format (optional)")
+
+	FsQuerySwaps.String(FlagCreator, "", "Filter by creator address")
+	FsQuerySwaps.String(FlagCounterparty, "", "Filter by counterparty address")
+
+	FsListONFT.String(FlagExpiry, "", "expiry time of the listing in RFC3339 format (optional)")
+	FsMakeOffer.String(FlagONFTID, "", "id of the onft, offers without an onft id are valid for any onft of the denom")
+	FsMakeOffer.String(FlagExpiry, "", "expiry time of the offer in RFC3339 format (optional)")
+	FsAcceptOffer.String(FlagONFTID, "", "id of the onft to sell, required for collection offers")
+
+	FsQueryListings.String(FlagDenomID, "", "Filter by denom id")
+	FsQueryListings.String(FlagSeller, "", "Filter by seller address")
+	FsQueryListings.String(FlagPriceDenom, "", "Filter by price denom, sorts listings by price")
+	FsQueryOffers.String(FlagDenomID, "", "Filter by denom id")
+	FsQueryOffers.String(FlagONFTID, "", "Filter by onft id")
+	FsQueryOffers.String(FlagBuyer, "", "Filter by buyer address")
+
+	FsCreateAuction.String(FlagAuctionType, "english", "type of the auction, english or dutch")
+	FsCreateAuction.String(FlagStartPrice, "", "reserve price of an english auction or start price of a dutch auction")
+	FsCreateAuction.String(FlagFloorPrice, "", "lowest price of a dutch auction")
+	FsCreateAuction.String(FlagMinIncrement, "0", "minimum amount a bid must add to the highest bid of an english auction")
+	FsCreateAuction.String(FlagDecrement, "0", "amount the price of a dutch auction drops every decrement interval")
+	FsCreateAuction.Duration(FlagDecrementInterval, 0, "interval between price drops of a dutch auction")
+	FsCreateAuction.Duration(FlagExtensionWindow, 0, "bids within this window before the end extend an english auction by the window")
+	FsCreateAuction.String(FlagStartTime, "", "start time of the auction in RFC3339 format. default is the current block time")
+	FsCreateAuction.String(FlagEndTime, "", "end time of the auction in RFC3339 format")
+
+	FsQueryAuctions.String(FlagStatus, "", "Filter by status: active, sold, unsold or cancelled")
+	FsQueryAuctions.String(FlagSeller, "", "Filter by seller address")
+	FsQueryAuctions.String(FlagDenomID, "", "Filter by denom id")
+
+	FsQueryLoans.String(FlagBorrower, "", "Filter by borrower address")
+	FsQueryLoans.String(FlagLender, "", "Filter by lender address")
+	FsQueryLoans.String(FlagStatus, "", "Filter by status: requested or active")
+
+	FsQueryFractionalizations.String(FlagDenomID, "", "Filter by denom id")
+	FsQueryFractionalizations.String(FlagOwner, "", "Filter by the address that fractionalized the oNFT")
+
+	FsCreateRecipe.String(FlagInputs, "", "comma separated recipe inputs as denom-id:count[:key=value;key=value]")
+	FsCreateRecipe.String(FlagCost, "", "coins paid to the recipe creator on every execution (optional)")
+	FsQueryRecipes.String(FlagOutputDenomID, "", "Filter by output denom id")
+	FsRedeemONFT.String(FlagRedeemer, "", "redeemer co-signing the redemption of the owner. the sender is the redeemer when not set")
+	FsRenewONFT.Uint64(FlagPeriods, 1, "number of periods to renew the subscription for")
+	FsPauseDenom.Bool(FlagMint, false, "select minting")
+	FsPauseDenom.Bool(FlagTransfer, false, "select transfers")
+	FsPauseDenom.Bool(FlagEdit, false, "select edits")
+	FsClawbackONFT.String(FlagRecipient, "", "address receiving the onft. the onft is burned when not set")
+	FsRevokeONFT.String(FlagReason, "", "reason of the revocation")
+	FsQueryCredential.String(FlagHolder, "", "address expected to hold the credential (optional)")
+	FsRevealDenom.StringSlice(FlagONFTIDs, nil, "comma separated ids of the remaining unrevealed onfts to reveal from the tree")
+
+	FsClaimAirdrop.String(FlagONFTID, "", "id of the onft to claim, required when the address has several leaves")
+
 	FsQuerySupply.String(FlagOwner, "", "The owner of a nft")
 	FsQueryOwner.String(FlagDenomID, "", "id of the denom")
+
+	FsQueryOwnerONFTsV2.StringSlice(FlagDenomIDs, nil, "comma separated denom ids to filter by")
+	FsQueryOwnerONFTsV2.String(FlagNsfw, "", "Filter by nsfw: true or false")
+	FsQueryOwnerONFTsV2.String(FlagTransferable, "", "Filter by transferable: true or false")
+	FsQueryOwnerONFTsV2.String(FlagCreator, "", "Filter by denom creator address")
+	FsQueryOwnerONFTsV2.String(FlagMintedAfter, "", "Only include onfts minted after this time in RFC3339 format")
 }
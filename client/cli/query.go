@@ -3,7 +3,9 @@ package cli
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"strings"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -29,6 +31,36 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQuerySupply(),
 		GetCmdQueryONFT(),
 		GetCmdQueryOwner(),
+		GetCmdQueryEditions(),
+		GetCmdQueryEditionSupply(),
+		GetCmdQueryClaim(),
+		GetCmdQueryClaims(),
+		GetCmdQueryAirdrop(),
+		GetCmdQueryAirdrops(),
+		GetCmdQueryAirdropClaimed(),
+		GetCmdQuerySwap(),
+		GetCmdQuerySwaps(),
+		GetCmdQueryListing(),
+		GetCmdQueryListings(),
+		GetCmdQueryOffer(),
+		GetCmdQueryOffers(),
+		GetCmdQueryAuction(),
+		GetCmdQueryAuctions(),
+		GetCmdQueryBids(),
+		GetCmdQueryLoan(),
+		GetCmdQueryLoans(),
+		GetCmdQueryFractionalization(),
+		GetCmdQueryFractionalizations(),
+		GetCmdQueryTokenAccount(),
+		GetCmdQueryRecipe(),
+		GetCmdQueryRecipes(),
+		GetCmdQueryMetadataCommitment(),
+		GetCmdQueryCredential(),
+		GetCmdQueryRedemptionStatus(),
+		GetCmdQueryExpiry(),
+		GetCmdQueryRenewals(),
+		GetCmdQueryFeeExemptions(),
+		GetCmdQueryOwnerONFTsV2(),
 		GetCmdQueryParams(),
 	)
 
@@ -272,28 +304,1222 @@ $ %s query onft asset <denom> <onft-id>`, version.AppName)),
 	return cmd
 }
 
+func GetCmdQueryEditions() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "editions [denom-id] [master-id]",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the editions printed from a master oNFT
+Example:
+$ %s query onft editions <denom-id> <master-id>`, version.AppName)),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadPersistentCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+			pagination, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			resp, err := queryClient.Editions(context.Background(), &types.QueryEditionsRequest{
+				DenomId:    args[0],
+				MasterId:   args[1],
+				Pagination: pagination,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(resp)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "editions")
+
+	return cmd
+}
+
+func GetCmdQueryEditionSupply() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "edition-supply [denom-id] [master-id]",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the printed and remaining editions of a master oNFT
+Example:
+$ %s query onft edition-supply <denom-id> <master-id>`, version.AppName)),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadPersistentCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			resp, err := queryClient.EditionSupply(context.Background(), &types.QueryEditionSupplyRequest{
+				DenomId:  args[0],
+				MasterId: args[1],
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(resp)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetCmdQueryClaim() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "claim [claim-id]",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query a claim by id
+Example:
+$ %s query onft claim <claim-id>`, version.AppName)),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadPersistentCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+			claimId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			resp, err := queryClient.Claim(context.Background(), &types.QueryClaimRequest{
+				Id: claimId,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(resp.Claim)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetCmdQueryClaims() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "claims",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query all claims, optionally filtered by creator
+Example:
+$ %s query onft claims --creator=<creator>`, version.AppName)),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadPersistentCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+			creator, err := cmd.Flags().GetString(FlagCreator)
+			if err != nil {
+				return err
+			}
+			if len(creator) > 0 {
+				if _, err := sdk.AccAddressFromBech32(creator); err != nil {
+					return err
+				}
+			}
+			pagination, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			resp, err := queryClient.Claims(context.Background(), &types.QueryClaimsRequest{
+				Creator:    creator,
+				Pagination: pagination,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(resp)
+		},
+	}
+	cmd.Flags().AddFlagSet(FsQueryCreator)
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "claims")
+
+	return cmd
+}
+
+func GetCmdQueryAirdrop() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "airdrop [airdrop-id]",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query a mint airdrop by id
+Example:
+$ %s query onft airdrop <airdrop-id>`, version.AppName)),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadPersistentCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+			airdropId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			resp, err := queryClient.Airdrop(context.Background(), &types.QueryAirdropRequest{
+				Id: airdropId,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(resp.Airdrop)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetCmdQueryAirdrops() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "airdrops",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query all mint airdrops, optionally filtered by creator
+Example:
+$ %s query onft airdrops --creator=<creator>`, version.AppName)),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadPersistentCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+			creator, err := cmd.Flags().GetString(FlagCreator)
+			if err != nil {
+				return err
+			}
+			if len(creator) > 0 {
+				if _, err := sdk.AccAddressFromBech32(creator); err != nil {
+					return err
+				}
+			}
+			pagination, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			resp, err := queryClient.Airdrops(context.Background(), &types.QueryAirdropsRequest{
+				Creator:    creator,
+				Pagination: pagination,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(resp)
+		},
+	}
+	cmd.Flags().AddFlagSet(FsQueryCreator)
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "airdrops")
+
+	return cmd
+}
+
+func GetCmdQueryAirdropClaimed() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "airdrop-claimed [airdrop-id] [leaf-hash]",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query whether a leaf of a mint airdrop is claimed
+Example:
+$ %s query onft airdrop-claimed <airdrop-id> <leaf-hash>`, version.AppName)),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadPersistentCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+			airdropId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			resp, err := queryClient.AirdropClaimed(context.Background(), &types.QueryAirdropClaimedRequest{
+				Id:       airdropId,
+				LeafHash: args[1],
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(resp)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetCmdQuerySwap() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "swap [swap-id]",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query a swap by id
+Example:
+$ %s query onft swap <swap-id>`, version.AppName)),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadPersistentCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+			swapId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			resp, err := queryClient.Swap(context.Background(), &types.QuerySwapRequest{
+				Id: swapId,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(resp.Swap)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetCmdQuerySwaps() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "swaps",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query open swaps, optionally filtered by creator and counterparty
+Example:
+$ %s query onft swaps --creator=<creator> --counterparty=<counterparty>`, version.AppName)),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadPersistentCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+			creator, err := cmd.Flags().GetString(FlagCreator)
+			if err != nil {
+				return err
+			}
+			counterparty, err := cmd.Flags().GetString(FlagCounterparty)
+			if err != nil {
+				return err
+			}
+			pagination, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			resp, err := queryClient.Swaps(context.Background(), &types.QuerySwapsRequest{
+				Creator:      creator,
+				Counterparty: counterparty,
+				Pagination:   pagination,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(resp)
+		},
+	}
+	cmd.Flags().AddFlagSet(FsQuerySwaps)
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "swaps")
+
+	return cmd
+}
+
+func GetCmdQueryListing() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "listing [listing-id]",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query a listing by id
+Example:
+$ %s query onft listing <listing-id>`, version.AppName)),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadPersistentCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+			listingId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			resp, err := queryClient.Listing(context.Background(), &types.QueryListingRequest{
+				Id: listingId,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(resp.Listing)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetCmdQueryListings() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "listings",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query active listings, optionally filtered by denom, seller and price denom.
+Listings filtered by price denom are sorted by price.
+Example:
+$ %s query onft listings --denom-id=<denom-id> --seller=<seller> --price-denom=uflix`, version.AppName)),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadPersistentCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+			denomId, err := cmd.Flags().GetString(FlagDenomID)
+			if err != nil {
+				return err
+			}
+			seller, err := cmd.Flags().GetString(FlagSeller)
+			if err != nil {
+				return err
+			}
+			priceDenom, err := cmd.Flags().GetString(FlagPriceDenom)
+			if err != nil {
+				return err
+			}
+			pagination, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			resp, err := queryClient.Listings(context.Background(), &types.QueryListingsRequest{
+				DenomId:    denomId,
+				Seller:     seller,
+				PriceDenom: priceDenom,
+				Pagination: pagination,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(resp)
+		},
+	}
+	cmd.Flags().AddFlagSet(FsQueryListings)
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "listings")
+
+	return cmd
+}
+
+func GetCmdQueryOffer() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "offer [offer-id]",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query an offer by id
+Example:
+$ %s query onft offer <offer-id>`, version.AppName)),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadPersistentCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+			offerId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			resp, err := queryClient.Offer(context.Background(), &types.QueryOfferRequest{
+				Id: offerId,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(resp.Offer)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetCmdQueryOffers() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "offers",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query open offers, optionally filtered by denom, onft and buyer
+Example:
+$ %s query onft offers --denom-id=<denom-id> --onft-id=<onft-id> --buyer=<buyer>`, version.AppName)),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadPersistentCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+			denomId, err := cmd.Flags().GetString(FlagDenomID)
+			if err != nil {
+				return err
+			}
+			onftId, err := cmd.Flags().GetString(FlagONFTID)
+			if err != nil {
+				return err
+			}
+			buyer, err := cmd.Flags().GetString(FlagBuyer)
+			if err != nil {
+				return err
+			}
+			pagination, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			resp, err := queryClient.Offers(context.Background(), &types.QueryOffersRequest{
+				DenomId:    denomId,
+				OnftId:     onftId,
+				Buyer:      buyer,
+				Pagination: pagination,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(resp)
+		},
+	}
+	cmd.Flags().AddFlagSet(FsQueryOffers)
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "offers")
+
+	return cmd
+}
+
+func GetCmdQueryAuction() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "auction [auction-id]",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query an auction and its current price by id
+Example:
+$ %s query onft auction <auction-id>`, version.AppName)),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadPersistentCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+			auctionId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			resp, err := queryClient.Auction(context.Background(), &types.QueryAuctionRequest{
+				Id: auctionId,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(resp)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetCmdQueryAuctions() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "auctions",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query auctions, optionally filtered by status, seller and denom
+Example:
+$ %s query onft auctions --status=active --seller=<seller> --denom-id=<denom-id>`, version.AppName)),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadPersistentCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+			statusStr, err := cmd.Flags().GetString(FlagStatus)
+			if err != nil {
+				return err
+			}
+			status, err := types.ParseAuctionStatus(statusStr)
+			if err != nil {
+				return err
+			}
+			seller, err := cmd.Flags().GetString(FlagSeller)
+			if err != nil {
+				return err
+			}
+			denomId, err := cmd.Flags().GetString(FlagDenomID)
+			if err != nil {
+				return err
+			}
+			pagination, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			resp, err := queryClient.Auctions(context.Background(), &types.QueryAuctionsRequest{
+				Status:     status,
+				Seller:     seller,
+				DenomId:    denomId,
+				Pagination: pagination,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(resp)
+		},
+	}
+	cmd.Flags().AddFlagSet(FsQueryAuctions)
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "auctions")
+
+	return cmd
+}
+
+func GetCmdQueryBids() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "bids [auction-id]",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the bid history of an auction
+Example:
+$ %s query onft bids <auction-id>`, version.AppName)),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadPersistentCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+			auctionId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			pagination, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			resp, err := queryClient.Bids(context.Background(), &types.QueryBidsRequest{
+				AuctionId:  auctionId,
+				Pagination: pagination,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(resp)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "bids")
+
+	return cmd
+}
+
+func GetCmdQueryLoan() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "loan [loan-id]",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query a loan by id
+Example:
+$ %s query onft loan <loan-id>`, version.AppName)),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadPersistentCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+			loanId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			resp, err := queryClient.Loan(context.Background(), &types.QueryLoanRequest{
+				Id: loanId,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(resp.Loan)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetCmdQueryLoans() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "loans",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query open loans, optionally filtered by borrower, lender and status
+Example:
+$ %s query onft loans --borrower=<borrower> --lender=<lender> --status=active`, version.AppName)),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadPersistentCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+			borrower, err := cmd.Flags().GetString(FlagBorrower)
+			if err != nil {
+				return err
+			}
+			lender, err := cmd.Flags().GetString(FlagLender)
+			if err != nil {
+				return err
+			}
+			statusStr, err := cmd.Flags().GetString(FlagStatus)
+			if err != nil {
+				return err
+			}
+			status, err := types.ParseLoanStatus(statusStr)
+			if err != nil {
+				return err
+			}
+			pagination, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			resp, err := queryClient.Loans(context.Background(), &types.QueryLoansRequest{
+				Borrower:   borrower,
+				Lender:     lender,
+				Status:     status,
+				Pagination: pagination,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(resp)
+		},
+	}
+	cmd.Flags().AddFlagSet(FsQueryLoans)
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "loans")
+
+	return cmd
+}
+
 // GetCmdQueryParams implements the query params command.
 func GetCmdQueryParams() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "params",
-		Args:  cobra.NoArgs,
-		Short: "Query oNFT params",
-		RunE: func(cmd *cobra.Command, _ []string) error {
-			clientCtx, err := client.GetClientQueryContext(cmd)
+		Use:   "params",
+		Args:  cobra.NoArgs,
+		Short: "Query oNFT params",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Params(cmd.Context(), &types.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.Params)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetCmdQueryFractionalization() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "fractionalization [denom-id] [onft-id]",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the fractionalization of an oNFT
+Example:
+$ %s query onft fractionalization <denom-id> <onft-id>`, version.AppName)),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadPersistentCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			resp, err := queryClient.Fractionalization(context.Background(), &types.QueryFractionalizationRequest{
+				DenomId: strings.TrimSpace(args[0]),
+				OnftId:  strings.TrimSpace(args[1]),
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(resp.Fractionalization)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetCmdQueryFractionalizations() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "fractionalizations",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query fractionalized oNFTs, optionally filtered by denom id and owner
+Example:
+$ %s query onft fractionalizations --denom-id=<denom-id> --owner=<owner>`, version.AppName)),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadPersistentCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+			denomId, err := cmd.Flags().GetString(FlagDenomID)
+			if err != nil {
+				return err
+			}
+			owner, err := cmd.Flags().GetString(FlagOwner)
+			if err != nil {
+				return err
+			}
+			pagination, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			resp, err := queryClient.Fractionalizations(context.Background(), &types.QueryFractionalizationsRequest{
+				DenomId:    strings.TrimSpace(denomId),
+				Owner:      owner,
+				Pagination: pagination,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(resp)
+		},
+	}
+	cmd.Flags().AddFlagSet(FsQueryFractionalizations)
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "fractionalizations")
+
+	return cmd
+}
+
+func GetCmdQueryTokenAccount() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "token-account [denom-id] [onft-id]",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the token account address and balances of an oNFT
+Example:
+$ %s query onft token-account <denom-id> <onft-id>`, version.AppName)),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadPersistentCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			resp, err := queryClient.TokenAccount(context.Background(), &types.QueryTokenAccountRequest{
+				DenomId: strings.TrimSpace(args[0]),
+				OnftId:  strings.TrimSpace(args[1]),
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(resp)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetCmdQueryRecipe() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "recipe [recipe-id]",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query a recipe by id
+Example:
+$ %s query onft recipe <recipe-id>`, version.AppName)),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadPersistentCommandFlags(clientCtx, cmd.Flags())
 			if err != nil {
 				return err
 			}
+			recipeId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
 			queryClient := types.NewQueryClient(clientCtx)
+			resp, err := queryClient.Recipe(context.Background(), &types.QueryRecipeRequest{
+				Id: recipeId,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(resp.Recipe)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
 
-			res, err := queryClient.Params(cmd.Context(), &types.QueryParamsRequest{})
+	return cmd
+}
+
+func GetCmdQueryRecipes() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "recipes",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query recipes, optionally filtered by output denom id
+Example:
+$ %s query onft recipes --output-denom-id=<denom-id>`, version.AppName)),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadPersistentCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+			outputDenomId, err := cmd.Flags().GetString(FlagOutputDenomID)
+			if err != nil {
+				return err
+			}
+			pagination, err := client.ReadPageRequest(cmd.Flags())
 			if err != nil {
 				return err
 			}
 
-			return clientCtx.PrintProto(&res.Params)
+			queryClient := types.NewQueryClient(clientCtx)
+			resp, err := queryClient.Recipes(context.Background(), &types.QueryRecipesRequest{
+				OutputDenomId: strings.ToLower(strings.TrimSpace(outputDenomId)),
+				Pagination:    pagination,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(resp)
+		},
+	}
+	cmd.Flags().AddFlagSet(FsQueryRecipes)
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "recipes")
+
+	return cmd
+}
+
+func GetCmdQueryMetadataCommitment() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "metadata-commitment [denom-id]",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the metadata commitment and reveal status of an unrevealed denom
+Example:
+$ %s query onft metadata-commitment <denom-id>`, version.AppName)),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadPersistentCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			resp, err := queryClient.MetadataCommitment(context.Background(), &types.QueryMetadataCommitmentRequest{
+				DenomId: strings.ToLower(strings.TrimSpace(args[0])),
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(resp.Commitment)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetCmdQueryCredential() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "credential [denom-id] [onft-id]",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Check if a credential oNFT is valid, optionally that it is held by an address
+Example:
+$ %s query onft credential <denom-id> <onft-id> --holder=<holder>`, version.AppName)),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadPersistentCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+			holder, err := cmd.Flags().GetString(FlagHolder)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			resp, err := queryClient.IsValidCredential(context.Background(), &types.QueryIsValidCredentialRequest{
+				DenomId: strings.TrimSpace(args[0]),
+				OnftId:  strings.TrimSpace(args[1]),
+				Holder:  holder,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(resp)
+		},
+	}
+	cmd.Flags().AddFlagSet(FsQueryCredential)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetCmdQueryRedemptionStatus() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "redemption-status [denom-id] [onft-id]",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the uses and remaining redemptions of a ticket oNFT
+Example:
+$ %s query onft redemption-status <denom-id> <onft-id>`, version.AppName)),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadPersistentCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			resp, err := queryClient.RedemptionStatus(context.Background(), &types.QueryRedemptionStatusRequest{
+				DenomId: strings.TrimSpace(args[0]),
+				OnftId:  strings.TrimSpace(args[1]),
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(resp)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetCmdQueryExpiry() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "expiry [denom-id] [onft-id]",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the expiry of a subscription oNFT and if it has lapsed
+Example:
+$ %s query onft expiry <denom-id> <onft-id>`, version.AppName)),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadPersistentCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			resp, err := queryClient.Expiry(context.Background(), &types.QueryExpiryRequest{
+				DenomId: strings.TrimSpace(args[0]),
+				OnftId:  strings.TrimSpace(args[1]),
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(resp)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetCmdQueryRenewals() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "renewals [denom-id] [onft-id]",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the renewal payments of a subscription oNFT
+Example:
+$ %s query onft renewals <denom-id> <onft-id>`, version.AppName)),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadPersistentCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+			pagination, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			resp, err := queryClient.RenewalHistory(context.Background(), &types.QueryRenewalHistoryRequest{
+				DenomId:    strings.ToLower(strings.TrimSpace(args[0])),
+				OnftId:     strings.ToLower(strings.TrimSpace(args[1])),
+				Pagination: pagination,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(resp)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "renewals")
+
+	return cmd
+}
+
+func GetCmdQueryFeeExemptions() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "fee-exemptions",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the denom creation fee exemptions granted by governance
+Example:
+$ %s query onft fee-exemptions`, version.AppName)),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadPersistentCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+			pagination, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			resp, err := queryClient.FeeExemptions(context.Background(), &types.QueryFeeExemptionsRequest{
+				Pagination: pagination,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(resp)
 		},
 	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "fee exemptions")
+
+	return cmd
+}
+
+// GetCmdQueryOwnerONFTsV2 queries the onfts of an owner across denoms
+func GetCmdQueryOwnerONFTsV2() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "owner-onfts [address]",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the onfts of an owner across denoms, optionally filtered by denom, flags, creator and mint time
+Example:
+$ %s query onft owner-onfts <address> --denom-ids=<denom-id>,<denom-id> --nsfw=false --transferable=true --minted-after=2024-01-01T00:00:00Z --reverse`, version.AppName)),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadPersistentCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+			if _, err := sdk.AccAddressFromBech32(args[0]); err != nil {
+				return err
+			}
+			denomIds, err := cmd.Flags().GetStringSlice(FlagDenomIDs)
+			if err != nil {
+				return err
+			}
+			nsfwStr, err := cmd.Flags().GetString(FlagNsfw)
+			if err != nil {
+				return err
+			}
+			nsfw, err := types.ParseBoolFilter(nsfwStr)
+			if err != nil {
+				return err
+			}
+			transferableStr, err := cmd.Flags().GetString(FlagTransferable)
+			if err != nil {
+				return err
+			}
+			transferable, err := types.ParseBoolFilter(transferableStr)
+			if err != nil {
+				return err
+			}
+			creator, err := cmd.Flags().GetString(FlagCreator)
+			if err != nil {
+				return err
+			}
+			var mintedAfter *time.Time
+			mintedAfterTime, err := timeFromFlag(cmd, FlagMintedAfter)
+			if err != nil {
+				return err
+			}
+			if !mintedAfterTime.IsZero() {
+				mintedAfter = &mintedAfterTime
+			}
+			pagination, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
 
+			queryClient := types.NewQueryClient(clientCtx)
+			resp, err := queryClient.OwnerONFTsV2(context.Background(), &types.QueryOwnerONFTsV2Request{
+				Owner:        args[0],
+				DenomIds:     denomIds,
+				Nsfw:         nsfw,
+				Transferable: transferable,
+				Creator:      creator,
+				MintedAfter:  mintedAfter,
+				Pagination:   pagination,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(resp)
+		},
+	}
+	cmd.Flags().AddFlagSet(FsQueryOwnerONFTsV2)
 	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "owner onfts")
+
 	return cmd
 }
@@ -0,0 +1,98 @@
+package cli
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/OmniFlix/onft/types"
+)
+
+// RevealTree is the merkle tree over the final metadata of an unrevealed
+// denom, with the proof of every oNFT
+type RevealTree struct {
+	MetadataRoot string       `json:"metadata_root"`
+	Leaves       []RevealLeaf `json:"leaves"`
+}
+
+// RevealLeaf holds the final metadata of an oNFT and the proof to reveal it
+type RevealLeaf struct {
+	OnftID   string         `json:"onft_id"`
+	Metadata types.Metadata `json:"metadata"`
+	Data     string         `json:"data,omitempty"`
+	LeafHash string         `json:"leaf_hash"`
+	Proof    []string       `json:"proof"`
+}
+
+// parseRevealLeaves reads the final metadata of oNFTs from a json array of
+// {"onft_id", "metadata", "data"} objects
+func parseRevealLeaves(bz []byte) ([]RevealLeaf, error) {
+	var leaves []RevealLeaf
+	if err := json.Unmarshal(bz, &leaves); err != nil {
+		return nil, err
+	}
+	if len(leaves) == 0 {
+		return nil, fmt.Errorf("no onfts found")
+	}
+	seen := make(map[string]bool)
+	for i := range leaves {
+		leaf := &leaves[i]
+		leaf.OnftID = strings.ToLower(strings.TrimSpace(leaf.OnftID))
+		if err := types.ValidateONFTID(leaf.OnftID); err != nil {
+			return nil, fmt.Errorf("entry %d: %w", i+1, err)
+		}
+		if seen[leaf.OnftID] {
+			return nil, fmt.Errorf("entry %d: duplicate onft id %s", i+1, leaf.OnftID)
+		}
+		seen[leaf.OnftID] = true
+		if err := types.ValidateMetadata(leaf.Metadata); err != nil {
+			return nil, fmt.Errorf("entry %d: %w", i+1, err)
+		}
+		leaf.LeafHash = hex.EncodeToString(types.RevealLeafHash(leaf.OnftID, leaf.Metadata, leaf.Data))
+	}
+	return leaves, nil
+}
+
+// buildRevealTree computes the metadata root and the proofs of the leaves
+func buildRevealTree(leaves []RevealLeaf) RevealTree {
+	hashes := make([][]byte, len(leaves))
+	for i, leaf := range leaves {
+		hashes[i], _ = hex.DecodeString(leaf.LeafHash)
+	}
+	root, proofs := types.BuildMerkleTree(hashes)
+	for i := range leaves {
+		leaves[i].Proof = types.EncodeMerkleProof(proofs[i])
+	}
+	return RevealTree{
+		MetadataRoot: hex.EncodeToString(root),
+		Leaves:       leaves,
+	}
+}
+
+func readRevealTree(path string) (RevealTree, error) {
+	var tree RevealTree
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return tree, err
+	}
+	err = json.Unmarshal(bz, &tree)
+	return tree, err
+}
+
+// revealedONFT returns the oNFT of the tree with the given id
+func (t RevealTree) revealedONFT(onftID string) (types.RevealedONFT, error) {
+	onftID = strings.ToLower(strings.TrimSpace(onftID))
+	for _, leaf := range t.Leaves {
+		if leaf.OnftID == onftID {
+			return types.RevealedONFT{
+				Id:       leaf.OnftID,
+				Metadata: leaf.Metadata,
+				Data:     leaf.Data,
+				Proof:    leaf.Proof,
+			}, nil
+		}
+	}
+	return types.RevealedONFT{}, fmt.Errorf("onft %s not found in the reveal tree", onftID)
+}
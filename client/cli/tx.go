@@ -1,8 +1,13 @@
 package cli
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
+	"os"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/OmniFlix/onft/types"
 	"github.com/cosmos/cosmos-sdk/client"
@@ -10,6 +15,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/version"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/spf13/cobra"
 )
 
@@ -30,6 +36,50 @@ func NewTxCmd() *cobra.Command {
 		GetCmdMintONFT(),
 		GetCmdTransferONFT(),
 		GetCmdBurnONFT(),
+		GetCmdPrintEdition(),
+		GetCmdCreateClaim(),
+		GetCmdCommitClaim(),
+		GetCmdClaim(),
+		GetCmdRefundClaim(),
+		GetCmdBuildAirdropTree(),
+		GetCmdCreateMintAirdrop(),
+		GetCmdClaimAirdrop(),
+		GetCmdCreateSwap(),
+		GetCmdAcceptSwap(),
+		GetCmdCancelSwap(),
+		GetCmdListONFT(),
+		GetCmdBuyONFT(),
+		GetCmdDelistONFT(),
+		GetCmdMakeOffer(),
+		GetCmdAcceptOffer(),
+		GetCmdCancelOffer(),
+		GetCmdCreateAuction(),
+		GetCmdPlaceBid(),
+		GetCmdCancelAuction(),
+		GetCmdRequestLoan(),
+		GetCmdFundLoan(),
+		GetCmdRepayLoan(),
+		GetCmdClaimDefault(),
+		GetCmdCancelLoan(),
+		GetCmdFractionalize(),
+		GetCmdRedeem(),
+		GetCmdBuyout(),
+		GetCmdNestONFT(),
+		GetCmdUnnestONFT(),
+		GetCmdCreateTokenAccount(),
+		GetCmdSendFromTokenAccount(),
+		GetCmdCreateRecipe(),
+		GetCmdExecuteRecipe(),
+		GetCmdDeleteRecipe(),
+		GetCmdBuildRevealTree(),
+		GetCmdRevealONFT(),
+		GetCmdRevealDenom(),
+		GetCmdRevokeONFT(),
+		GetCmdRedeemONFT(),
+		GetCmdRenewONFT(),
+		GetCmdPauseDenom(),
+		GetCmdUnpauseDenom(),
+		GetCmdClawbackONFT(),
 	)
 
 	return txCmd
@@ -42,7 +92,14 @@ func GetCmdCreateDenom() *cobra.Command {
 			fmt.Sprintf(`Create a new denom.
 Example:
 $ %s tx onft create [symbol] --name=<name> --schema=<schema> --description=<description> --preview-uri=<preview-uri> 
---creation-fee <collection-creation-fee> --chain-id=<chain-id> --from=<key-name> --fees=<fee>`,
+--creation-fee <collection-creation-fee> --chain-id=<chain-id> --from=<key-name> --fees=<fee>
+
+The creation fee can be any one of the accepted denom creation fees, the first one is paid when it is not set.
+
+Additional Flags
+    --royalty-receivers=<address>:0.6,<address>:0.4
+    --subscription-period=720h --subscription-price=10000000uflix
+`,
 				version.AppName,
 			),
 		),
@@ -77,9 +134,9 @@ $ %s tx onft create [symbol] --name=<name> --schema=<schema> --description=<desc
 			if err != nil {
 				return err
 			}
-			creationFee, err := sdk.ParseCoinNormalized(creationFeeStr)
+			creationFee, err := creationFeeFromFlag(clientCtx, creationFeeStr)
 			if err != nil {
-				return fmt.Errorf("failed to parse creation fee: %s", creationFeeStr)
+				return err
 			}
 
 			msg := types.NewMsgCreateDenom(symbol,
@@ -90,6 +147,30 @@ $ %s tx onft create [symbol] --name=<name> --schema=<schema> --description=<desc
 				clientCtx.GetFromAddress().String(),
 				creationFee,
 			)
+			msg.RoyaltyReceivers, err = royaltyReceiversFromFlag(cmd)
+			if err != nil {
+				return err
+			}
+			msg.MetadataRoot, err = cmd.Flags().GetString(FlagMetadataRoot)
+			if err != nil {
+				return err
+			}
+			msg.Credential, err = cmd.Flags().GetBool(FlagCredential)
+			if err != nil {
+				return err
+			}
+			msg.Redeemer, err = cmd.Flags().GetString(FlagRedeemer)
+			if err != nil {
+				return err
+			}
+			msg.Subscription, err = subscriptionFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+			msg.ClawbackEnabled, err = cmd.Flags().GetBool(FlagClawback)
+			if err != nil {
+				return err
+			}
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -98,12 +179,32 @@ $ %s tx onft create [symbol] --name=<name> --schema=<schema> --description=<desc
 	}
 	cmd.Flags().AddFlagSet(FsCreateDenom)
 	_ = cmd.MarkFlagRequired(FlagName)
-	_ = cmd.MarkFlagRequired(FlagCreationFee)
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
 }
 
+// royaltyReceiversFromFlag parses a list of address:weight royalty receivers
+func royaltyReceiversFromFlag(cmd *cobra.Command) ([]types.WeightedAddress, error) {
+	values, err := cmd.Flags().GetStringSlice(FlagRoyaltyReceivers)
+	if err != nil {
+		return nil, err
+	}
+	receivers := make([]types.WeightedAddress, 0, len(values))
+	for _, value := range values {
+		parts := strings.Split(value, ":")
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("invalid royalty receiver %s, expected address:weight", value)
+		}
+		weight, err := sdk.NewDecFromStr(strings.TrimSpace(parts[1]))
+		if err != nil {
+			return nil, fmt.Errorf("invalid royalty receiver weight %s", parts[1])
+		}
+		receivers = append(receivers, types.WeightedAddress{Address: strings.TrimSpace(parts[0]), Weight: weight})
+	}
+	return receivers, nil
+}
+
 func GetCmdMintONFT() *cobra.Command {
 	cmd := &cobra.Command{
 		Use: "mint [denom-id]",
@@ -124,6 +225,7 @@ Additional Flags
     --inextensible
     --nsfw
     --royalty-share="0.05"
+    --max-editions=50
 `,
 				version.AppName,
 			),
@@ -224,6 +326,15 @@ Additional Flags
 				}
 			}
 
+			maxEditions, err := cmd.Flags().GetUint64(FlagMaxEditions)
+			if err != nil {
+				return err
+			}
+			maxUses, err := cmd.Flags().GetUint64(FlagMaxUses)
+			if err != nil {
+				return err
+			}
+
 			msg := types.NewMsgMintONFT(
 				denomId,
 				sender,
@@ -235,6 +346,8 @@ Additional Flags
 				nsfw,
 				royaltyShare,
 			)
+			msg.MaxEditions = maxEditions
+			msg.MaxUses = maxUses
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -255,7 +368,7 @@ func GetCmdUpdateDenom() *cobra.Command {
 			fmt.Sprintf(`Edit the data of Denom.
 Example:
 $ %s tx onft update-denom [denom-id] --name=<onft-name> --description=<onft-description> 
---preview-uri=<uri> --from=<key-name> --chain-id=<chain-id> --fees=<fee>`,
+--preview-uri=<uri> --royalty-receivers=<address>:<weight> --from=<key-name> --chain-id=<chain-id> --fees=<fee>`,
 				version.AppName,
 			),
 		),
@@ -289,6 +402,18 @@ $ %s tx onft update-denom [denom-id] --name=<onft-name> --description=<onft-desc
 				denomPreviewURI,
 				clientCtx.GetFromAddress().String(),
 			)
+			msg.RoyaltyReceivers, err = royaltyReceiversFromFlag(cmd)
+			if err != nil {
+				return err
+			}
+			msg.Redeemer, err = cmd.Flags().GetString(FlagRedeemer)
+			if err != nil {
+				return err
+			}
+			msg.Subscription, err = subscriptionFromFlags(cmd)
+			if err != nil {
+				return err
+			}
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -415,3 +540,2067 @@ $ %s tx onft burn [denom-id] [onft-id] --from=<key-name> --chain-id=<chain-id> -
 
 	return cmd
 }
+
+func GetCmdPrintEdition() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "print-edition [denom-id] [master-id]",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Print a numbered edition of a master oNFT.
+Example:
+$ %s tx onft print-edition [denom-id] [master-id] --recipient=<recipient> --from=<key-name> --chain-id=<chain-id> --fees=<fee>`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			denomId := strings.ToLower(strings.TrimSpace(args[0]))
+			masterId := strings.ToLower(strings.TrimSpace(args[1]))
+
+			sender := clientCtx.GetFromAddress().String()
+			recipient, err := cmd.Flags().GetString(FlagRecipient)
+			if err != nil {
+				return err
+			}
+			if len(recipient) > 0 {
+				if _, err = sdk.AccAddressFromBech32(recipient); err != nil {
+					return err
+				}
+			} else {
+				recipient = sender
+			}
+
+			msg := types.NewMsgPrintEdition(denomId, masterId, sender, recipient)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	cmd.Flags().AddFlagSet(FsPrintEdition)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// onftTemplateFromFlags reads an oNFT template from the template flag set
+func onftTemplateFromFlags(cmd *cobra.Command) (*types.ONFTTemplate, error) {
+	var metadata types.Metadata
+	var err error
+	if metadata.Name, err = cmd.Flags().GetString(FlagName); err != nil {
+		return nil, err
+	}
+	if metadata.Description, err = cmd.Flags().GetString(FlagDescription); err != nil {
+		return nil, err
+	}
+	if metadata.MediaURI, err = cmd.Flags().GetString(FlagMediaURI); err != nil {
+		return nil, err
+	}
+	if metadata.PreviewURI, err = cmd.Flags().GetString(FlagPreviewURI); err != nil {
+		return nil, err
+	}
+	data, err := cmd.Flags().GetString(FlagData)
+	if err != nil {
+		return nil, err
+	}
+	nonTransferable, err := cmd.Flags().GetBool(FlagNonTransferable)
+	if err != nil {
+		return nil, err
+	}
+	inExtensible, err := cmd.Flags().GetBool(FlagInExtensible)
+	if err != nil {
+		return nil, err
+	}
+	nsfw, err := cmd.Flags().GetBool(FlagNsfw)
+	if err != nil {
+		return nil, err
+	}
+	royaltyShareStr, err := cmd.Flags().GetString(FlagRoyaltyShare)
+	if err != nil {
+		return nil, err
+	}
+	royaltyShare := sdk.NewDec(0)
+	if len(royaltyShareStr) > 0 {
+		royaltyShare, err = sdk.NewDecFromStr(royaltyShareStr)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	maxUses, err := cmd.Flags().GetUint64(FlagMaxUses)
+	if err != nil {
+		return nil, err
+	}
+
+	template := types.NewONFTTemplate(metadata, data, !nonTransferable, !inExtensible, nsfw, royaltyShare)
+	template.MaxUses = maxUses
+	return &template, nil
+}
+
+func GetCmdCreateClaim() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "create-claim [denom-id]",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Create a hash locked claim for an existing oNFT or for oNFTs minted from a template.
+Example:
+$ %s tx onft create-claim [denom-id] \
+	--onft-id=<onft-id> \
+	--secret=<secret> \
+	--expiry=2026-12-31T00:00:00Z \
+	--from=<key-name> \
+	--chain-id=<chain-id> \
+	--fees=<fee>
+
+$ %s tx onft create-claim [denom-id] \
+	--name=<onft-name> \
+	--media-uri=<uri> \
+	--secret-hash=<sha256-hex> \
+	--expiry=2026-12-31T00:00:00Z \
+	--max-claims=100 \
+	--from=<key-name> \
+	--chain-id=<chain-id> \
+	--fees=<fee>
+`,
+				version.AppName, version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			denomId := strings.ToLower(strings.TrimSpace(args[0]))
+			sender := clientCtx.GetFromAddress().String()
+
+			onftId, err := cmd.Flags().GetString(FlagONFTID)
+			if err != nil {
+				return err
+			}
+			var template *types.ONFTTemplate
+			if len(onftId) == 0 {
+				template, err = onftTemplateFromFlags(cmd)
+				if err != nil {
+					return err
+				}
+			}
+
+			hash, err := cmd.Flags().GetString(FlagSecretHash)
+			if err != nil {
+				return err
+			}
+			secret, err := cmd.Flags().GetString(FlagSecret)
+			if err != nil {
+				return err
+			}
+			if len(secret) > 0 {
+				if len(hash) > 0 {
+					return fmt.Errorf("only one of --%s or --%s can be used", FlagSecret, FlagSecretHash)
+				}
+				hash = types.HashClaimSecret(secret)
+			}
+
+			expiryStr, err := cmd.Flags().GetString(FlagExpiry)
+			if err != nil {
+				return err
+			}
+			expiry, err := time.Parse(time.RFC3339, expiryStr)
+			if err != nil {
+				return err
+			}
+			maxClaims, err := cmd.Flags().GetUint64(FlagMaxClaims)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCreateClaim(denomId, strings.ToLower(onftId), template, hash, expiry, maxClaims, sender)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	cmd.Flags().AddFlagSet(FsCreateClaim)
+	cmd.Flags().AddFlagSet(FsONFTTemplate)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetCmdCommitClaim() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "commit-claim [claim-id] [secret]",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Commit to the secret of a claim. Only the commitment is broadcast, the secret
+is revealed with the claim transaction in a later block.
+Example:
+$ %s tx onft commit-claim [claim-id] [secret] --from=<key-name> --chain-id=<chain-id> --fees=<fee>`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			claimId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			commitment := types.ClaimCommitmentHash(args[1], clientCtx.GetFromAddress())
+			msg := types.NewMsgCommitClaim(claimId, commitment, clientCtx.GetFromAddress().String())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetCmdClaim() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "claim [claim-id] [secret]",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Claim an oNFT by revealing the claim secret.
+Example:
+$ %s tx onft claim [claim-id] [secret] --from=<key-name> --chain-id=<chain-id> --fees=<fee>`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			claimId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgClaim(claimId, args[1], clientCtx.GetFromAddress().String())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetCmdRefundClaim() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "refund-claim [claim-id]",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Close a claim and return the escrowed oNFT if it is not claimed yet.
+Example:
+$ %s tx onft refund-claim [claim-id] --from=<key-name> --chain-id=<chain-id> --fees=<fee>`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			claimId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRefundClaim(claimId, clientCtx.GetFromAddress().String())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetCmdBuildAirdropTree() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "build-airdrop-tree [csv-file]",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Build the merkle tree and proofs of a mint airdrop from a local csv file.
+Every row holds address,onft_id and optionally name,description,media_uri,preview_uri
+of the oNFT. Rows without metadata mint with the airdrop template metadata.
+The command runs offline, share the proof of every recipient with them.
+Example:
+$ %s tx onft build-airdrop-tree recipients.csv --output=tree.json`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			file, err := os.Open(args[0])
+			if err != nil {
+				return err
+			}
+			defer file.Close()
+
+			leaves, err := parseAirdropCSV(file)
+			if err != nil {
+				return err
+			}
+			bz, err := json.MarshalIndent(buildAirdropTree(leaves), "", "  ")
+			if err != nil {
+				return err
+			}
+
+			output, err := cmd.Flags().GetString(FlagOutput)
+			if err != nil {
+				return err
+			}
+			if len(output) > 0 {
+				return os.WriteFile(output, bz, 0o600)
+			}
+			cmd.Println(string(bz))
+			return nil
+		},
+	}
+	cmd.Flags().AddFlagSet(FsAirdropTree)
+
+	return cmd
+}
+
+func GetCmdCreateMintAirdrop() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "create-mint-airdrop [denom-id] [merkle-root]",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Create a mint airdrop from the merkle root built with build-airdrop-tree.
+Example:
+$ %s tx onft create-mint-airdrop [denom-id] [merkle-root] \
+	--name=<onft-name> \
+	--media-uri=<uri> \
+	--expiry=2026-12-31T00:00:00Z \
+	--from=<key-name> \
+	--chain-id=<chain-id> \
+	--fees=<fee>
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			denomId := strings.ToLower(strings.TrimSpace(args[0]))
+
+			template, err := onftTemplateFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+			expiryStr, err := cmd.Flags().GetString(FlagExpiry)
+			if err != nil {
+				return err
+			}
+			expiry, err := time.Parse(time.RFC3339, expiryStr)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCreateMintAirdrop(denomId, strings.ToLower(args[1]), *template, expiry, clientCtx.GetFromAddress().String())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	cmd.Flags().AddFlagSet(FsCreateAirdrop)
+	cmd.Flags().AddFlagSet(FsONFTTemplate)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetCmdClaimAirdrop() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "claim-airdrop [airdrop-id] [tree-file]",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Claim the oNFT of a mint airdrop with the proof of the sender from the tree file.
+Example:
+$ %s tx onft claim-airdrop [airdrop-id] tree.json --onft-id=<onft-id> --from=<key-name> --chain-id=<chain-id> --fees=<fee>`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			airdropId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			tree, err := readAirdropTree(args[1])
+			if err != nil {
+				return err
+			}
+			onftId, err := cmd.Flags().GetString(FlagONFTID)
+			if err != nil {
+				return err
+			}
+
+			claimant := clientCtx.GetFromAddress().String()
+			var leaves []AirdropLeaf
+			for _, leaf := range tree.Leaves {
+				if leaf.Address == claimant && (len(onftId) == 0 || leaf.OnftID == strings.ToLower(onftId)) {
+					leaves = append(leaves, leaf)
+				}
+			}
+			if len(leaves) == 0 {
+				return fmt.Errorf("no airdrop leaf found for %s", claimant)
+			}
+			if len(leaves) > 1 {
+				return fmt.Errorf("%s has %d airdrop leaves, select one with --%s", claimant, len(leaves), FlagONFTID)
+			}
+
+			msg := types.NewMsgClaimAirdrop(airdropId, leaves[0].OnftID, leaves[0].Metadata, leaves[0].Proof, claimant)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	cmd.Flags().AddFlagSet(FsClaimAirdrop)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// onftRefsFromFlag parses a list of denom-id/onft-id references
+func onftRefsFromFlag(cmd *cobra.Command, flagName string) ([]types.ONFTRef, error) {
+	values, err := cmd.Flags().GetStringSlice(flagName)
+	if err != nil {
+		return nil, err
+	}
+	refs := make([]types.ONFTRef, 0, len(values))
+	for _, value := range values {
+		ref, err := types.ParseONFTRef(value)
+		if err != nil {
+			return nil, err
+		}
+		refs = append(refs, ref)
+	}
+	return refs, nil
+}
+
+// coinsFromFlag parses optional coins of a flag
+func coinsFromFlag(cmd *cobra.Command, flagName string) (sdk.Coins, error) {
+	value, err := cmd.Flags().GetString(flagName)
+	if err != nil || len(value) == 0 {
+		return sdk.Coins{}, err
+	}
+	return sdk.ParseCoinsNormalized(value)
+}
+
+// subscriptionFromFlags parses an optional subscription period and price,
+// which must be set together
+func subscriptionFromFlags(cmd *cobra.Command) (*types.SubscriptionConfig, error) {
+	period, err := cmd.Flags().GetDuration(FlagSubscriptionPeriod)
+	if err != nil {
+		return nil, err
+	}
+	priceStr, err := cmd.Flags().GetString(FlagSubscriptionPrice)
+	if err != nil {
+		return nil, err
+	}
+	if period == 0 && len(priceStr) == 0 {
+		return nil, nil
+	}
+	if period == 0 || len(priceStr) == 0 {
+		return nil, fmt.Errorf("--%s and --%s must be set together", FlagSubscriptionPeriod, FlagSubscriptionPrice)
+	}
+	price, err := sdk.ParseCoinNormalized(priceStr)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse subscription price: %s", priceStr)
+	}
+	return types.NewSubscriptionConfig(period, price), nil
+}
+
+// pauseFromFlags parses the selected denom actions, all actions when none
+// is selected
+func pauseFromFlags(cmd *cobra.Command) (types.DenomPause, error) {
+	var pause types.DenomPause
+	var err error
+	if pause.Mint, err = cmd.Flags().GetBool(FlagMint); err != nil {
+		return pause, err
+	}
+	if pause.Transfer, err = cmd.Flags().GetBool(FlagTransfer); err != nil {
+		return pause, err
+	}
+	if pause.Edit, err = cmd.Flags().GetBool(FlagEdit); err != nil {
+		return pause, err
+	}
+	if pause.IsEmpty() {
+		return types.DenomPause{Mint: true, Transfer: true, Edit: true}, nil
+	}
+	return pause, nil
+}
+
+// creationFeeFromFlag parses the creation fee, or picks the first accepted
+// denom creation fee from the module params when it is empty
+func creationFeeFromFlag(clientCtx client.Context, creationFeeStr string) (sdk.Coin, error) {
+	if len(strings.TrimSpace(creationFeeStr)) > 0 {
+		creationFee, err := sdk.ParseCoinNormalized(creationFeeStr)
+		if err != nil {
+			return sdk.Coin{}, fmt.Errorf("failed to parse creation fee: %s", creationFeeStr)
+		}
+		return creationFee, nil
+	}
+	queryClient := types.NewQueryClient(clientCtx)
+	res, err := queryClient.Params(context.Background(), &types.QueryParamsRequest{})
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	fees := res.Params.GetDenomCreationFees()
+	if len(fees) == 0 {
+		return sdk.Coin{}, fmt.Errorf("no denom creation fee is accepted")
+	}
+	return fees[0], nil
+}
+
+// expiryFromFlag parses an optional RFC3339 expiry time
+func expiryFromFlag(cmd *cobra.Command) (time.Time, error) {
+	value, err := cmd.Flags().GetString(FlagExpiry)
+	if err != nil || len(value) == 0 {
+		return time.Time{}, err
+	}
+	return time.Parse(time.RFC3339, value)
+}
+
+func GetCmdCreateSwap() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "create-swap",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Offer oNFTs and coins in exchange for the requested oNFTs and coins.
+The offered assets are escrowed until the swap is accepted or cancelled.
+Example:
+$ %s tx onft create-swap \
+	--offer-onfts=<denom-id>/<onft-id>,<denom-id>/<onft-id> \
+	--offer-coins=100uflix \
+	--request-onfts=<denom-id>/<onft-id> \
+	--request-coins=500uflix \
+	--counterparty=<address> \
+	--expiry=2026-12-31T00:00:00Z \
+	--from=<key-name> \
+	--chain-id=<chain-id> \
+	--fees=<fee>
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			offeredONFTs, err := onftRefsFromFlag(cmd, FlagOfferONFTs)
+			if err != nil {
+				return err
+			}
+			offeredCoins, err := coinsFromFlag(cmd, FlagOfferCoins)
+			if err != nil {
+				return err
+			}
+			requestedONFTs, err := onftRefsFromFlag(cmd, FlagRequestONFTs)
+			if err != nil {
+				return err
+			}
+			requestedCoins, err := coinsFromFlag(cmd, FlagRequestCoins)
+			if err != nil {
+				return err
+			}
+			counterparty, err := cmd.Flags().GetString(FlagCounterparty)
+			if err != nil {
+				return err
+			}
+			expiry, err := expiryFromFlag(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCreateSwap(
+				offeredONFTs, offeredCoins,
+				requestedONFTs, requestedCoins,
+				counterparty, expiry,
+				clientCtx.GetFromAddress().String(),
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	cmd.Flags().AddFlagSet(FsCreateSwap)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetCmdAcceptSwap() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "accept-swap [swap-id]",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Accept a swap by giving the requested oNFTs and coins.
+Example:
+$ %s tx onft accept-swap [swap-id] --from=<key-name> --chain-id=<chain-id> --fees=<fee>`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			swapId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgAcceptSwap(swapId, clientCtx.GetFromAddress().String())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetCmdCancelSwap() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "cancel-swap [swap-id]",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Cancel a swap and return the escrowed assets to its creator.
+Anyone can cancel a swap after it expired.
+Example:
+$ %s tx onft cancel-swap [swap-id] --from=<key-name> --chain-id=<chain-id> --fees=<fee>`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			swapId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCancelSwap(swapId, clientCtx.GetFromAddress().String())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetCmdListONFT() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "list-onft [denom-id] [onft-id] [price]",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`List an oNFT for sale at a fixed price. The oNFT is escrowed until it is sold or delisted.
+Example:
+$ %s tx onft list-onft [denom-id] [onft-id] 1000000uflix --expiry=2026-12-31T00:00:00Z --from=<key-name> --chain-id=<chain-id> --fees=<fee>`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			price, err := sdk.ParseCoinNormalized(args[2])
+			if err != nil {
+				return fmt.Errorf("failed to parse price: %s", args[2])
+			}
+			expiry, err := expiryFromFlag(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgListONFT(
+				strings.TrimSpace(args[0]),
+				strings.TrimSpace(args[1]),
+				price,
+				expiry,
+				clientCtx.GetFromAddress().String(),
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	cmd.Flags().AddFlagSet(FsListONFT)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetCmdBuyONFT() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "buy-onft [listing-id]",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Buy a listed oNFT at its listing price.
+Example:
+$ %s tx onft buy-onft [listing-id] --from=<key-name> --chain-id=<chain-id> --fees=<fee>`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			listingId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgBuyONFT(listingId, clientCtx.GetFromAddress().String())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetCmdDelistONFT() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "delist-onft [listing-id]",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Remove a listing and return the oNFT to its seller.
+Anyone can delist an expired listing.
+Example:
+$ %s tx onft delist-onft [listing-id] --from=<key-name> --chain-id=<chain-id> --fees=<fee>`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			listingId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgDelistONFT(listingId, clientCtx.GetFromAddress().String())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetCmdMakeOffer() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "make-offer [denom-id] [amount]",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Make an offer for an oNFT, or for any oNFT of a denom when no onft id is given.
+The offered amount is escrowed until the offer is accepted or cancelled.
+Example:
+$ %s tx onft make-offer [denom-id] 1000000uflix --onft-id=<onft-id> --expiry=2026-12-31T00:00:00Z --from=<key-name> --chain-id=<chain-id> --fees=<fee>`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			amount, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return fmt.Errorf("failed to parse amount: %s", args[1])
+			}
+			onftId, err := cmd.Flags().GetString(FlagONFTID)
+			if err != nil {
+				return err
+			}
+			expiry, err := expiryFromFlag(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgMakeOffer(
+				strings.TrimSpace(args[0]),
+				strings.TrimSpace(onftId),
+				amount,
+				expiry,
+				clientCtx.GetFromAddress().String(),
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	cmd.Flags().AddFlagSet(FsMakeOffer)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetCmdAcceptOffer() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "accept-offer [offer-id]",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Sell an oNFT to the buyer of an offer.
+Example:
+$ %s tx onft accept-offer [offer-id] --onft-id=<onft-id> --from=<key-name> --chain-id=<chain-id> --fees=<fee>`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			offerId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			onftId, err := cmd.Flags().GetString(FlagONFTID)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgAcceptOffer(offerId, strings.TrimSpace(onftId), clientCtx.GetFromAddress().String())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	cmd.Flags().AddFlagSet(FsAcceptOffer)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetCmdCancelOffer() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "cancel-offer [offer-id]",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Cancel an offer and refund the escrowed amount to its buyer.
+Anyone can cancel an expired offer.
+Example:
+$ %s tx onft cancel-offer [offer-id] --from=<key-name> --chain-id=<chain-id> --fees=<fee>`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			offerId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCancelOffer(offerId, clientCtx.GetFromAddress().String())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// timeFromFlag parses an optional RFC3339 time
+func timeFromFlag(cmd *cobra.Command, flagName string) (time.Time, error) {
+	value, err := cmd.Flags().GetString(flagName)
+	if err != nil || len(value) == 0 {
+		return time.Time{}, err
+	}
+	return time.Parse(time.RFC3339, value)
+}
+
+// coinFromFlag parses an optional coin
+func coinFromFlag(cmd *cobra.Command, flagName string) (sdk.Coin, error) {
+	value, err := cmd.Flags().GetString(flagName)
+	if err != nil || len(value) == 0 {
+		return sdk.Coin{}, err
+	}
+	return sdk.ParseCoinNormalized(value)
+}
+
+func GetCmdCreateAuction() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "create-auction [denom-id] [onft-id]",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Auction an oNFT. The oNFT is escrowed until the auction is settled or cancelled.
+English auctions sell to the highest bid at the end time, bids within the extension window extend the auction.
+Dutch auctions lower the price by the decrement every decrement interval and sell to the first bid.
+Example:
+$ %s tx onft create-auction [denom-id] [onft-id] \
+	--type=english \
+	--start-price=1000000uflix \
+	--min-increment=100000 \
+	--extension-window=10m \
+	--end-time=2026-12-31T00:00:00Z \
+	--from=<key-name> \
+	--chain-id=<chain-id> \
+	--fees=<fee>
+
+$ %s tx onft create-auction [denom-id] [onft-id] \
+	--type=dutch \
+	--start-price=5000000uflix \
+	--floor-price=1000000uflix \
+	--decrement=100000 \
+	--decrement-interval=1h \
+	--end-time=2026-12-31T00:00:00Z \
+	--from=<key-name> \
+	--chain-id=<chain-id> \
+	--fees=<fee>
+`,
+				version.AppName, version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			typeStr, err := cmd.Flags().GetString(FlagAuctionType)
+			if err != nil {
+				return err
+			}
+			auctionType, err := types.ParseAuctionType(typeStr)
+			if err != nil {
+				return err
+			}
+			startPrice, err := coinFromFlag(cmd, FlagStartPrice)
+			if err != nil {
+				return err
+			}
+			floorPrice, err := coinFromFlag(cmd, FlagFloorPrice)
+			if err != nil {
+				return err
+			}
+			minIncrementStr, err := cmd.Flags().GetString(FlagMinIncrement)
+			if err != nil {
+				return err
+			}
+			minIncrement, ok := sdk.NewIntFromString(minIncrementStr)
+			if !ok {
+				return fmt.Errorf("invalid min increment %s", minIncrementStr)
+			}
+			decrementStr, err := cmd.Flags().GetString(FlagDecrement)
+			if err != nil {
+				return err
+			}
+			decrement, ok := sdk.NewIntFromString(decrementStr)
+			if !ok {
+				return fmt.Errorf("invalid decrement %s", decrementStr)
+			}
+			decrementInterval, err := cmd.Flags().GetDuration(FlagDecrementInterval)
+			if err != nil {
+				return err
+			}
+			extensionWindow, err := cmd.Flags().GetDuration(FlagExtensionWindow)
+			if err != nil {
+				return err
+			}
+			startTime, err := timeFromFlag(cmd, FlagStartTime)
+			if err != nil {
+				return err
+			}
+			endTime, err := timeFromFlag(cmd, FlagEndTime)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCreateAuction(
+				auctionType,
+				strings.TrimSpace(args[0]),
+				strings.TrimSpace(args[1]),
+				startPrice, floorPrice,
+				minIncrement, decrement,
+				decrementInterval, extensionWindow,
+				startTime, endTime,
+				clientCtx.GetFromAddress().String(),
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	cmd.Flags().AddFlagSet(FsCreateAuction)
+	_ = cmd.MarkFlagRequired(FlagStartPrice)
+	_ = cmd.MarkFlagRequired(FlagEndTime)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetCmdPlaceBid() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "place-bid [auction-id] [amount]",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Bid on an auction. The bid is escrowed and refunded when outbid.
+A bid on a dutch auction pays the current price, which can be lower than the amount.
+Example:
+$ %s tx onft place-bid [auction-id] 1000000uflix --from=<key-name> --chain-id=<chain-id> --fees=<fee>`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			auctionId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			amount, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return fmt.Errorf("failed to parse amount: %s", args[1])
+			}
+
+			msg := types.NewMsgPlaceBid(auctionId, amount, clientCtx.GetFromAddress().String())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetCmdCancelAuction() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "cancel-auction [auction-id]",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Cancel an auction without bids and return the oNFT to the seller.
+Example:
+$ %s tx onft cancel-auction [auction-id] --from=<key-name> --chain-id=<chain-id> --fees=<fee>`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			auctionId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCancelAuction(auctionId, clientCtx.GetFromAddress().String())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetCmdRequestLoan() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "request-loan [denom-id] [onft-id] [principal] [interest] [duration]",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Request a loan against an oNFT. The oNFT is escrowed as collateral until the loan is repaid,
+defaulted or cancelled. The loan must be repaid with interest within the duration after it is funded.
+Example:
+$ %s tx onft request-loan [denom-id] [onft-id] 1000000uflix 50000uflix 720h --from=<key-name> --chain-id=<chain-id> --fees=<fee>`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(5),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			principal, err := sdk.ParseCoinNormalized(args[2])
+			if err != nil {
+				return fmt.Errorf("failed to parse principal: %s", args[2])
+			}
+			interest, err := sdk.ParseCoinNormalized(args[3])
+			if err != nil {
+				return fmt.Errorf("failed to parse interest: %s", args[3])
+			}
+			duration, err := time.ParseDuration(args[4])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRequestLoan(
+				strings.TrimSpace(args[0]),
+				strings.TrimSpace(args[1]),
+				principal,
+				interest,
+				duration,
+				clientCtx.GetFromAddress().String(),
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetCmdFundLoan() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "fund-loan [loan-id]",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Fund a requested loan by sending the principal to the borrower.
+Example:
+$ %s tx onft fund-loan [loan-id] --from=<key-name> --chain-id=<chain-id> --fees=<fee>`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			loanId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgFundLoan(loanId, clientCtx.GetFromAddress().String())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetCmdRepayLoan() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "repay-loan [loan-id]",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Repay a loan with interest and get the collateral back.
+Example:
+$ %s tx onft repay-loan [loan-id] --from=<key-name> --chain-id=<chain-id> --fees=<fee>`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			loanId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRepayLoan(loanId, clientCtx.GetFromAddress().String())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetCmdClaimDefault() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "claim-default [loan-id]",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Claim the collateral of a loan that was not repaid before its due time.
+Example:
+$ %s tx onft claim-default [loan-id] --from=<key-name> --chain-id=<chain-id> --fees=<fee>`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			loanId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgClaimDefault(loanId, clientCtx.GetFromAddress().String())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetCmdCancelLoan() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "cancel-loan [loan-id]",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Cancel a loan that was not funded and get the collateral back.
+Example:
+$ %s tx onft cancel-loan [loan-id] --from=<key-name> --chain-id=<chain-id> --fees=<fee>`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			loanId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCancelLoan(loanId, clientCtx.GetFromAddress().String())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetCmdFractionalize() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "fractionalize [denom-id] [onft-id] [shares] [buyout-threshold] [buyout-price]",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Lock an oNFT in the module account and mint shares coins of denom onft/[denom-id]/[onft-id].
+Holding all shares redeems the oNFT, holding at least buyout-threshold of the shares allows buying out the
+other holders at buyout-price for the whole oNFT.
+Example:
+$ %s tx onft fractionalize [denom-id] [onft-id] 1000000 0.75 500000000uflix --from=<key-name> --chain-id=<chain-id> --fees=<fee>`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(5),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			shares, ok := sdk.NewIntFromString(args[2])
+			if !ok {
+				return fmt.Errorf("failed to parse shares: %s", args[2])
+			}
+			buyoutThreshold, err := sdk.NewDecFromStr(args[3])
+			if err != nil {
+				return fmt.Errorf("failed to parse buyout threshold: %s", args[3])
+			}
+			buyoutPrice, err := sdk.ParseCoinNormalized(args[4])
+			if err != nil {
+				return fmt.Errorf("failed to parse buyout price: %s", args[4])
+			}
+
+			msg := types.NewMsgFractionalize(
+				strings.TrimSpace(args[0]),
+				strings.TrimSpace(args[1]),
+				shares,
+				buyoutThreshold,
+				buyoutPrice,
+				clientCtx.GetFromAddress().String(),
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetCmdRedeem() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "redeem [denom-id] [onft-id]",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Burn all shares of a fractionalized oNFT and get the oNFT back. After a buyout, burn the shares
+held for their part of the buyout proceeds.
+Example:
+$ %s tx onft redeem [denom-id] [onft-id] --from=<key-name> --chain-id=<chain-id> --fees=<fee>`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRedeem(
+				strings.TrimSpace(args[0]),
+				strings.TrimSpace(args[1]),
+				clientCtx.GetFromAddress().String(),
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetCmdBuyout() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "buyout [denom-id] [onft-id]",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Buy the oNFT out of its shares by paying the buyout price for the shares held by others.
+Requires holding at least the buyout threshold of the shares.
+Example:
+$ %s tx onft buyout [denom-id] [onft-id] --from=<key-name> --chain-id=<chain-id> --fees=<fee>`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgBuyout(
+				strings.TrimSpace(args[0]),
+				strings.TrimSpace(args[1]),
+				clientCtx.GetFromAddress().String(),
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetCmdNestONFT() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "nest [denom-id] [onft-id] [parent-denom-id] [parent-onft-id]",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Nest an oNFT under a parent oNFT. The nested oNFT moves with its parent on every transfer
+until it is unnested.
+Example:
+$ %s tx onft nest [denom-id] [onft-id] [parent-denom-id] [parent-onft-id] --from=<key-name> --chain-id=<chain-id> --fees=<fee>`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgNestONFT(
+				strings.TrimSpace(args[0]),
+				strings.TrimSpace(args[1]),
+				strings.TrimSpace(args[2]),
+				strings.TrimSpace(args[3]),
+				clientCtx.GetFromAddress().String(),
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetCmdUnnestONFT() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "unnest [denom-id] [onft-id]",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Move a nested oNFT out of its parent, it stays with the owner of the root oNFT.
+Example:
+$ %s tx onft unnest [denom-id] [onft-id] --from=<key-name> --chain-id=<chain-id> --fees=<fee>`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgUnnestONFT(
+				strings.TrimSpace(args[0]),
+				strings.TrimSpace(args[1]),
+				clientCtx.GetFromAddress().String(),
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetCmdCreateTokenAccount() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "create-token-account [denom-id] [onft-id]",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Enable the token account of an oNFT. The account can receive coins, and its balances move
+with the oNFT.
+Example:
+$ %s tx onft create-token-account [denom-id] [onft-id] --from=<key-name> --chain-id=<chain-id> --fees=<fee>`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCreateTokenAccount(
+				strings.TrimSpace(args[0]),
+				strings.TrimSpace(args[1]),
+				clientCtx.GetFromAddress().String(),
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetCmdSendFromTokenAccount() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "send-from-token-account [denom-id] [onft-id] [to-address] [amount]",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Send coins from the token account of an oNFT owned by the sender.
+Example:
+$ %s tx onft send-from-token-account [denom-id] [onft-id] [to-address] 1000uflix --from=<key-name> --chain-id=<chain-id> --fees=<fee>`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			denomId := strings.TrimSpace(args[0])
+			onftId := strings.TrimSpace(args[1])
+			to, err := sdk.AccAddressFromBech32(args[2])
+			if err != nil {
+				return err
+			}
+			amount, err := sdk.ParseCoinsNormalized(args[3])
+			if err != nil {
+				return fmt.Errorf("failed to parse amount: %s", args[3])
+			}
+
+			send := banktypes.NewMsgSend(types.TokenAccountAddress(denomId, onftId), to, amount)
+			msg, err := types.NewMsgExecuteFromTokenAccount(
+				denomId,
+				onftId,
+				clientCtx.GetFromAddress().String(),
+				[]sdk.Msg{send},
+			)
+			if err != nil {
+				return err
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetCmdCreateRecipe() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "create-recipe [output-denom-id]",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Create a recipe burning input oNFTs to mint an oNFT of the output denom from a template.
+Inputs are denom-id:count with optional trait filters matched against the json data of the input oNFTs.
+Example:
+$ %s tx onft create-recipe [output-denom-id] \
+	--inputs=<denom-id>:2:rarity=common,<denom-id>:1 \
+	--name=<onft-name> \
+	--media-uri=<uri> \
+	--cost=1000000uflix \
+	--from=<key-name> \
+	--chain-id=<chain-id> \
+	--fees=<fee>
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			outputDenomId := strings.ToLower(strings.TrimSpace(args[0]))
+
+			inputsStr, err := cmd.Flags().GetString(FlagInputs)
+			if err != nil {
+				return err
+			}
+			inputs, err := types.ParseRecipeInputs(inputsStr)
+			if err != nil {
+				return err
+			}
+			template, err := onftTemplateFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+			cost, err := coinsFromFlag(cmd, FlagCost)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCreateRecipe(outputDenomId, *template, inputs, cost, clientCtx.GetFromAddress().String())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	cmd.Flags().AddFlagSet(FsCreateRecipe)
+	cmd.Flags().AddFlagSet(FsONFTTemplate)
+	_ = cmd.MarkFlagRequired(FlagInputs)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetCmdExecuteRecipe() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "execute-recipe [recipe-id] [input-onfts]",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Burn the input oNFTs to mint the output of a recipe. Input oNFTs are comma separated
+denom-id/onft-id in the order of the recipe inputs.
+Example:
+$ %s tx onft execute-recipe [recipe-id] <denom-id>/<onft-id>,<denom-id>/<onft-id> --from=<key-name> --chain-id=<chain-id> --fees=<fee>`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			recipeId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			var inputONFTs []types.ONFTRef
+			for _, value := range strings.Split(args[1], ",") {
+				ref, err := types.ParseONFTRef(value)
+				if err != nil {
+					return err
+				}
+				inputONFTs = append(inputONFTs, ref)
+			}
+
+			msg := types.NewMsgExecuteRecipe(recipeId, inputONFTs, clientCtx.GetFromAddress().String())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetCmdDeleteRecipe() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "delete-recipe [recipe-id]",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Delete a recipe.
+Example:
+$ %s tx onft delete-recipe [recipe-id] --from=<key-name> --chain-id=<chain-id> --fees=<fee>`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			recipeId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgDeleteRecipe(recipeId, clientCtx.GetFromAddress().String())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetCmdBuildRevealTree() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "build-reveal-tree [json-file]",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Build the merkle tree over the final metadata of an unrevealed denom from a local json file.
+The file holds an array of {"onft_id", "metadata": {"name", "description", "media_uri", "preview_uri"}, "data"}
+objects. Create the denom with --metadata-root set to the root of the tree, and keep the tree private until reveal.
+The command runs offline.
+Example:
+$ %s tx onft build-reveal-tree metadata.json --output=reveal-tree.json`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			input, err := os.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+			leaves, err := parseRevealLeaves(input)
+			if err != nil {
+				return err
+			}
+			bz, err := json.MarshalIndent(buildRevealTree(leaves), "", "  ")
+			if err != nil {
+				return err
+			}
+
+			output, err := cmd.Flags().GetString(FlagOutput)
+			if err != nil {
+				return err
+			}
+			if len(output) > 0 {
+				return os.WriteFile(output, bz, 0o600)
+			}
+			cmd.Println(string(bz))
+			return nil
+		},
+	}
+	cmd.Flags().AddFlagSet(FsAirdropTree)
+
+	return cmd
+}
+
+func GetCmdRevealONFT() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "reveal-onft [denom-id] [tree-file] [onft-id]",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Reveal the final metadata of an oNFT of an unrevealed denom from the tree built with build-reveal-tree.
+Example:
+$ %s tx onft reveal-onft [denom-id] reveal-tree.json [onft-id] --from=<key-name> --chain-id=<chain-id> --fees=<fee>`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			tree, err := readRevealTree(args[1])
+			if err != nil {
+				return err
+			}
+			onft, err := tree.revealedONFT(args[2])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRevealONFT(
+				strings.ToLower(strings.TrimSpace(args[0])),
+				onft,
+				clientCtx.GetFromAddress().String(),
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetCmdRevealDenom() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "reveal-denom [denom-id] [tree-file]",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Reveal the remaining unrevealed oNFTs of a denom and end its unrevealed mode.
+All minted oNFTs of the denom must be revealed by the end of the transaction.
+Example:
+$ %s tx onft reveal-denom [denom-id] reveal-tree.json --onft-ids=<onft-id>,<onft-id> --from=<key-name> --chain-id=<chain-id> --fees=<fee>`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			tree, err := readRevealTree(args[1])
+			if err != nil {
+				return err
+			}
+			onftIds, err := cmd.Flags().GetStringSlice(FlagONFTIDs)
+			if err != nil {
+				return err
+			}
+			onfts := make([]types.RevealedONFT, 0, len(onftIds))
+			for _, onftId := range onftIds {
+				onft, err := tree.revealedONFT(onftId)
+				if err != nil {
+					return err
+				}
+				onfts = append(onfts, onft)
+			}
+
+			msg := types.NewMsgRevealDenom(
+				strings.ToLower(strings.TrimSpace(args[0])),
+				onfts,
+				clientCtx.GetFromAddress().String(),
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	cmd.Flags().AddFlagSet(FsRevealDenom)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetCmdRevokeONFT() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "revoke [denom-id] [onft-id]",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Revoke a credential oNFT. The oNFT stays with its holder and is reported as revoked to verifiers.
+Example:
+$ %s tx onft revoke [denom-id] [onft-id] --reason=<reason> --from=<key-name> --chain-id=<chain-id> --fees=<fee>`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			denomId := strings.ToLower(strings.TrimSpace(args[0]))
+			onftId := strings.ToLower(strings.TrimSpace(args[1]))
+			reason, err := cmd.Flags().GetString(FlagReason)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRevokeONFT(denomId, onftId, reason, clientCtx.GetFromAddress().String())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	cmd.Flags().AddFlagSet(FsRevokeONFT)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetCmdRedeemONFT() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "redeem [denom-id] [onft-id]",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Redeem a ticket oNFT once without burning it. The redeemer of the denom redeems any ticket directly.
+The owner redeems with the redeemer co-signing, the transaction needs the signatures of both.
+Example:
+$ %s tx onft redeem [denom-id] [onft-id] --from=<redeemer-key> --chain-id=<chain-id> --fees=<fee>
+
+$ %s tx onft redeem [denom-id] [onft-id] --redeemer=<redeemer> --from=<owner-key> --generate-only --chain-id=<chain-id> --fees=<fee>
+`,
+				version.AppName, version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			denomId := strings.ToLower(strings.TrimSpace(args[0]))
+			onftId := strings.ToLower(strings.TrimSpace(args[1]))
+			redeemer, err := cmd.Flags().GetString(FlagRedeemer)
+			if err != nil {
+				return err
+			}
+
+			sender := clientCtx.GetFromAddress().String()
+			var owner string
+			if len(redeemer) == 0 {
+				redeemer = sender
+			} else {
+				owner = sender
+			}
+			msg := types.NewMsgRedeemONFT(denomId, onftId, redeemer, owner)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	cmd.Flags().AddFlagSet(FsRedeemONFT)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetCmdRenewONFT() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "renew [denom-id] [onft-id]",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Renew a subscription oNFT. Any address can pay the subscription price per period to the denom creator.
+Example:
+$ %s tx onft renew [denom-id] [onft-id] --periods=3 --from=<key-name> --chain-id=<chain-id> --fees=<fee>
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			denomId := strings.ToLower(strings.TrimSpace(args[0]))
+			onftId := strings.ToLower(strings.TrimSpace(args[1]))
+			periods, err := cmd.Flags().GetUint64(FlagPeriods)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRenewONFT(denomId, onftId, periods, clientCtx.GetFromAddress().String())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	cmd.Flags().AddFlagSet(FsRenewONFT)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetCmdPauseDenom() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "pause [denom-id]",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Pause minting, transfers or edits on a denom of the sender. All of them are paused when none is selected.
+Example:
+$ %s tx onft pause [denom-id] --mint --transfer --from=<key-name> --chain-id=<chain-id> --fees=<fee>
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			pause, err := pauseFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			denomId := strings.ToLower(strings.TrimSpace(args[0]))
+			msg := types.NewMsgPauseDenom(denomId, pause, clientCtx.GetFromAddress().String())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	cmd.Flags().AddFlagSet(FsPauseDenom)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetCmdUnpauseDenom() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "unpause [denom-id]",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Resume minting, transfers or edits on a denom of the sender. All of them are resumed when none is selected.
+Example:
+$ %s tx onft unpause [denom-id] --transfer --from=<key-name> --chain-id=<chain-id> --fees=<fee>
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			unpause, err := pauseFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			denomId := strings.ToLower(strings.TrimSpace(args[0]))
+			msg := types.NewMsgUnpauseDenom(denomId, unpause, clientCtx.GetFromAddress().String())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	cmd.Flags().AddFlagSet(FsPauseDenom)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetCmdClawbackONFT() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "clawback [denom-id] [onft-id]",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Claw back an onft of a clawback enabled denom of the sender. The onft is burned when no recipient is given.
+Example:
+$ %s tx onft clawback [denom-id] [onft-id] --recipient=<recipient> --from=<key-name> --chain-id=<chain-id> --fees=<fee>
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			recipient, err := cmd.Flags().GetString(FlagRecipient)
+			if err != nil {
+				return err
+			}
+
+			denomId := strings.ToLower(strings.TrimSpace(args[0]))
+			onftId := strings.ToLower(strings.TrimSpace(args[1]))
+			msg := types.NewMsgClawbackONFT(denomId, onftId, strings.TrimSpace(recipient), clientCtx.GetFromAddress().String())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	cmd.Flags().AddFlagSet(FsClawbackONFT)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -63,5 +63,6 @@ func ConvertBech32Prefix(address, prefix string) (string, error) {
 // addDebugCommands adds the custom debug commands to the application.
 func addDebugCommands(cmd *cobra.Command) *cobra.Command {
 	cmd.AddCommand(AddBech32ConvertCommand())
+	cmd.AddCommand(AddONFTStoreCommand())
 	return cmd
 }
@@ -6,8 +6,39 @@ import (
 
 type SimAppConfig struct {
 	serverconfig.Config
+
+	ONFTIndex ONFTIndexConfig `mapstructure:"onft-index"`
+}
+
+// ONFTIndexConfig defines the options of the onft index streaming service.
+type ONFTIndexConfig struct {
+	Enabled       bool   `mapstructure:"enabled"`
+	DBPath        string `mapstructure:"db-path"`
+	InitialHeight int64  `mapstructure:"initial-height"`
+	StopNodeOnErr bool   `mapstructure:"stop-node-on-err"`
 }
 
+const onftIndexConfigTemplate = `
+[streamers.onft-index]
+
+# Enabled maintains a SQLite index of the onft store, built from the
+# committed state changes of every block.
+enabled = {{ .ONFTIndex.Enabled }}
+
+# DBPath is the path of the index database. It defaults to
+# data/onft-index.db in the node home directory.
+db-path = "{{ .ONFTIndex.DBPath }}"
+
+# InitialHeight is the first height of the chain. An empty index starting at
+# this height indexes the genesis block, otherwise it is resynced from the
+# committed state, like on a node started from a snapshot.
+initial-height = {{ .ONFTIndex.InitialHeight }}
+
+# StopNodeOnErr stops the node when a block fails to be indexed, otherwise
+# the error is logged and the block is retried after the next block.
+stop-node-on-err = {{ .ONFTIndex.StopNodeOnErr }}
+`
+
 func initAppConfig() (string, interface{}) {
 	srvCfg := serverconfig.DefaultConfig()
 
@@ -15,9 +46,13 @@ func initAppConfig() (string, interface{}) {
 
 	simAppConfig := SimAppConfig{
 		Config: *srvCfg,
+		ONFTIndex: ONFTIndexConfig{
+			InitialHeight: 1,
+			StopNodeOnErr: true,
+		},
 	}
 
-	simAppTemplate := serverconfig.DefaultConfigTemplate
+	simAppTemplate := serverconfig.DefaultConfigTemplate + onftIndexConfigTemplate
 
 	return simAppTemplate, simAppConfig
 }
@@ -0,0 +1,268 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+
+	dbm "github.com/cometbft/cometbft-db"
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/spf13/cobra"
+
+	"github.com/OmniFlix/onft/app"
+	"github.com/OmniFlix/onft/simulation"
+	"github.com/OmniFlix/onft/types"
+)
+
+const (
+	flagONFTStoreHeight    = "height"
+	flagONFTStoreDBBackend = "db-backend"
+	flagONFTStorePrefix    = "prefix"
+)
+
+// onftStoreHeader describes the onft store loaded from a data directory.
+type onftStoreHeader struct {
+	DataDir string `json:"data_dir"`
+	Height  int64  `json:"height"`
+	Hash    string `json:"hash"`
+}
+
+// onftStoreEntry is a decoded entry of the onft store. Values that fail to
+// decode are kept as hex together with the error.
+type onftStoreEntry struct {
+	Prefix string          `json:"prefix"`
+	Key    string          `json:"key"`
+	Value  json.RawMessage `json:"value,omitempty"`
+	Raw    string          `json:"raw,omitempty"`
+	Error  string          `json:"error,omitempty"`
+}
+
+// onftStoreDiff is an entry present in only one of the stores, or stored with
+// different values.
+type onftStoreDiff struct {
+	Prefix string          `json:"prefix"`
+	Key    string          `json:"key"`
+	A      *onftStoreEntry `json:"a"`
+	B      *onftStoreEntry `json:"b"`
+}
+
+// AddONFTStoreCommand returns the onft-store cobra Command.
+func AddONFTStoreCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "onft-store",
+		Short: "Dump and diff the onft store of local data directories",
+		Long: `Dump and diff the onft store of local data directories, decoding every
+entry. The nodes using the data directories have to be stopped.`,
+		RunE: client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		onftStoreDumpCommand(),
+		onftStoreDiffCommand(),
+	)
+	cmd.PersistentFlags().Int64(flagONFTStoreHeight, 0, "Height to load, defaults to the latest height")
+	cmd.PersistentFlags().String(flagONFTStoreDBBackend, string(dbm.GoLevelDBBackend), "Database backend of the application db")
+	cmd.PersistentFlags().String(flagONFTStorePrefix, "", "Only include entries of this key prefix, like PrefixONFT")
+
+	return cmd
+}
+
+func onftStoreDumpCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "dump [data-dir]",
+		Short: "Dump the decoded onft store of a data directory as JSON lines",
+		Long: `Dump the decoded onft store of a data directory as JSON lines. The first
+line holds the height and hash of the store.
+
+Example:
+	onftd debug onft-store dump ~/.onftd/data --height 1200
+	`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cdc := app.MakeEncodingConfig().Marshaler
+			store, header, closeDB, err := loadONFTStore(cmd, args[0])
+			if err != nil {
+				return err
+			}
+			defer closeDB()
+
+			prefix, err := cmd.Flags().GetString(flagONFTStorePrefix)
+			if err != nil {
+				return err
+			}
+
+			if err := printJSONLine(cmd, header); err != nil {
+				return err
+			}
+			iterator := store.Iterator(nil, nil)
+			defer iterator.Close()
+			for ; iterator.Valid(); iterator.Next() {
+				entry := decodeONFTStoreEntry(cdc, iterator.Key(), iterator.Value())
+				if prefix != "" && entry.Prefix != prefix {
+					continue
+				}
+				if err := printJSONLine(cmd, entry); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+	}
+}
+
+func onftStoreDiffCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "diff [data-dir-a] [data-dir-b]",
+		Short: "Diff the decoded onft stores of two data directories as JSON lines",
+		Long: `Diff the decoded onft stores of two data directories as JSON lines. The
+first two lines hold the height and hash of each store, followed by every
+entry missing from one of the stores or stored with different values.
+
+Example:
+	onftd debug onft-store diff node0/data node1/data --height 1200
+	`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cdc := app.MakeEncodingConfig().Marshaler
+			storeA, headerA, closeA, err := loadONFTStore(cmd, args[0])
+			if err != nil {
+				return err
+			}
+			defer closeA()
+			storeB, headerB, closeB, err := loadONFTStore(cmd, args[1])
+			if err != nil {
+				return err
+			}
+			defer closeB()
+
+			prefix, err := cmd.Flags().GetString(flagONFTStorePrefix)
+			if err != nil {
+				return err
+			}
+
+			if err := printJSONLine(cmd, headerA); err != nil {
+				return err
+			}
+			if err := printJSONLine(cmd, headerB); err != nil {
+				return err
+			}
+
+			iteratorA := storeA.Iterator(nil, nil)
+			defer iteratorA.Close()
+			iteratorB := storeB.Iterator(nil, nil)
+			defer iteratorB.Close()
+
+			differences := 0
+			for iteratorA.Valid() || iteratorB.Valid() {
+				var diff onftStoreDiff
+				switch {
+				case !iteratorB.Valid() || (iteratorA.Valid() && bytes.Compare(iteratorA.Key(), iteratorB.Key()) < 0):
+					diff.A = decodeONFTStoreEntry(cdc, iteratorA.Key(), iteratorA.Value())
+					iteratorA.Next()
+				case !iteratorA.Valid() || bytes.Compare(iteratorA.Key(), iteratorB.Key()) > 0:
+					diff.B = decodeONFTStoreEntry(cdc, iteratorB.Key(), iteratorB.Value())
+					iteratorB.Next()
+				default:
+					if !bytes.Equal(iteratorA.Value(), iteratorB.Value()) {
+						diff.A = decodeONFTStoreEntry(cdc, iteratorA.Key(), iteratorA.Value())
+						diff.B = decodeONFTStoreEntry(cdc, iteratorB.Key(), iteratorB.Value())
+					}
+					iteratorA.Next()
+					iteratorB.Next()
+				}
+
+				entry := diff.A
+				if entry == nil {
+					entry = diff.B
+				}
+				if entry == nil || (prefix != "" && entry.Prefix != prefix) {
+					continue
+				}
+				diff.Prefix, diff.Key = entry.Prefix, entry.Key
+				if err := printJSONLine(cmd, diff); err != nil {
+					return err
+				}
+				differences++
+			}
+
+			cmd.PrintErrf("%d differences\n", differences)
+			return nil
+		},
+	}
+}
+
+// loadONFTStore loads the onft store from the application db of a data
+// directory, at the height of the height flag or at the latest height.
+func loadONFTStore(cmd *cobra.Command, dataDir string) (storetypes.CommitKVStore, onftStoreHeader, func(), error) {
+	height, err := cmd.Flags().GetInt64(flagONFTStoreHeight)
+	if err != nil {
+		return nil, onftStoreHeader{}, nil, err
+	}
+	backend, err := cmd.Flags().GetString(flagONFTStoreDBBackend)
+	if err != nil {
+		return nil, onftStoreHeader{}, nil, err
+	}
+
+	db, err := dbm.NewDB("application", dbm.BackendType(backend), dataDir)
+	if err != nil {
+		return nil, onftStoreHeader{}, nil, err
+	}
+	closeDB := func() { _ = db.Close() }
+
+	cms := rootmulti.NewStore(db, log.NewNopLogger())
+	// loading with fast nodes enabled would upgrade and write to the db
+	cms.SetIAVLDisableFastNode(true)
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	cms.MountStoreWithDB(key, storetypes.StoreTypeIAVL, nil)
+	if height == 0 {
+		err = cms.LoadLatestVersion()
+	} else {
+		err = cms.LoadVersion(height)
+	}
+	if err != nil {
+		closeDB()
+		return nil, onftStoreHeader{}, nil, fmt.Errorf("failed to load %s: %w", dataDir, err)
+	}
+
+	store := cms.GetCommitKVStore(key)
+	commitID := store.LastCommitID()
+	header := onftStoreHeader{
+		DataDir: dataDir,
+		Height:  commitID.Version,
+		Hash:    hex.EncodeToString(commitID.Hash),
+	}
+	return store, header, closeDB, nil
+}
+
+func decodeONFTStoreEntry(cdc codec.Codec, key, value []byte) *onftStoreEntry {
+	entry := &onftStoreEntry{Key: hex.EncodeToString(key)}
+	prefix, err := simulation.PrefixName(key)
+	if err != nil {
+		entry.Raw, entry.Error = hex.EncodeToString(value), err.Error()
+		return entry
+	}
+	entry.Prefix = prefix
+
+	msg, err := simulation.DecodeValue(cdc, key, value)
+	if err == nil {
+		entry.Value, err = cdc.MarshalJSON(msg)
+	}
+	if err != nil {
+		entry.Value, entry.Raw, entry.Error = nil, hex.EncodeToString(value), err.Error()
+	}
+	return entry
+}
+
+func printJSONLine(cmd *cobra.Command, v interface{}) error {
+	bz, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	cmd.Println(string(bz))
+	return nil
+}
@@ -16,13 +16,132 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, data types.GenesisState) {
 			panic(err)
 		}
 	}
+	for _, count := range data.EditionCounts {
+		k.SetEditionCount(ctx, count)
+	}
+	for _, claim := range data.Claims {
+		k.SetClaim(ctx, claim)
+	}
+	for _, commitment := range data.ClaimCommitments {
+		k.SetClaimCommitment(ctx, commitment)
+	}
+	for _, record := range data.ClaimRecords {
+		k.SetClaimRecord(ctx, record)
+	}
+	if data.NextClaimId > 0 {
+		k.SetNextClaimID(ctx, data.NextClaimId)
+	}
+	for _, airdrop := range data.Airdrops {
+		k.SetMintAirdrop(ctx, airdrop)
+	}
+	for _, record := range data.AirdropClaimRecords {
+		k.SetAirdropClaimRecord(ctx, record)
+	}
+	if data.NextAirdropId > 0 {
+		k.SetNextAirdropID(ctx, data.NextAirdropId)
+	}
+	for _, swap := range data.Swaps {
+		k.SetSwap(ctx, swap)
+	}
+	if data.NextSwapId > 0 {
+		k.SetNextSwapID(ctx, data.NextSwapId)
+	}
+	for _, listing := range data.Listings {
+		k.SetListing(ctx, listing)
+	}
+	if data.NextListingId > 0 {
+		k.SetNextListingID(ctx, data.NextListingId)
+	}
+	for _, offer := range data.Offers {
+		k.SetOffer(ctx, offer)
+	}
+	if data.NextOfferId > 0 {
+		k.SetNextOfferID(ctx, data.NextOfferId)
+	}
+	for _, auction := range data.Auctions {
+		k.SetAuction(ctx, auction)
+	}
+	k.SetBids(ctx, data.Bids)
+	if data.NextAuctionId > 0 {
+		k.SetNextAuctionID(ctx, data.NextAuctionId)
+	}
+	for _, loan := range data.Loans {
+		k.SetLoan(ctx, loan)
+	}
+	if data.NextLoanId > 0 {
+		k.SetNextLoanID(ctx, data.NextLoanId)
+	}
+	for _, fractionalization := range data.Fractionalizations {
+		k.SetFractionalization(ctx, fractionalization)
+	}
+	for _, nesting := range data.Nestings {
+		k.SetNesting(ctx, nesting)
+	}
+	for _, ref := range data.TokenAccounts {
+		k.SetTokenAccount(ctx, ref.DenomId, ref.OnftId)
+	}
+	for _, recipe := range data.Recipes {
+		k.SetRecipe(ctx, recipe)
+	}
+	if data.NextRecipeId > 0 {
+		k.SetNextRecipeID(ctx, data.NextRecipeId)
+	}
+	for _, commitment := range data.MetadataCommitments {
+		k.SetMetadataCommitment(ctx, commitment)
+	}
+	for _, ref := range data.UnrevealedOnfts {
+		k.SetUnrevealedONFT(ctx, ref.DenomId, ref.OnftId)
+	}
+	for _, revocation := range data.Revocations {
+		k.SetRevocation(ctx, revocation)
+	}
+	for _, renewal := range data.Renewals {
+		k.SetRenewal(ctx, renewal)
+	}
+	for _, status := range data.ModerationStatuses {
+		k.SetModerationStatus(ctx, status)
+	}
+	for _, exemption := range data.FeeExemptions {
+		k.SetFeeExemptionEntry(ctx, exemption)
+	}
 	if err := k.SetParams(ctx, data.Params); err != nil {
 		panic(err)
 	}
 }
 
 func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
-	return types.NewGenesisState(k.GetCollections(ctx), k.GetParams(ctx))
+	genesisState := types.NewGenesisState(k.GetCollections(ctx), k.GetParams(ctx))
+	genesisState.EditionCounts = k.GetEditionCounts(ctx)
+	genesisState.Claims = k.GetClaims(ctx)
+	genesisState.ClaimCommitments = k.GetClaimCommitments(ctx)
+	genesisState.ClaimRecords = k.GetClaimRecords(ctx)
+	genesisState.NextClaimId = k.GetNextClaimID(ctx)
+	genesisState.Airdrops = k.GetMintAirdrops(ctx)
+	genesisState.AirdropClaimRecords = k.GetAirdropClaimRecords(ctx)
+	genesisState.NextAirdropId = k.GetNextAirdropID(ctx)
+	genesisState.Swaps = k.GetSwaps(ctx)
+	genesisState.NextSwapId = k.GetNextSwapID(ctx)
+	genesisState.Listings = k.GetListings(ctx)
+	genesisState.NextListingId = k.GetNextListingID(ctx)
+	genesisState.Offers = k.GetOffers(ctx)
+	genesisState.NextOfferId = k.GetNextOfferID(ctx)
+	genesisState.Auctions = k.GetAuctions(ctx)
+	genesisState.Bids = k.GetBids(ctx)
+	genesisState.NextAuctionId = k.GetNextAuctionID(ctx)
+	genesisState.Loans = k.GetLoans(ctx)
+	genesisState.NextLoanId = k.GetNextLoanID(ctx)
+	genesisState.Fractionalizations = k.GetFractionalizations(ctx)
+	genesisState.Nestings = k.GetNestings(ctx)
+	genesisState.TokenAccounts = k.GetTokenAccounts(ctx)
+	genesisState.Recipes = k.GetRecipes(ctx)
+	genesisState.NextRecipeId = k.GetNextRecipeID(ctx)
+	genesisState.MetadataCommitments = k.GetMetadataCommitments(ctx)
+	genesisState.UnrevealedOnfts = k.GetUnrevealedONFTs(ctx)
+	genesisState.Revocations = k.GetRevocations(ctx)
+	genesisState.Renewals = k.GetRenewals(ctx)
+	genesisState.ModerationStatuses = k.GetModerationStatuses(ctx)
+	genesisState.FeeExemptions = k.GetFeeExemptions(ctx)
+	return genesisState
 }
 
 func DefaultGenesisState() *types.GenesisState {
@@ -0,0 +1,302 @@
+//go:build onftindex
+
+package indexer
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"strconv"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/OmniFlix/onft/simulation"
+	"github.com/OmniFlix/onft/types"
+)
+
+const (
+	actionListed  = "listed"
+	actionUpdated = "updated"
+	actionRemoved = "removed"
+)
+
+// applyPair applies a single write of the onft store. Writes under prefixes
+// the index does not cover are ignored.
+func (idx *Index) applyPair(tx *sql.Tx, height int64, pair storetypes.StoreKVPair) error {
+	if len(pair.Key) < 2 {
+		return nil
+	}
+	switch {
+	case bytes.Equal(pair.Key[:1], types.PrefixONFT):
+		denomID, onftID, err := types.SplitKeyDenom(pair.Key[len(types.PrefixONFT)+1:])
+		if err != nil {
+			return err
+		}
+		if pair.Delete {
+			return deleteONFT(tx, height, denomID, onftID)
+		}
+		value, err := simulation.DecodeValue(idx.cdc, pair.Key, pair.Value)
+		if err != nil {
+			return err
+		}
+		return idx.setONFT(tx, height, denomID, value.(*types.ONFT))
+	case bytes.Equal(pair.Key[:1], types.PrefixDenom):
+		if pair.Delete {
+			_, err := tx.Exec(`DELETE FROM denoms WHERE id = ?`, string(pair.Key[len(types.PrefixDenom)+1:]))
+			return err
+		}
+		value, err := simulation.DecodeValue(idx.cdc, pair.Key, pair.Value)
+		if err != nil {
+			return err
+		}
+		return idx.setDenom(tx, height, value.(*types.Denom))
+	case bytes.Equal(pair.Key[:1], types.PrefixListing):
+		if pair.Delete {
+			return deleteListing(tx, height, sdk.BigEndianToUint64(pair.Key[len(types.PrefixListing)+1:]))
+		}
+		value, err := simulation.DecodeValue(idx.cdc, pair.Key, pair.Value)
+		if err != nil {
+			return err
+		}
+		return idx.setListing(tx, height, value.(*types.Listing))
+	default:
+		return nil
+	}
+}
+
+// resyncPairs returns the writes turning the indexed state into the state of
+// the store: every indexed record of the store, and a delete for every
+// indexed record missing from it.
+func resyncPairs(tx *sql.Tx, store storetypes.KVStore) ([]storetypes.StoreKVPair, error) {
+	var pairs []storetypes.StoreKVPair
+	stored := make(map[string]bool)
+	for _, prefix := range [][]byte{types.KeyDenomID(""), types.KeyONFT("", ""), types.KeyListing(0)} {
+		iterator := sdk.KVStorePrefixIterator(store, prefix)
+		for ; iterator.Valid(); iterator.Next() {
+			key := append([]byte{}, iterator.Key()...)
+			pairs = append(pairs, storetypes.StoreKVPair{Key: key, Value: append([]byte{}, iterator.Value()...)})
+			stored[string(key)] = true
+		}
+		if err := iterator.Close(); err != nil {
+			return nil, err
+		}
+	}
+
+	rows, err := tx.Query(`SELECT 'denom', id, '' FROM denoms
+		UNION ALL SELECT 'onft', denom_id, id FROM onfts
+		UNION ALL SELECT 'listing', CAST(id AS TEXT), '' FROM listings`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var indexed [][]byte
+	for rows.Next() {
+		var table, id, onftID string
+		if err := rows.Scan(&table, &id, &onftID); err != nil {
+			return nil, err
+		}
+		switch table {
+		case "denom":
+			indexed = append(indexed, types.KeyDenomID(id))
+		case "onft":
+			indexed = append(indexed, types.KeyONFT(id, onftID))
+		case "listing":
+			listingID, err := strconv.ParseUint(id, 10, 64)
+			if err != nil {
+				return nil, err
+			}
+			indexed = append(indexed, types.KeyListing(listingID))
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	for _, key := range indexed {
+		if !stored[string(key)] {
+			pairs = append(pairs, storetypes.StoreKVPair{Key: key, Delete: true})
+		}
+	}
+	return pairs, nil
+}
+
+func (idx *Index) setDenom(tx *sql.Tx, height int64, denom *types.Denom) error {
+	bz, err := idx.cdc.MarshalJSON(denom)
+	if err != nil {
+		return err
+	}
+	_, err = tx.Exec(
+		`INSERT OR REPLACE INTO denoms
+		(id, symbol, name, creator, schema, description, preview_uri, credential, clawback, updated_height, json)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+		denom.Id, denom.Symbol, denom.Name, denom.Creator, denom.Schema, denom.Description,
+		denom.PreviewURI, denom.Credential, denom.ClawbackEnabled, height, string(bz),
+	)
+	return err
+}
+
+func (idx *Index) setONFT(tx *sql.Tx, height int64, denomID string, onft *types.ONFT) error {
+	bz, err := idx.cdc.MarshalJSON(onft)
+	if err != nil {
+		return err
+	}
+
+	var previousOwner string
+	err = tx.QueryRow(`SELECT owner FROM onfts WHERE denom_id = ? AND id = ?`, denomID, onft.Id).Scan(&previousOwner)
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		previousOwner = ""
+	case err != nil:
+		return err
+	}
+
+	var expiresAt interface{}
+	if onft.ExpiresAt != nil {
+		expiresAt = sdk.FormatTimeString(*onft.ExpiresAt)
+	}
+	if _, err := tx.Exec(
+		`INSERT OR REPLACE INTO onfts
+		(denom_id, id, owner, name, description, media_uri, preview_uri, data, transferable, extensible,
+		nsfw, royalty_share, created_at, expires_at, updated_height, json)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+		denomID, onft.Id, onft.Owner, onft.Metadata.Name, onft.Metadata.Description, onft.Metadata.MediaURI,
+		onft.Metadata.PreviewURI, onft.Data, onft.Transferable, onft.Extensible, onft.Nsfw,
+		onft.RoyaltyShare.String(), sdk.FormatTimeString(onft.CreatedAt), expiresAt, height, string(bz),
+	); err != nil {
+		return err
+	}
+
+	if onft.Owner != previousOwner {
+		if _, err := tx.Exec(
+			`INSERT OR REPLACE INTO ownership_history (denom_id, onft_id, height, owner) VALUES (?, ?, ?, ?)`,
+			denomID, onft.Id, height, onft.Owner,
+		); err != nil {
+			return err
+		}
+	}
+
+	if _, err := tx.Exec(`DELETE FROM onft_traits WHERE denom_id = ? AND onft_id = ?`, denomID, onft.Id); err != nil {
+		return err
+	}
+	for _, trait := range parseTraits(onft.Data) {
+		if _, err := tx.Exec(
+			`INSERT OR REPLACE INTO onft_traits (denom_id, onft_id, trait_type, value) VALUES (?, ?, ?, ?)`,
+			denomID, onft.Id, trait.TraitType, trait.Value,
+		); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func deleteONFT(tx *sql.Tx, height int64, denomID, onftID string) error {
+	if _, err := tx.Exec(`DELETE FROM onfts WHERE denom_id = ? AND id = ?`, denomID, onftID); err != nil {
+		return err
+	}
+	if _, err := tx.Exec(`DELETE FROM onft_traits WHERE denom_id = ? AND onft_id = ?`, denomID, onftID); err != nil {
+		return err
+	}
+	_, err := tx.Exec(
+		`INSERT OR REPLACE INTO ownership_history (denom_id, onft_id, height, owner) VALUES (?, ?, ?, '')`,
+		denomID, onftID, height,
+	)
+	return err
+}
+
+func (idx *Index) setListing(tx *sql.Tx, height int64, listing *types.Listing) error {
+	bz, err := idx.cdc.MarshalJSON(listing)
+	if err != nil {
+		return err
+	}
+
+	action := actionListed
+	listedHeight := height
+	var priceDenom, priceAmount string
+	err = tx.QueryRow(
+		`SELECT price_denom, price_amount, listed_height FROM listings WHERE id = ?`, listing.Id,
+	).Scan(&priceDenom, &priceAmount, &listedHeight)
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+	case err != nil:
+		return err
+	case priceDenom == listing.Price.Denom && priceAmount == listing.Price.Amount.String():
+		action = ""
+	default:
+		action = actionUpdated
+	}
+
+	if _, err := tx.Exec(
+		`INSERT OR REPLACE INTO listings
+		(id, denom_id, onft_id, seller, price_denom, price_amount, expiry, listed_height, json)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+		listing.Id, listing.DenomId, listing.OnftId, listing.Seller, listing.Price.Denom,
+		listing.Price.Amount.String(), sdk.FormatTimeString(listing.Expiry), listedHeight, string(bz),
+	); err != nil {
+		return err
+	}
+	if action == "" {
+		return nil
+	}
+	_, err = tx.Exec(
+		`INSERT OR REPLACE INTO listing_history
+		(listing_id, height, denom_id, onft_id, seller, price_denom, price_amount, action)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?)`,
+		listing.Id, height, listing.DenomId, listing.OnftId, listing.Seller, listing.Price.Denom,
+		listing.Price.Amount.String(), action,
+	)
+	return err
+}
+
+func deleteListing(tx *sql.Tx, height int64, id uint64) error {
+	_, err := tx.Exec(
+		`INSERT OR REPLACE INTO listing_history
+		(listing_id, height, denom_id, onft_id, seller, price_denom, price_amount, action)
+		SELECT id, ?, denom_id, onft_id, seller, price_denom, price_amount, ?
+		FROM listings WHERE id = ?`,
+		height, actionRemoved, id,
+	)
+	if err != nil {
+		return err
+	}
+	_, err = tx.Exec(`DELETE FROM listings WHERE id = ?`, id)
+	return err
+}
+
+// trait is a single entry of the `attributes` array of the oNFT data.
+type trait struct {
+	TraitType string
+	Value     string
+}
+
+// parseTraits returns the traits of the oNFT data in document order. Data
+// that is not a JSON object with an `attributes` array has no traits.
+func parseTraits(data string) []trait {
+	var doc struct {
+		Attributes []struct {
+			TraitType string          `json:"trait_type"`
+			Value     json.RawMessage `json:"value"`
+		} `json:"attributes"`
+	}
+	if err := json.Unmarshal([]byte(data), &doc); err != nil {
+		return nil
+	}
+
+	traits := make([]trait, 0, len(doc.Attributes))
+	for _, attribute := range doc.Attributes {
+		if attribute.TraitType == "" || len(attribute.Value) == 0 {
+			continue
+		}
+		var value string
+		if err := json.Unmarshal(attribute.Value, &value); err != nil {
+			var buf bytes.Buffer
+			if err := json.Compact(&buf, attribute.Value); err != nil {
+				continue
+			}
+			value = buf.String()
+		}
+		traits = append(traits, trait{TraitType: attribute.TraitType, Value: value})
+	}
+	return traits
+}
@@ -0,0 +1,238 @@
+//go:build onftindex
+
+package indexer
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+)
+
+const (
+	FlagDBPath  = "db-path"
+	FlagDenomID = "denom-id"
+	FlagLimit   = "limit"
+	FlagOffset  = "offset"
+)
+
+// GetIndexCmd returns the commands querying a local onft index.
+func GetIndexCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        "onft-index",
+		Short:                      "Query the local onft index",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		GetCmdIndexStatus(),
+		GetCmdIndexONFTsByOwner(),
+		GetCmdIndexONFTsByTrait(),
+		GetCmdIndexOwnershipHistory(),
+		GetCmdIndexPriceHistory(),
+		GetCmdIndexSales(),
+		GetCmdIndexSQL(),
+	)
+	cmd.PersistentFlags().String(FlagDBPath, "", "Path of the index database, defaults to data/onft-index.db in the home directory")
+
+	return cmd
+}
+
+// GetCmdIndexStatus queries the height and size of the index
+func GetCmdIndexStatus() *cobra.Command {
+	return &cobra.Command{
+		Use:     "status",
+		Long:    "Query the last indexed height, the last resync height and the number of indexed records.",
+		Example: "$ onftd onft-index status",
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runIndexQuery(cmd, `SELECT
+				(SELECT last_height FROM index_state WHERE id = 1) AS last_height,
+				(SELECT schema_version FROM index_state WHERE id = 1) AS schema_version,
+				(SELECT MAX(height) FROM resyncs) AS last_resync_height,
+				(SELECT COUNT(*) FROM denoms) AS denoms,
+				(SELECT COUNT(*) FROM onfts) AS onfts,
+				(SELECT COUNT(*) FROM listings) AS listings,
+				(SELECT COUNT(*) FROM sales) AS sales`)
+		},
+	}
+}
+
+// GetCmdIndexONFTsByOwner queries the oNFTs of an owner
+func GetCmdIndexONFTsByOwner() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "owner [address]",
+		Long:    "Query the oNFTs of an owner, newest first.",
+		Example: "$ onftd onft-index owner <address> --denom-id=<denom-id>",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			denomID, err := cmd.Flags().GetString(FlagDenomID)
+			if err != nil {
+				return err
+			}
+			return runIndexQuery(cmd, `SELECT denom_id, id, name, media_uri, transferable, nsfw, created_at
+				FROM onfts WHERE owner = ? AND (? = '' OR denom_id = ?)
+				ORDER BY created_at DESC, denom_id, id`,
+				args[0], denomID, denomID)
+		},
+	}
+	cmd.Flags().String(FlagDenomID, "", "Only query oNFTs of this denom")
+	addPaginationFlags(cmd)
+	return cmd
+}
+
+// GetCmdIndexONFTsByTrait queries the oNFTs having a trait value
+func GetCmdIndexONFTsByTrait() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "trait [trait-type] [value]",
+		Long:    "Query the oNFTs having the given trait value.",
+		Example: "$ onftd onft-index trait background blue --denom-id=<denom-id>",
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			denomID, err := cmd.Flags().GetString(FlagDenomID)
+			if err != nil {
+				return err
+			}
+			return runIndexQuery(cmd, `SELECT o.denom_id, o.id, o.name, o.owner, o.created_at
+				FROM onft_traits t JOIN onfts o ON o.denom_id = t.denom_id AND o.id = t.onft_id
+				WHERE t.trait_type = ? AND t.value = ? AND (? = '' OR t.denom_id = ?)
+				ORDER BY o.denom_id, o.id`,
+				args[0], args[1], denomID, denomID)
+		},
+	}
+	cmd.Flags().String(FlagDenomID, "", "Only query oNFTs of this denom")
+	addPaginationFlags(cmd)
+	return cmd
+}
+
+// GetCmdIndexOwnershipHistory queries the owners of an oNFT over time
+func GetCmdIndexOwnershipHistory() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "ownership-history [denom-id] [onft-id]",
+		Long:    "Query the owners of an oNFT over time. An empty owner marks a burn.",
+		Example: "$ onftd onft-index ownership-history <denom-id> <onft-id>",
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runIndexQuery(cmd, `SELECT h.height, b.time, h.owner
+				FROM ownership_history h JOIN blocks b ON b.height = h.height
+				WHERE h.denom_id = ? AND h.onft_id = ?
+				ORDER BY h.height`,
+				args[0], args[1])
+		},
+	}
+	addPaginationFlags(cmd)
+	return cmd
+}
+
+// GetCmdIndexPriceHistory queries the listing prices of an oNFT over time
+func GetCmdIndexPriceHistory() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "price-history [denom-id] [onft-id]",
+		Long:    "Query the listing prices of an oNFT over time.",
+		Example: "$ onftd onft-index price-history <denom-id> <onft-id>",
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runIndexQuery(cmd, `SELECT h.height, b.time, h.listing_id, h.seller, h.price_denom, h.price_amount, h.action
+				FROM listing_history h JOIN blocks b ON b.height = h.height
+				WHERE h.denom_id = ? AND h.onft_id = ?
+				ORDER BY h.height, h.listing_id`,
+				args[0], args[1])
+		},
+	}
+	addPaginationFlags(cmd)
+	return cmd
+}
+
+// GetCmdIndexSales queries the prices an oNFT was sold for
+func GetCmdIndexSales() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "sales [denom-id] [onft-id]",
+		Long:    "Query the listing and auction sales of an oNFT with the price paid, oldest first.",
+		Example: "$ onftd onft-index sales <denom-id> <onft-id>",
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runIndexQuery(cmd, `SELECT s.height, b.time, s.source, s.source_id, s.seller, s.buyer,
+				s.price_denom, s.price_amount, s.tx_hash
+				FROM sales s JOIN blocks b ON b.height = s.height
+				WHERE s.denom_id = ? AND s.onft_id = ?
+				ORDER BY s.height, s.source, s.source_id`,
+				args[0], args[1])
+		},
+	}
+	addPaginationFlags(cmd)
+	return cmd
+}
+
+// GetCmdIndexSQL runs a read only SQL query against the index
+func GetCmdIndexSQL() *cobra.Command {
+	return &cobra.Command{
+		Use:     "sql [query]",
+		Long:    "Run a read only SQL query against the index. See the readme for the schema.",
+		Example: "$ onftd onft-index sql \"SELECT owner, COUNT(*) FROM onfts GROUP BY owner\"",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runIndexQuery(cmd, args[0])
+		},
+	}
+}
+
+func addPaginationFlags(cmd *cobra.Command) {
+	cmd.Flags().Uint64(FlagLimit, 100, "Maximum number of rows to return")
+	cmd.Flags().Uint64(FlagOffset, 0, "Number of rows to skip")
+}
+
+// runIndexQuery opens the index read only, runs the query and prints the
+// rows as JSON. Commands with pagination flags get LIMIT and OFFSET appended.
+func runIndexQuery(cmd *cobra.Command, query string, args ...interface{}) error {
+	path, err := indexPath(cmd)
+	if err != nil {
+		return err
+	}
+	if cmd.Flags().Lookup(FlagLimit) != nil {
+		limit, err := cmd.Flags().GetUint64(FlagLimit)
+		if err != nil {
+			return err
+		}
+		offset, err := cmd.Flags().GetUint64(FlagOffset)
+		if err != nil {
+			return err
+		}
+		query = fmt.Sprintf("%s LIMIT %d OFFSET %d", query, limit, offset)
+	}
+
+	index, err := OpenReadOnly(path)
+	if err != nil {
+		return err
+	}
+	defer index.Close()
+
+	rows, err := index.Query(query, args...)
+	if err != nil {
+		return err
+	}
+	if rows == nil {
+		rows = []map[string]interface{}{}
+	}
+	bz, err := json.MarshalIndent(rows, "", "  ")
+	if err != nil {
+		return err
+	}
+	cmd.Println(string(bz))
+	return nil
+}
+
+func indexPath(cmd *cobra.Command) (string, error) {
+	path, err := cmd.Flags().GetString(FlagDBPath)
+	if err != nil || path != "" {
+		return path, err
+	}
+	home, err := cmd.Flags().GetString(flags.FlagHome)
+	if err != nil {
+		return "", err
+	}
+	return DefaultDBPath(home), nil
+}
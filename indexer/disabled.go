@@ -0,0 +1,47 @@
+//go:build !onftindex
+
+package indexer
+
+import (
+	"fmt"
+
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+)
+
+// errNotBuilt is returned by binaries built without the index.
+var errNotBuilt = fmt.Errorf("onftd was built without the onft index, rebuild it with the %s build tag", BuildTag)
+
+// RegisterStreamingService fails when the index is enabled in the app
+// options, as the index is not linked into this binary.
+func RegisterStreamingService(
+	_ *baseapp.BaseApp,
+	appOpts servertypes.AppOptions,
+	_ string,
+	_ storetypes.StoreKey,
+	_ codec.Codec,
+	_ log.Logger,
+) error {
+	if cast.ToBool(appOpts.Get(OptEnabled)) {
+		return errNotBuilt
+	}
+	return nil
+}
+
+// GetIndexCmd returns a placeholder for the index commands, which are not
+// linked into this binary.
+func GetIndexCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:    "onft-index",
+		Short:  "Query the local onft index, requires a build with the " + BuildTag + " build tag",
+		Hidden: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return errNotBuilt
+		},
+	}
+}
@@ -0,0 +1,209 @@
+//go:build onftindex
+
+package indexer
+
+import (
+	"database/sql"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	// registers the sqlite3 database driver
+	_ "github.com/mattn/go-sqlite3"
+)
+
+// Index is the SQLite database holding the onft index.
+type Index struct {
+	db  *sql.DB
+	cdc codec.Codec
+}
+
+// Open opens the index at path for writing, creating the database and its
+// schema when they do not exist yet.
+func Open(path string, cdc codec.Codec) (*Index, error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return nil, err
+	}
+	db, err := sql.Open("sqlite3", fmt.Sprintf("file:%s?_journal_mode=WAL&_synchronous=FULL&_busy_timeout=5000", path))
+	if err != nil {
+		return nil, err
+	}
+	// a single connection serializes the writes of the streaming service
+	db.SetMaxOpenConns(1)
+
+	if _, err := db.Exec(Schema); err != nil {
+		db.Close()
+		return nil, err
+	}
+	if _, err := db.Exec(
+		`INSERT OR IGNORE INTO index_state (id, schema_version, last_height) VALUES (1, ?, 0)`,
+		SchemaVersion,
+	); err != nil {
+		db.Close()
+		return nil, err
+	}
+
+	index := &Index{db: db, cdc: cdc}
+	if err := index.checkSchemaVersion(); err != nil {
+		db.Close()
+		return nil, err
+	}
+	return index, nil
+}
+
+// OpenReadOnly opens an existing index at path for queries.
+func OpenReadOnly(path string) (*Index, error) {
+	if _, err := os.Stat(path); err != nil {
+		return nil, err
+	}
+	db, err := sql.Open("sqlite3", fmt.Sprintf("file:%s?mode=ro&_busy_timeout=5000", path))
+	if err != nil {
+		return nil, err
+	}
+
+	index := &Index{db: db}
+	if err := index.checkSchemaVersion(); err != nil {
+		db.Close()
+		return nil, err
+	}
+	return index, nil
+}
+
+// Close closes the database.
+func (idx *Index) Close() error {
+	return idx.db.Close()
+}
+
+func (idx *Index) checkSchemaVersion() error {
+	var version int
+	if err := idx.db.QueryRow(`SELECT schema_version FROM index_state WHERE id = 1`).Scan(&version); err != nil {
+		return err
+	}
+	if version != SchemaVersion {
+		return fmt.Errorf("index schema version %d does not match %d, the index has to be rebuilt", version, SchemaVersion)
+	}
+	return nil
+}
+
+// LastHeight returns the height of the last indexed block, or 0 when no
+// block has been indexed yet.
+func (idx *Index) LastHeight() (int64, error) {
+	var height int64
+	err := idx.db.QueryRow(`SELECT last_height FROM index_state WHERE id = 1`).Scan(&height)
+	return height, err
+}
+
+// ApplyBlock applies the writes and sales of a block to the index and records
+// it as the last indexed block. All changes are applied in a single
+// transaction, so the index never holds a partially applied block.
+func (idx *Index) ApplyBlock(height int64, blockTime time.Time, pairs []storetypes.StoreKVPair, sales []sale) error {
+	return idx.update(height, blockTime, func(tx *sql.Tx) error {
+		if err := idx.applyPairs(tx, height, pairs); err != nil {
+			return err
+		}
+		return insertSales(tx, height, sales)
+	})
+}
+
+// Resync rebuilds the latest state of the index from the committed onft store
+// at height, for an index that missed the blocks since its last height. The
+// changes and the given sales of the blocks still known are recorded at
+// height, and the missed blocks in the resyncs table.
+func (idx *Index) Resync(height int64, blockTime time.Time, store storetypes.KVStore, sales []sale) error {
+	lastHeight, err := idx.LastHeight()
+	if err != nil {
+		return err
+	}
+	return idx.update(height, blockTime, func(tx *sql.Tx) error {
+		pairs, err := resyncPairs(tx, store)
+		if err != nil {
+			return err
+		}
+		if err := idx.applyPairs(tx, height, pairs); err != nil {
+			return err
+		}
+		if err := insertSales(tx, height, sales); err != nil {
+			return err
+		}
+		_, err = tx.Exec(`INSERT OR REPLACE INTO resyncs (height, last_height) VALUES (?, ?)`, height, lastHeight)
+		return err
+	})
+}
+
+// update runs apply in a transaction recording the block and updating the
+// last indexed height.
+func (idx *Index) update(height int64, blockTime time.Time, apply func(tx *sql.Tx) error) (err error) {
+	tx, err := idx.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	if _, err = tx.Exec(
+		`INSERT OR REPLACE INTO blocks (height, time) VALUES (?, ?)`,
+		height, sdk.FormatTimeString(blockTime),
+	); err != nil {
+		return err
+	}
+	if err = apply(tx); err != nil {
+		return err
+	}
+	if _, err = tx.Exec(`UPDATE index_state SET last_height = ? WHERE id = 1`, height); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+func (idx *Index) applyPairs(tx *sql.Tx, height int64, pairs []storetypes.StoreKVPair) error {
+	for _, pair := range pairs {
+		if err := idx.applyPair(tx, height, pair); err != nil {
+			return fmt.Errorf("failed to index key %X: %w", pair.Key, err)
+		}
+	}
+	return nil
+}
+
+// Query runs a read only query against the index and returns its rows as
+// column name to value maps.
+func (idx *Index) Query(query string, args ...interface{}) ([]map[string]interface{}, error) {
+	rows, err := idx.db.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	columns, err := rows.Columns()
+	if err != nil {
+		return nil, err
+	}
+	var result []map[string]interface{}
+	for rows.Next() {
+		values := make([]interface{}, len(columns))
+		pointers := make([]interface{}, len(columns))
+		for i := range values {
+			pointers[i] = &values[i]
+		}
+		if err := rows.Scan(pointers...); err != nil {
+			return nil, err
+		}
+
+		row := make(map[string]interface{}, len(columns))
+		for i, column := range columns {
+			if b, ok := values[i].([]byte); ok {
+				values[i] = string(b)
+			}
+			row[column] = values[i]
+		}
+		result = append(result, row)
+	}
+	return result, rows.Err()
+}
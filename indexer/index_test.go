@@ -0,0 +1,421 @@
+//go:build onftindex
+
+package indexer
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/libs/log"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/stretchr/testify/require"
+
+	"github.com/OmniFlix/onft"
+	"github.com/OmniFlix/onft/types"
+)
+
+var (
+	alice = sdk.AccAddress("alice_______________")
+	bob   = sdk.AccAddress("bob_________________")
+)
+
+func testCodec() codec.Codec {
+	return moduletestutil.MakeTestEncodingConfig(onft.AppModuleBasic{}).Codec
+}
+
+func testONFT(id string, owner sdk.AccAddress, data string) types.ONFT {
+	return types.ONFT{
+		Id:           id,
+		Owner:        owner.String(),
+		Data:         data,
+		Transferable: true,
+		RoyaltyShare: sdk.ZeroDec(),
+		CreatedAt:    time.Unix(0, 0).UTC(),
+	}
+}
+
+func testListing(id uint64, amount int64) types.Listing {
+	return types.Listing{
+		Id:      id,
+		DenomId: "denom1",
+		OnftId:  "onft1",
+		Seller:  alice.String(),
+		Price:   sdk.NewInt64Coin("uflix", amount),
+	}
+}
+
+func TestApplyPair(t *testing.T) {
+	cdc := testCodec()
+	index, err := Open(":memory:", cdc)
+	require.NoError(t, err)
+	defer index.Close()
+
+	denom := types.NewDenom("denom1", "sym", "name", "{}", alice, "", "")
+	minted := testONFT("onft1", alice, `{"attributes":[{"trait_type":"background","value":"blue"},{"trait_type":"level","value":3}]}`)
+	transferred := testONFT("onft1", bob, `{"attributes":[{"trait_type":"background","value":"red"}]}`)
+	listed, updated := testListing(1, 10), testListing(1, 20)
+
+	tests := []struct {
+		name     string
+		pairs    []storetypes.StoreKVPair
+		query    string
+		expected []map[string]interface{}
+	}{
+		{
+			"denom created",
+			[]storetypes.StoreKVPair{{Key: types.KeyDenomID("denom1"), Value: cdc.MustMarshal(&denom)}},
+			`SELECT id, symbol, creator FROM denoms`,
+			[]map[string]interface{}{{"id": "denom1", "symbol": "sym", "creator": alice.String()}},
+		},
+		{
+			"onft minted with traits",
+			[]storetypes.StoreKVPair{{Key: types.KeyONFT("denom1", "onft1"), Value: cdc.MustMarshal(&minted)}},
+			`SELECT trait_type, value FROM onft_traits ORDER BY trait_type`,
+			[]map[string]interface{}{
+				{"trait_type": "background", "value": "blue"},
+				{"trait_type": "level", "value": "3"},
+			},
+		},
+		{
+			"onft transferred",
+			[]storetypes.StoreKVPair{{Key: types.KeyONFT("denom1", "onft1"), Value: cdc.MustMarshal(&transferred)}},
+			`SELECT height, owner FROM ownership_history ORDER BY height`,
+			[]map[string]interface{}{
+				{"height": int64(2), "owner": alice.String()},
+				{"height": int64(3), "owner": bob.String()},
+			},
+		},
+		{
+			"traits replaced",
+			nil,
+			`SELECT trait_type, value FROM onft_traits`,
+			[]map[string]interface{}{{"trait_type": "background", "value": "red"}},
+		},
+		{
+			"listing created",
+			[]storetypes.StoreKVPair{{Key: types.KeyListing(1), Value: cdc.MustMarshal(&listed)}},
+			`SELECT height, price_amount, action FROM listing_history`,
+			[]map[string]interface{}{{"height": int64(5), "price_amount": "10", "action": actionListed}},
+		},
+		{
+			"listing price updated",
+			[]storetypes.StoreKVPair{{Key: types.KeyListing(1), Value: cdc.MustMarshal(&updated)}},
+			`SELECT height, price_amount, action FROM listing_history WHERE height = 6`,
+			[]map[string]interface{}{{"height": int64(6), "price_amount": "20", "action": actionUpdated}},
+		},
+		{
+			"listing removed",
+			[]storetypes.StoreKVPair{{Key: types.KeyListing(1), Delete: true}},
+			`SELECT (SELECT COUNT(*) FROM listings) AS listings, action FROM listing_history WHERE height = 7`,
+			[]map[string]interface{}{{"listings": int64(0), "action": actionRemoved}},
+		},
+		{
+			"onft burned",
+			[]storetypes.StoreKVPair{{Key: types.KeyONFT("denom1", "onft1"), Delete: true}},
+			`SELECT (SELECT COUNT(*) FROM onft_traits) AS traits, owner FROM ownership_history WHERE height = 8`,
+			[]map[string]interface{}{{"traits": int64(0), "owner": ""}},
+		},
+		{
+			"other prefixes ignored",
+			[]storetypes.StoreKVPair{{Key: types.KeyCollection("denom1"), Value: types.MustMarshalSupply(cdc, 1)}},
+			`SELECT last_height FROM index_state`,
+			[]map[string]interface{}{{"last_height": int64(9)}},
+		},
+	}
+
+	for i, tt := range tests {
+		tt := tt
+		height := int64(i + 1)
+		t.Run(tt.name, func(t *testing.T) {
+			require.NoError(t, index.ApplyBlock(height, time.Unix(height, 0), tt.pairs, nil))
+			rows, err := index.Query(tt.query)
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, rows)
+		})
+	}
+}
+
+func TestApplyBlockRollsBackOnError(t *testing.T) {
+	cdc := testCodec()
+	index, err := Open(":memory:", cdc)
+	require.NoError(t, err)
+	defer index.Close()
+
+	nft := testONFT("onft1", alice, "")
+	err = index.ApplyBlock(1, time.Unix(1, 0), []storetypes.StoreKVPair{
+		{Key: types.KeyONFT("denom1", "onft1"), Value: cdc.MustMarshal(&nft)},
+		{Key: types.KeyONFT("denom1", "onft2"), Value: []byte{0xFF}},
+	}, nil)
+	require.Error(t, err)
+
+	rows, err := index.Query(`SELECT (SELECT last_height FROM index_state) AS last_height, (SELECT COUNT(*) FROM onfts) AS onfts`)
+	require.NoError(t, err)
+	require.Equal(t, []map[string]interface{}{{"last_height": int64(0), "onfts": int64(0)}}, rows)
+}
+
+// testChain commits blocks to a store with a streaming service attached.
+type testChain struct {
+	t       *testing.T
+	cdc     codec.Codec
+	ctx     sdk.Context
+	key     storetypes.StoreKey
+	service *StreamingService
+}
+
+func newTestChain(t *testing.T, initialHeight int64, stopNodeOnErr bool) *testChain {
+	cdc := testCodec()
+	key := sdk.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContext(key, sdk.NewTransientStoreKey("transient_test"))
+	service, err := NewStreamingService(":memory:", ctx.MultiStore(), key, cdc, log.NewNopLogger(), initialHeight, stopNodeOnErr)
+	require.NoError(t, err)
+	t.Cleanup(func() { service.Close() })
+	return &testChain{t: t, cdc: cdc, ctx: ctx, key: key, service: service}
+}
+
+// commit runs a block writing pairs to the store.
+func (c *testChain) commit(height int64, pairs []storetypes.StoreKVPair) error {
+	c.begin(height)
+	c.write(pairs)
+	for _, pair := range pairs {
+		require.NoError(c.t, c.service.listener.OnWrite(c.key, pair.Key, pair.Value, pair.Delete))
+	}
+	return c.service.ListenCommit(context.Background(), abci.ResponseCommit{})
+}
+
+func (c *testChain) begin(height int64) {
+	require.NoError(c.t, c.service.ListenBeginBlock(context.Background(), abci.RequestBeginBlock{
+		Header: tmproto.Header{Height: height, Time: time.Unix(height, 0)},
+	}, abci.ResponseBeginBlock{}))
+}
+
+// deliver runs a transaction emitting events.
+func (c *testChain) deliver(tx []byte, code uint32, events ...sdk.Event) {
+	require.NoError(c.t, c.service.ListenDeliverTx(context.Background(), abci.RequestDeliverTx{Tx: tx},
+		abci.ResponseDeliverTx{Code: code, Events: sdk.Events(events).ToABCIEvents()}))
+}
+
+// write writes pairs to the store without the service, like the writes of
+// blocks the service missed.
+func (c *testChain) write(pairs []storetypes.StoreKVPair) {
+	store := c.ctx.KVStore(c.key)
+	for _, pair := range pairs {
+		if pair.Delete {
+			store.Delete(pair.Key)
+		} else {
+			store.Set(pair.Key, pair.Value)
+		}
+	}
+}
+
+func (c *testChain) lastHeight() int64 {
+	height, err := c.service.index.LastHeight()
+	require.NoError(c.t, err)
+	return height
+}
+
+func (c *testChain) onftPair(id string, owner sdk.AccAddress) storetypes.StoreKVPair {
+	nft := testONFT(id, owner, "")
+	return storetypes.StoreKVPair{Key: types.KeyONFT("denom1", id), Value: c.cdc.MustMarshal(&nft)}
+}
+
+func TestStreamingServiceHeights(t *testing.T) {
+	chain := newTestChain(t, 1, false)
+
+	require.NoError(t, chain.commit(1, []storetypes.StoreKVPair{chain.onftPair("onft1", alice)}))
+	require.Equal(t, int64(1), chain.lastHeight())
+
+	// a block replayed after a restart is skipped
+	require.NoError(t, chain.commit(1, []storetypes.StoreKVPair{chain.onftPair("onft1", bob)}))
+	require.Equal(t, int64(1), chain.lastHeight())
+	rows, err := chain.service.index.Query(`SELECT owner FROM onfts`)
+	require.NoError(t, err)
+	require.Equal(t, []map[string]interface{}{{"owner": alice.String()}}, rows)
+
+	require.NoError(t, chain.commit(2, []storetypes.StoreKVPair{chain.onftPair("onft2", alice)}))
+	require.Equal(t, int64(2), chain.lastHeight())
+}
+
+func TestStreamingServiceRetriesFailedBlocks(t *testing.T) {
+	chain := newTestChain(t, 1, false)
+	require.NoError(t, chain.commit(1, []storetypes.StoreKVPair{chain.onftPair("onft1", alice)}))
+
+	// the block fails to be indexed and stays pending, blocking later blocks
+	require.NoError(t, chain.service.listener.OnWrite(chain.key, types.KeyONFT("denom1", "onft2"), []byte{0xFF}, false))
+	require.NoError(t, chain.commit(2, nil))
+	require.NoError(t, chain.commit(3, []storetypes.StoreKVPair{chain.onftPair("onft3", alice)}))
+	require.Equal(t, int64(1), chain.lastHeight())
+	require.Len(t, chain.service.pending, 2)
+
+	// once too many blocks are pending, the index is resynced from the store
+	height := int64(4)
+	for ; len(chain.service.pending) > 0; height++ {
+		require.NoError(t, chain.commit(height, nil))
+	}
+	require.Equal(t, height-1, chain.lastHeight())
+	rows, err := chain.service.index.Query(`SELECT id FROM onfts ORDER BY id`)
+	require.NoError(t, err)
+	require.Equal(t, []map[string]interface{}{{"id": "onft1"}, {"id": "onft3"}}, rows)
+	rows, err = chain.service.index.Query(`SELECT height, last_height FROM resyncs`)
+	require.NoError(t, err)
+	require.Equal(t, []map[string]interface{}{{"height": height - 1, "last_height": int64(1)}}, rows)
+}
+
+func TestStreamingServiceStopNodeOnErr(t *testing.T) {
+	chain := newTestChain(t, 1, true)
+	require.NoError(t, chain.service.listener.OnWrite(chain.key, types.KeyONFT("denom1", "onft1"), []byte{0xFF}, false))
+	require.Error(t, chain.commit(1, nil))
+	require.Equal(t, int64(0), chain.lastHeight())
+}
+
+func TestStreamingServiceResync(t *testing.T) {
+	chain := newTestChain(t, 1, false)
+
+	// an index enabled on a node started from a snapshot is built from the store
+	chain.write([]storetypes.StoreKVPair{
+		chain.onftPair("onft1", alice),
+		chain.onftPair("onft2", alice),
+	})
+	require.NoError(t, chain.commit(10, nil))
+	require.Equal(t, int64(10), chain.lastHeight())
+
+	// block 11 is missed, the owner change and the burn are recorded at the
+	// resync height
+	chain.write([]storetypes.StoreKVPair{
+		chain.onftPair("onft1", bob),
+		{Key: types.KeyONFT("denom1", "onft2"), Delete: true},
+	})
+	require.NoError(t, chain.commit(12, nil))
+	require.Equal(t, int64(12), chain.lastHeight())
+
+	rows, err := chain.service.index.Query(`SELECT onft_id, height, owner FROM ownership_history ORDER BY onft_id, height`)
+	require.NoError(t, err)
+	require.Equal(t, []map[string]interface{}{
+		{"onft_id": "onft1", "height": int64(10), "owner": alice.String()},
+		{"onft_id": "onft1", "height": int64(12), "owner": bob.String()},
+		{"onft_id": "onft2", "height": int64(10), "owner": alice.String()},
+		{"onft_id": "onft2", "height": int64(12), "owner": ""},
+	}, rows)
+	rows, err = chain.service.index.Query(`SELECT height, last_height FROM resyncs ORDER BY height`)
+	require.NoError(t, err)
+	require.Equal(t, []map[string]interface{}{
+		{"height": int64(10), "last_height": int64(0)},
+		{"height": int64(12), "last_height": int64(10)},
+	}, rows)
+}
+
+func buyEvent(listingID, price string) sdk.Event {
+	return sdk.NewEvent(types.EventTypeBuyONFT,
+		sdk.NewAttribute(types.AttributeKeyListingID, listingID),
+		sdk.NewAttribute(types.AttributeKeyDenomID, "denom1"),
+		sdk.NewAttribute(types.AttributeKeyNFTID, "onft1"),
+		sdk.NewAttribute(types.AttributeKeySeller, alice.String()),
+		sdk.NewAttribute(types.AttributeKeyBuyer, bob.String()),
+		sdk.NewAttribute(types.AttributeKeyPrice, price),
+	)
+}
+
+func settleEvent(auctionID string, status types.AuctionStatus, bidder, amount string) sdk.Event {
+	return sdk.NewEvent(types.EventTypeSettleAuction,
+		sdk.NewAttribute(types.AttributeKeyAuctionID, auctionID),
+		sdk.NewAttribute(types.AttributeKeyDenomID, "denom1"),
+		sdk.NewAttribute(types.AttributeKeyNFTID, "onft1"),
+		sdk.NewAttribute(types.AttributeKeySeller, bob.String()),
+		sdk.NewAttribute(types.AttributeKeyBidder, bidder),
+		sdk.NewAttribute(types.AttributeKeyAmount, amount),
+		sdk.NewAttribute(types.AttributeKeyStatus, status.String()),
+	)
+}
+
+func TestParseSales(t *testing.T) {
+	tests := []struct {
+		name     string
+		event    sdk.Event
+		expected []sale
+		err      bool
+	}{
+		{
+			"listing sold",
+			buyEvent("1", "100uflix"),
+			[]sale{{
+				source: saleSourceListing, sourceID: 1, denomID: "denom1", onftID: "onft1",
+				seller: alice.String(), buyer: bob.String(), price: sdk.NewInt64Coin("uflix", 100), txHash: "HASH",
+			}},
+			false,
+		},
+		{
+			"auction sold",
+			settleEvent("2", types.AuctionStatusSold, alice.String(), "50uflix"),
+			[]sale{{
+				source: saleSourceAuction, sourceID: 2, denomID: "denom1", onftID: "onft1",
+				seller: bob.String(), buyer: alice.String(), price: sdk.NewInt64Coin("uflix", 50), txHash: "HASH",
+			}},
+			false,
+		},
+		{"auction unsold", settleEvent("3", types.AuctionStatusUnsold, "", ""), nil, false},
+		{"other event", sdk.NewEvent(types.EventTypeListONFT), nil, false},
+		{"invalid listing id", buyEvent("x", "100uflix"), nil, true},
+		{"invalid price", buyEvent("1", "uflix"), nil, true},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			sales, err := parseSales(sdk.Events{tt.event}.ToABCIEvents(), "HASH")
+			if tt.err {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, sales)
+		})
+	}
+}
+
+func TestStreamingServiceSales(t *testing.T) {
+	chain := newTestChain(t, 1, false)
+
+	chain.begin(1)
+	chain.deliver([]byte("buy"), 0, buyEvent("1", "100uflix"))
+	// the events of a failed transaction are not indexed
+	chain.deliver([]byte("failed"), 5, buyEvent("2", "100uflix"))
+	// a malformed event is logged and skipped without stopNodeOnErr
+	chain.deliver([]byte("malformed"), 0, buyEvent("3", "uflix"))
+	require.NoError(t, chain.service.ListenEndBlock(context.Background(), abci.RequestEndBlock{Height: 1}, abci.ResponseEndBlock{
+		Events: sdk.Events{
+			settleEvent("1", types.AuctionStatusSold, alice.String(), "50uflix"),
+			settleEvent("2", types.AuctionStatusUnsold, "", ""),
+		}.ToABCIEvents(),
+	}))
+	require.NoError(t, chain.service.ListenCommit(context.Background(), abci.ResponseCommit{}))
+
+	rows, err := chain.service.index.Query(`SELECT source, source_id, height, buyer, price_amount, tx_hash
+		FROM sales ORDER BY source, source_id`)
+	require.NoError(t, err)
+	require.Equal(t, []map[string]interface{}{
+		{"source": saleSourceAuction, "source_id": int64(1), "height": int64(1), "buyer": alice.String(), "price_amount": "50", "tx_hash": ""},
+		{"source": saleSourceListing, "source_id": int64(1), "height": int64(1), "buyer": bob.String(), "price_amount": "100", "tx_hash": txHash([]byte("buy"))},
+	}, rows)
+
+	// block 2 is missed, the sales of the resync block are still indexed
+	chain.begin(3)
+	chain.deliver([]byte("buy4"), 0, buyEvent("4", "10uflix"))
+	require.NoError(t, chain.service.ListenCommit(context.Background(), abci.ResponseCommit{}))
+	rows, err = chain.service.index.Query(`SELECT r.height, r.last_height FROM sales s JOIN resyncs r ON r.height = s.height
+		WHERE s.source_id = 4`)
+	require.NoError(t, err)
+	require.Equal(t, []map[string]interface{}{{"height": int64(3), "last_height": int64(1)}}, rows)
+}
+
+func TestStreamingServiceSalesStopNodeOnErr(t *testing.T) {
+	chain := newTestChain(t, 1, true)
+	chain.begin(1)
+	require.Error(t, chain.service.ListenDeliverTx(context.Background(), abci.RequestDeliverTx{Tx: []byte("malformed")},
+		abci.ResponseDeliverTx{Events: sdk.Events{buyEvent("1", "uflix")}.ToABCIEvents()}))
+}
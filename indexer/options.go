@@ -0,0 +1,22 @@
+package indexer
+
+import "path/filepath"
+
+// BuildTag is the build tag linking the index and its SQLite driver into
+// onftd. Binaries built without it reject an enabled index.
+const BuildTag = "onftindex"
+
+const (
+	// DefaultDBName is the file name of the index inside the node data directory.
+	DefaultDBName = "onft-index.db"
+
+	OptEnabled       = "streamers.onft-index.enabled"
+	OptDBPath        = "streamers.onft-index.db-path"
+	OptInitialHeight = "streamers.onft-index.initial-height"
+	OptStopNodeOnErr = "streamers.onft-index.stop-node-on-err"
+)
+
+// DefaultDBPath returns the path of the index inside the node home directory.
+func DefaultDBPath(homePath string) string {
+	return filepath.Join(homePath, "data", DefaultDBName)
+}
@@ -0,0 +1,51 @@
+//go:build onftindex
+
+package indexer
+
+import (
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/spf13/cast"
+)
+
+// RegisterStreamingService registers the index streaming service with the
+// BaseApp when it is enabled in the app options.
+func RegisterStreamingService(
+	bApp *baseapp.BaseApp,
+	appOpts servertypes.AppOptions,
+	homePath string,
+	storeKey storetypes.StoreKey,
+	cdc codec.Codec,
+	logger log.Logger,
+) error {
+	if !cast.ToBool(appOpts.Get(OptEnabled)) {
+		return nil
+	}
+
+	path := cast.ToString(appOpts.Get(OptDBPath))
+	if path == "" {
+		path = DefaultDBPath(homePath)
+	}
+	stopNodeOnErr := true
+	if v := appOpts.Get(OptStopNodeOnErr); v != nil {
+		stopNodeOnErr = cast.ToBool(v)
+	}
+
+	service, err := NewStreamingService(
+		path,
+		bApp.CommitMultiStore(),
+		storeKey,
+		cdc,
+		logger,
+		cast.ToInt64(appOpts.Get(OptInitialHeight)),
+		stopNodeOnErr,
+	)
+	if err != nil {
+		return err
+	}
+	bApp.SetStreamingService(service)
+	return nil
+}
@@ -0,0 +1,104 @@
+//go:build onftindex
+
+package indexer
+
+import (
+	"database/sql"
+	"fmt"
+	"strconv"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/crypto/tmhash"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/OmniFlix/onft/types"
+)
+
+const (
+	saleSourceListing = "listing"
+	saleSourceAuction = "auction"
+)
+
+// sale is an oNFT sold for a price, parsed from the buy_onft event of a
+// listing or the settle_auction event of a sold auction. The store only keeps
+// the removed listing or the settled auction, not the price paid.
+type sale struct {
+	source   string
+	sourceID uint64
+	denomID  string
+	onftID   string
+	seller   string
+	buyer    string
+	price    sdk.Coin
+	txHash   string
+}
+
+// txHash returns the hash of the raw transaction as shown by explorers.
+func txHash(tx []byte) string {
+	return fmt.Sprintf("%X", tmhash.Sum(tx))
+}
+
+// parseSales returns the sales of the events of a transaction or of the end
+// block. End block settlements have no transaction hash.
+func parseSales(events []abci.Event, hash string) ([]sale, error) {
+	var sales []sale
+	for _, event := range events {
+		attributes := make(map[string]string, len(event.Attributes))
+		for _, attribute := range event.Attributes {
+			attributes[attribute.Key] = attribute.Value
+		}
+
+		var (
+			s      sale
+			id     string
+			amount string
+		)
+		switch event.Type {
+		case types.EventTypeBuyONFT:
+			s.source = saleSourceListing
+			s.buyer = attributes[types.AttributeKeyBuyer]
+			id, amount = attributes[types.AttributeKeyListingID], attributes[types.AttributeKeyPrice]
+		case types.EventTypeSettleAuction:
+			if attributes[types.AttributeKeyStatus] != types.AuctionStatusSold.String() {
+				continue
+			}
+			s.source = saleSourceAuction
+			s.buyer = attributes[types.AttributeKeyBidder]
+			id, amount = attributes[types.AttributeKeyAuctionID], attributes[types.AttributeKeyAmount]
+		default:
+			continue
+		}
+
+		sourceID, err := strconv.ParseUint(id, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s id in %s event: %w", s.source, event.Type, err)
+		}
+		price, err := sdk.ParseCoinNormalized(amount)
+		if err != nil {
+			return nil, fmt.Errorf("invalid price of %s %d: %w", s.source, sourceID, err)
+		}
+		s.sourceID = sourceID
+		s.price = price
+		s.denomID = attributes[types.AttributeKeyDenomID]
+		s.onftID = attributes[types.AttributeKeyNFTID]
+		s.seller = attributes[types.AttributeKeySeller]
+		s.txHash = hash
+		sales = append(sales, s)
+	}
+	return sales, nil
+}
+
+func insertSales(tx *sql.Tx, height int64, sales []sale) error {
+	for _, s := range sales {
+		if _, err := tx.Exec(
+			`INSERT OR REPLACE INTO sales
+			(source, source_id, height, denom_id, onft_id, seller, buyer, price_denom, price_amount, tx_hash)
+			VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+			s.source, s.sourceID, height, s.denomID, s.onftID, s.seller, s.buyer,
+			s.price.Denom, s.price.Amount.String(), s.txHash,
+		); err != nil {
+			return err
+		}
+	}
+	return nil
+}
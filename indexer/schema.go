@@ -0,0 +1,150 @@
+package indexer
+
+// SchemaVersion is the version of the index schema. An index created with a
+// different version has to be rebuilt from genesis.
+const SchemaVersion = 3
+
+// Schema is the SQLite schema of the onft index.
+//
+//   - index_state: single row holding the schema version and the last indexed
+//     block height. It is updated in the same transaction as the block data.
+//   - blocks: height and header time of every indexed block.
+//   - resyncs: heights at which the latest state was rebuilt from the
+//     committed onft store, because the blocks after last_height were not
+//     indexed, like on a node started from a snapshot. The history tables
+//     miss the changes of those blocks, they are recorded at the resync
+//     height instead.
+//   - denoms: latest state of every denom, with the full record as JSON.
+//   - onfts: latest state of every oNFT, with the full record as JSON.
+//   - onft_traits: traits parsed from the `attributes` array of the oNFT data,
+//     following the `{"trait_type": ..., "value": ...}` convention.
+//   - ownership_history: owner of an oNFT from the given height on. An empty
+//     owner means the oNFT was burned at that height. Listed or otherwise
+//     escrowed oNFTs are owned by the module account.
+//   - listings: active marketplace listings.
+//   - listing_history: price history of listings. The action is `listed`,
+//     `updated` or `removed`. A removed listing was either sold or cancelled,
+//     a sale is recorded in the sales table at the same height.
+//   - sales: oNFTs sold through a listing or an auction, with the price paid,
+//     parsed from the `buy_onft` and `settle_auction` events. The source is
+//     `listing` or `auction`, the source id is the listing or auction id.
+//     Sales settled at the end of a block have no transaction hash. The
+//     sales of blocks missed before a resync are not indexed.
+//
+// Amounts are stored as decimal strings. Times are stored in UTC using
+// sdk.SortableTimeFormat, so they compare correctly as strings.
+const Schema = `
+CREATE TABLE IF NOT EXISTS index_state (
+	id             INTEGER PRIMARY KEY CHECK (id = 1),
+	schema_version INTEGER NOT NULL,
+	last_height    INTEGER NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS blocks (
+	height INTEGER PRIMARY KEY,
+	time   TEXT NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS resyncs (
+	height      INTEGER PRIMARY KEY,
+	last_height INTEGER NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS denoms (
+	id              TEXT PRIMARY KEY,
+	symbol          TEXT NOT NULL,
+	name            TEXT NOT NULL,
+	creator         TEXT NOT NULL,
+	schema          TEXT NOT NULL,
+	description     TEXT NOT NULL,
+	preview_uri     TEXT NOT NULL,
+	credential      INTEGER NOT NULL,
+	clawback        INTEGER NOT NULL,
+	updated_height  INTEGER NOT NULL,
+	json            TEXT NOT NULL
+);
+CREATE INDEX IF NOT EXISTS denoms_creator ON denoms (creator);
+
+CREATE TABLE IF NOT EXISTS onfts (
+	denom_id        TEXT NOT NULL,
+	id              TEXT NOT NULL,
+	owner           TEXT NOT NULL,
+	name            TEXT NOT NULL,
+	description     TEXT NOT NULL,
+	media_uri       TEXT NOT NULL,
+	preview_uri     TEXT NOT NULL,
+	data            TEXT NOT NULL,
+	transferable    INTEGER NOT NULL,
+	extensible      INTEGER NOT NULL,
+	nsfw            INTEGER NOT NULL,
+	royalty_share   TEXT NOT NULL,
+	created_at      TEXT NOT NULL,
+	expires_at      TEXT,
+	updated_height  INTEGER NOT NULL,
+	json            TEXT NOT NULL,
+	PRIMARY KEY (denom_id, id)
+);
+CREATE INDEX IF NOT EXISTS onfts_owner ON onfts (owner);
+CREATE INDEX IF NOT EXISTS onfts_created_at ON onfts (created_at);
+
+CREATE TABLE IF NOT EXISTS onft_traits (
+	denom_id   TEXT NOT NULL,
+	onft_id    TEXT NOT NULL,
+	trait_type TEXT NOT NULL,
+	value      TEXT NOT NULL,
+	PRIMARY KEY (denom_id, onft_id, trait_type)
+);
+CREATE INDEX IF NOT EXISTS onft_traits_value ON onft_traits (trait_type, value);
+
+CREATE TABLE IF NOT EXISTS ownership_history (
+	denom_id TEXT NOT NULL,
+	onft_id  TEXT NOT NULL,
+	height   INTEGER NOT NULL,
+	owner    TEXT NOT NULL,
+	PRIMARY KEY (denom_id, onft_id, height)
+);
+CREATE INDEX IF NOT EXISTS ownership_history_owner ON ownership_history (owner, height);
+
+CREATE TABLE IF NOT EXISTS listings (
+	id            INTEGER PRIMARY KEY,
+	denom_id      TEXT NOT NULL,
+	onft_id       TEXT NOT NULL,
+	seller        TEXT NOT NULL,
+	price_denom   TEXT NOT NULL,
+	price_amount  TEXT NOT NULL,
+	expiry        TEXT NOT NULL,
+	listed_height INTEGER NOT NULL,
+	json          TEXT NOT NULL
+);
+CREATE INDEX IF NOT EXISTS listings_onft ON listings (denom_id, onft_id);
+CREATE INDEX IF NOT EXISTS listings_seller ON listings (seller);
+
+CREATE TABLE IF NOT EXISTS listing_history (
+	listing_id   INTEGER NOT NULL,
+	height       INTEGER NOT NULL,
+	denom_id     TEXT NOT NULL,
+	onft_id      TEXT NOT NULL,
+	seller       TEXT NOT NULL,
+	price_denom  TEXT NOT NULL,
+	price_amount TEXT NOT NULL,
+	action       TEXT NOT NULL,
+	PRIMARY KEY (listing_id, height)
+);
+CREATE INDEX IF NOT EXISTS listing_history_onft ON listing_history (denom_id, onft_id, height);
+
+CREATE TABLE IF NOT EXISTS sales (
+	source       TEXT NOT NULL,
+	source_id    INTEGER NOT NULL,
+	height       INTEGER NOT NULL,
+	denom_id     TEXT NOT NULL,
+	onft_id      TEXT NOT NULL,
+	seller       TEXT NOT NULL,
+	buyer        TEXT NOT NULL,
+	price_denom  TEXT NOT NULL,
+	price_amount TEXT NOT NULL,
+	tx_hash      TEXT NOT NULL,
+	PRIMARY KEY (source, source_id)
+);
+CREATE INDEX IF NOT EXISTS sales_onft ON sales (denom_id, onft_id, height);
+CREATE INDEX IF NOT EXISTS sales_height ON sales (height);
+`
@@ -0,0 +1,189 @@
+//go:build onftindex
+
+package indexer
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+)
+
+var _ baseapp.StreamingService = (*StreamingService)(nil)
+
+// maxPendingBlocks is the number of blocks kept for a retry while indexing
+// fails. Once exceeded, the index is resynced from the committed state.
+const maxPendingBlocks = 100
+
+// pendingBlock holds the writes and sales of a committed block that is not
+// indexed yet.
+type pendingBlock struct {
+	height int64
+	time   time.Time
+	pairs  []storetypes.StoreKVPair
+	sales  []sale
+}
+
+// StreamingService listens to the writes of the onft store and applies them
+// to the index once the block is committed.
+type StreamingService struct {
+	index         *Index
+	listener      *storetypes.MemoryListener
+	state         storetypes.MultiStore
+	storeKey      storetypes.StoreKey
+	logger        log.Logger
+	initialHeight int64
+	stopNodeOnErr bool
+
+	current pendingBlock
+	pending []pendingBlock
+}
+
+// NewStreamingService opens the index at path and returns a service listening
+// to the store of storeKey. An empty index starting at initialHeight indexes
+// the genesis block, otherwise missed blocks are resynced from the committed
+// state of storeKey in state.
+func NewStreamingService(
+	path string,
+	state storetypes.MultiStore,
+	storeKey storetypes.StoreKey,
+	cdc codec.Codec,
+	logger log.Logger,
+	initialHeight int64,
+	stopNodeOnErr bool,
+) (*StreamingService, error) {
+	index, err := Open(path, cdc)
+	if err != nil {
+		return nil, err
+	}
+	if initialHeight < 1 {
+		initialHeight = 1
+	}
+	return &StreamingService{
+		index:         index,
+		listener:      storetypes.NewMemoryListener(storeKey),
+		state:         state,
+		storeKey:      storeKey,
+		logger:        logger.With("module", "onft-index"),
+		initialHeight: initialHeight,
+		stopNodeOnErr: stopNodeOnErr,
+	}, nil
+}
+
+// Stream satisfies the StreamingService interface. The index is written
+// synchronously during Commit, so there is no streaming loop.
+func (s *StreamingService) Stream(_ *sync.WaitGroup) error {
+	return nil
+}
+
+// Listeners satisfies the StreamingService interface.
+func (s *StreamingService) Listeners() map[storetypes.StoreKey][]storetypes.WriteListener {
+	return map[storetypes.StoreKey][]storetypes.WriteListener{
+		s.listener.StoreKey(): {s.listener},
+	}
+}
+
+// ListenBeginBlock records the height and time of the block being executed.
+func (s *StreamingService) ListenBeginBlock(_ context.Context, req abci.RequestBeginBlock, _ abci.ResponseBeginBlock) error {
+	s.current = pendingBlock{height: req.Header.Height, time: req.Header.Time}
+	return nil
+}
+
+// ListenEndBlock records the sales of the auctions settled at the end of the
+// block.
+func (s *StreamingService) ListenEndBlock(_ context.Context, _ abci.RequestEndBlock, res abci.ResponseEndBlock) error {
+	return s.addSales(res.Events, "")
+}
+
+// ListenDeliverTx records the sales of a successful transaction.
+func (s *StreamingService) ListenDeliverTx(_ context.Context, req abci.RequestDeliverTx, res abci.ResponseDeliverTx) error {
+	if !res.IsOK() {
+		return nil
+	}
+	return s.addSales(res.Events, txHash(req.Tx))
+}
+
+// addSales adds the sales of the events to the current block. As the
+// BaseApp halts on listener errors, events that fail to be parsed are only
+// logged unless stopNodeOnErr is set.
+func (s *StreamingService) addSales(events []abci.Event, hash string) error {
+	sales, err := parseSales(events, hash)
+	if err != nil {
+		s.logger.Error("failed to index sales", "height", s.current.height, "tx_hash", hash, "err", err)
+		if s.stopNodeOnErr {
+			return err
+		}
+		return nil
+	}
+	s.current.sales = append(s.current.sales, sales...)
+	return nil
+}
+
+// ListenCommit applies the writes of the committed block to the index. A
+// block that fails to be indexed is kept and retried after the next block. It
+// only returns an error when stopNodeOnErr is set, otherwise errors are
+// logged for every block while the index is behind.
+func (s *StreamingService) ListenCommit(_ context.Context, _ abci.ResponseCommit) error {
+	s.current.pairs = s.listener.PopStateCache()
+	s.pending = append(s.pending, s.current)
+	if len(s.pending) > maxPendingBlocks {
+		// the writes of the dropped blocks are recovered by a resync
+		s.pending = s.pending[len(s.pending)-1:]
+	}
+
+	if err := s.commit(); err != nil {
+		s.logger.Error("failed to index block, the index is behind",
+			"height", s.current.height, "pending_blocks", len(s.pending), "err", err)
+		if s.stopNodeOnErr {
+			return err
+		}
+	}
+	return nil
+}
+
+// commit indexes the pending blocks in order. When blocks are missing before
+// the first pending block, like after a crash between the commit of the app
+// and the index or on a node started from a snapshot, the index is resynced
+// from the committed state of the latest block instead.
+func (s *StreamingService) commit() error {
+	for len(s.pending) > 0 {
+		block := s.pending[0]
+		lastHeight, err := s.index.LastHeight()
+		if err != nil {
+			return err
+		}
+		switch {
+		case block.height <= lastHeight:
+			// the block was indexed before the node restarted
+		case block.height == lastHeight+1 || (lastHeight == 0 && block.height == s.initialHeight):
+			if err := s.index.ApplyBlock(block.height, block.time, block.pairs, block.sales); err != nil {
+				return err
+			}
+			s.logger.Debug("indexed block", "height", block.height, "writes", len(block.pairs))
+		default:
+			latest := s.pending[len(s.pending)-1]
+			var sales []sale
+			for _, pending := range s.pending {
+				sales = append(sales, pending.sales...)
+			}
+			if err := s.index.Resync(latest.height, latest.time, s.state.GetKVStore(s.storeKey), sales); err != nil {
+				return err
+			}
+			s.logger.Info("resynced index from the committed state", "height", latest.height, "last_height", lastHeight)
+			s.pending = nil
+			return nil
+		}
+		s.pending = s.pending[1:]
+	}
+	return nil
+}
+
+// Close closes the index.
+func (s *StreamingService) Close() error {
+	return s.index.Close()
+}
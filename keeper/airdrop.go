@@ -0,0 +1,166 @@
+package keeper
+
+import (
+	"encoding/hex"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/OmniFlix/onft/types"
+)
+
+// CreateMintAirdrop registers a merkle root of recipients that can mint oNFTs
+// of the denom on behalf of the creator until the airdrop expires.
+func (k Keeper) CreateMintAirdrop(
+	ctx sdk.Context,
+	denomID, merkleRoot string,
+	template types.ONFTTemplate,
+	expiry time.Time,
+	creator sdk.AccAddress,
+) (uint64, error) {
+	if !k.HasDenomID(ctx, denomID) {
+		return 0, errorsmod.Wrapf(types.ErrInvalidDenom, "denomID %s not exists", denomID)
+	}
+	if !k.HasPermissionToMint(ctx, denomID, creator) {
+		return 0, errorsmod.Wrapf(types.ErrUnauthorized, "only creator of denom has permission to mint")
+	}
+	if !expiry.After(ctx.BlockTime()) {
+		return 0, errorsmod.Wrapf(types.ErrInvalidAirdrop, "expiry %s must be in the future", expiry)
+	}
+
+	airdropID := k.GetNextAirdropID(ctx)
+	k.SetNextAirdropID(ctx, airdropID+1)
+	k.SetMintAirdrop(ctx, types.MintAirdrop{
+		Id:         airdropID,
+		Creator:    creator.String(),
+		DenomId:    denomID,
+		MerkleRoot: merkleRoot,
+		Template:   template,
+		Expiry:     expiry,
+	})
+	k.emitCreateMintAirdropEvent(ctx, airdropID, denomID, merkleRoot, creator.String())
+	return airdropID, nil
+}
+
+// ClaimAirdrop verifies the merkle proof of the claimant's leaf and mints the
+// oNFT of the leaf to the claimant. Every leaf can be claimed once.
+func (k Keeper) ClaimAirdrop(
+	ctx sdk.Context,
+	airdropID uint64,
+	onftID string,
+	metadata *types.Metadata,
+	proof []string,
+	claimant sdk.AccAddress,
+) (string, error) {
+	airdrop, err := k.GetMintAirdrop(ctx, airdropID)
+	if err != nil {
+		return "", err
+	}
+	if airdrop.IsExpired(ctx.BlockTime()) {
+		return "", errorsmod.Wrapf(types.ErrAirdropExpired, "airdrop %d expired at %s", airdropID, airdrop.Expiry)
+	}
+
+	leaf := types.AirdropLeafHash(claimant, onftID, metadata)
+	if k.IsAirdropLeafClaimed(ctx, airdropID, leaf) {
+		return "", errorsmod.Wrapf(types.ErrAirdropClaimed, "onft %s of airdrop %d", onftID, airdropID)
+	}
+	proofBz, err := types.DecodeMerkleProof(proof)
+	if err != nil {
+		return "", err
+	}
+	root, _ := hex.DecodeString(airdrop.MerkleRoot)
+	if !types.VerifyMerkleProof(root, leaf, proofBz) {
+		return "", errorsmod.Wrapf(types.ErrInvalidMerkleProof, "leaf of %s is not part of airdrop %d", claimant, airdropID)
+	}
+
+	creator, err := sdk.AccAddressFromBech32(airdrop.Creator)
+	if err != nil {
+		return "", err
+	}
+	onft := airdrop.Template.NewONFT(onftID, claimant, ctx.BlockTime())
+	if metadata != nil {
+		onft.Metadata = *metadata
+	}
+	if err := k.mintONFT(ctx, airdrop.DenomId, onft, creator, claimant); err != nil {
+		return "", err
+	}
+
+	airdrop.Claimed++
+	k.SetMintAirdrop(ctx, airdrop)
+	k.setAirdropLeafClaimed(ctx, airdropID, leaf)
+	k.emitClaimAirdropEvent(ctx, airdropID, airdrop.DenomId, onftID, claimant.String())
+	return airdrop.DenomId, nil
+}
+
+func (k Keeper) GetNextAirdropID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.NextAirdropIDKey)
+	if len(bz) == 0 {
+		return 1
+	}
+	return types.MustUnMarshalSupply(k.cdc, bz)
+}
+
+func (k Keeper) SetNextAirdropID(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.NextAirdropIDKey, types.MustMarshalSupply(k.cdc, id))
+}
+
+func (k Keeper) GetMintAirdrop(ctx sdk.Context, id uint64) (airdrop types.MintAirdrop, err error) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyAirdrop(id))
+	if bz == nil {
+		return airdrop, errorsmod.Wrapf(types.ErrUnknownAirdrop, "airdrop %d not found", id)
+	}
+	k.cdc.MustUnmarshal(bz, &airdrop)
+	return airdrop, nil
+}
+
+func (k Keeper) GetMintAirdrops(ctx sdk.Context) (airdrops []types.MintAirdrop) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyAirdrop(0))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var airdrop types.MintAirdrop
+		k.cdc.MustUnmarshal(iterator.Value(), &airdrop)
+		airdrops = append(airdrops, airdrop)
+	}
+	return airdrops
+}
+
+func (k Keeper) SetMintAirdrop(ctx sdk.Context, airdrop types.MintAirdrop) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&airdrop)
+	store.Set(types.KeyAirdrop(airdrop.Id), bz)
+}
+
+func (k Keeper) IsAirdropLeafClaimed(ctx sdk.Context, id uint64, leaf []byte) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.KeyAirdropClaim(id, leaf))
+}
+
+func (k Keeper) GetAirdropClaimRecords(ctx sdk.Context) (records []types.AirdropClaimRecord) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyAirdropClaim(0, nil))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var record types.AirdropClaimRecord
+		k.cdc.MustUnmarshal(iterator.Value(), &record)
+		records = append(records, record)
+	}
+	return records
+}
+
+func (k Keeper) SetAirdropClaimRecord(ctx sdk.Context, record types.AirdropClaimRecord) {
+	leaf, _ := hex.DecodeString(record.LeafHash)
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&record)
+	store.Set(types.KeyAirdropClaim(record.AirdropId, leaf), bz)
+}
+
+func (k Keeper) setAirdropLeafClaimed(ctx sdk.Context, id uint64, leaf []byte) {
+	k.SetAirdropClaimRecord(ctx, types.AirdropClaimRecord{AirdropId: id, LeafHash: hex.EncodeToString(leaf)})
+}
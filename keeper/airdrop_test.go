@@ -0,0 +1,77 @@
+package keeper_test
+
+import (
+	"encoding/hex"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/OmniFlix/onft/types"
+)
+
+func (s *KeeperTestSuite) airdropTemplate() types.ONFTTemplate {
+	return types.NewONFTTemplate(
+		types.Metadata{Name: "airdrop", MediaURI: "https://onft.test/media"}, "{}",
+		true, true, false, sdk.NewDecWithPrec(1, 1),
+	)
+}
+
+func (s *KeeperTestSuite) TestClaimAirdrop() {
+	s.createDenom(denomID, s.creator)
+	recipients := []sdk.AccAddress{s.alice, s.bob, testAddr("carol")}
+	leaves := make([][]byte, len(recipients))
+	for i, recipient := range recipients {
+		leaves[i] = types.AirdropLeafHash(recipient, onftID+recipient.String()[:12], nil)
+	}
+	root, proofs := types.BuildMerkleTree(leaves)
+
+	expiry := s.ctx.BlockTime().Add(time.Hour)
+	airdropID, err := s.keeper.CreateMintAirdrop(s.ctx, denomID, hex.EncodeToString(root), s.airdropTemplate(), expiry, s.creator)
+	s.Require().NoError(err)
+
+	aliceONFT := onftID + s.alice.String()[:12]
+	// the proof of alice does not prove the leaf of bob
+	_, err = s.keeper.ClaimAirdrop(s.ctx, airdropID, aliceONFT, nil, types.EncodeMerkleProof(proofs[0]), s.bob)
+	s.Require().ErrorIs(err, types.ErrInvalidMerkleProof)
+
+	_, err = s.keeper.ClaimAirdrop(s.ctx, airdropID, aliceONFT, nil, types.EncodeMerkleProof(proofs[0]), s.alice)
+	s.Require().NoError(err)
+	s.Require().Equal(s.alice, s.owner(denomID, aliceONFT))
+	s.Require().True(s.keeper.IsAirdropLeafClaimed(s.ctx, airdropID, leaves[0]))
+
+	_, err = s.keeper.ClaimAirdrop(s.ctx, airdropID, aliceONFT, nil, types.EncodeMerkleProof(proofs[0]), s.alice)
+	s.Require().ErrorIs(err, types.ErrAirdropClaimed)
+
+	s.nextBlock(time.Hour)
+	bobONFT := onftID + s.bob.String()[:12]
+	_, err = s.keeper.ClaimAirdrop(s.ctx, airdropID, bobONFT, nil, types.EncodeMerkleProof(proofs[1]), s.bob)
+	s.Require().ErrorIs(err, types.ErrAirdropExpired)
+}
+
+func (s *KeeperTestSuite) TestClaimSingleLeafAirdrop() {
+	s.createDenom(denomID, s.creator)
+	leaf := types.AirdropLeafHash(s.alice, onftID, nil)
+	root, proofs := types.BuildMerkleTree([][]byte{leaf})
+	s.Require().Empty(proofs[0])
+
+	expiry := s.ctx.BlockTime().Add(time.Hour)
+	airdropID, err := s.keeper.CreateMintAirdrop(s.ctx, denomID, hex.EncodeToString(root), s.airdropTemplate(), expiry, s.creator)
+	s.Require().NoError(err)
+
+	_, err = s.keeper.ClaimAirdrop(s.ctx, airdropID, onftID, nil, nil, s.bob)
+	s.Require().ErrorIs(err, types.ErrInvalidMerkleProof)
+	_, err = s.keeper.ClaimAirdrop(s.ctx, airdropID, onftID, nil, nil, s.alice)
+	s.Require().NoError(err)
+	s.Require().Equal(s.alice, s.owner(denomID, onftID))
+}
+
+func (s *KeeperTestSuite) TestCreateMintAirdropRequiresMinter() {
+	s.createDenom(denomID, s.creator)
+	expiry := s.ctx.BlockTime().Add(time.Hour)
+	root := hex.EncodeToString(types.AirdropLeafHash(s.alice, onftID, nil))
+
+	_, err := s.keeper.CreateMintAirdrop(s.ctx, denomID, root, s.airdropTemplate(), expiry, s.alice)
+	s.Require().ErrorIs(err, types.ErrUnauthorized)
+	_, err = s.keeper.CreateMintAirdrop(s.ctx, denomID, root, s.airdropTemplate(), s.ctx.BlockTime(), s.creator)
+	s.Require().ErrorIs(err, types.ErrInvalidAirdrop)
+}
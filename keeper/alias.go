@@ -10,3 +10,8 @@ import (
 func (k Keeper) GetONFTModuleAccount(ctx sdk.Context) authtypes.ModuleAccountI {
 	return k.accountKeeper.GetModuleAccount(ctx, types.ModuleName)
 }
+
+// GetModuleAddress returns the oNFT module account address that holds escrowed assets
+func (k Keeper) GetModuleAddress() sdk.AccAddress {
+	return k.accountKeeper.GetModuleAddress(types.ModuleName)
+}
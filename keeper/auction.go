@@ -0,0 +1,302 @@
+package keeper
+
+import (
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/OmniFlix/onft/types"
+)
+
+// CreateAuction escrows the oNFT of the seller in the module account and
+// starts an auction with the given terms. A zero start time starts the
+// auction at the current block time.
+func (k Keeper) CreateAuction(ctx sdk.Context, auction types.Auction, seller sdk.AccAddress) (uint64, error) {
+	if auction.StartTime.IsZero() {
+		auction.StartTime = ctx.BlockTime()
+	}
+	if auction.StartTime.Before(ctx.BlockTime()) {
+		return 0, errorsmod.Wrapf(types.ErrInvalidAuction, "start time %s can not be in the past", auction.StartTime)
+	}
+	if !auction.EndTime.After(auction.StartTime) {
+		return 0, errorsmod.Wrapf(types.ErrInvalidAuction, "end time %s must be after start time %s", auction.EndTime, auction.StartTime)
+	}
+	if err := k.TransferOwnership(ctx, auction.DenomId, auction.OnftId, seller, k.GetModuleAddress()); err != nil {
+		return 0, err
+	}
+
+	auctionID := k.GetNextAuctionID(ctx)
+	k.SetNextAuctionID(ctx, auctionID+1)
+	auction.Id = auctionID
+	auction.Seller = seller.String()
+	auction.Status = types.AuctionStatusActive
+	auction.HighestBid = nil
+	auction.BidCount = 0
+	k.SetAuction(ctx, auction)
+	k.emitCreateAuctionEvent(ctx, auctionID, auction.DenomId, auction.OnftId, auction.Seller, auction.AuctionType.String())
+	return auctionID, nil
+}
+
+// PlaceBid escrows a bid on an open auction. A higher bid on an English
+// auction refunds the previous highest bidder and extends the auction when it
+// is placed within the extension window. A bid on a Dutch auction pays the
+// current price and ends the auction, it is settled at the end of the block.
+func (k Keeper) PlaceBid(ctx sdk.Context, auctionID uint64, amount sdk.Coin, bidder sdk.AccAddress) error {
+	auction, err := k.GetAuction(ctx, auctionID)
+	if err != nil {
+		return err
+	}
+	blockTime := ctx.BlockTime()
+	if !auction.IsOpen(blockTime) {
+		return errorsmod.Wrapf(types.ErrAuctionNotActive, "auction %d is not open for bids", auctionID)
+	}
+	bidderAddr := bidder.String()
+	if bidderAddr == auction.Seller {
+		return errorsmod.Wrap(types.ErrInvalidBid, "seller can not bid on own auction")
+	}
+	price := auction.CurrentPrice(blockTime)
+	if amount.Denom != price.Denom {
+		return errorsmod.Wrapf(types.ErrInvalidBid, "bid must be in %s", price.Denom)
+	}
+	if amount.IsLT(price) {
+		return errorsmod.Wrapf(types.ErrInvalidBid, "bid %s is lower than the current price %s", amount, price)
+	}
+	if auction.AuctionType == types.AuctionTypeDutch {
+		amount = price
+	}
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, bidder, types.ModuleName, sdk.NewCoins(amount)); err != nil {
+		return err
+	}
+	if auction.HighestBid != nil {
+		if err := k.refundBid(ctx, *auction.HighestBid); err != nil {
+			return err
+		}
+	}
+
+	bid := types.Bid{
+		AuctionId: auctionID,
+		Bidder:    bidderAddr,
+		Amount:    amount,
+		Time:      blockTime,
+	}
+	k.deleteAuctionByEndTime(ctx, auction)
+	switch {
+	case auction.AuctionType == types.AuctionTypeDutch:
+		auction.EndTime = blockTime
+	case auction.ExtensionWindow > 0 && auction.EndTime.Sub(blockTime) < auction.ExtensionWindow:
+		auction.EndTime = blockTime.Add(auction.ExtensionWindow)
+	}
+	auction.HighestBid = &bid
+	auction.BidCount++
+	k.SetAuction(ctx, auction)
+	k.setBid(ctx, auction.BidCount, bid)
+	k.emitPlaceBidEvent(ctx, auctionID, bidderAddr, amount.String(), auction.EndTime.String())
+	return nil
+}
+
+// CancelAuction returns the oNFT of an active auction without bids to the seller
+func (k Keeper) CancelAuction(ctx sdk.Context, auctionID uint64, sender sdk.AccAddress) error {
+	auction, err := k.GetAuction(ctx, auctionID)
+	if err != nil {
+		return err
+	}
+	if sender.String() != auction.Seller {
+		return errorsmod.Wrap(types.ErrUnauthorized, sender.String())
+	}
+	if auction.Status != types.AuctionStatusActive {
+		return errorsmod.Wrapf(types.ErrAuctionNotActive, "auction %d is %s", auctionID, auction.Status)
+	}
+	if auction.HighestBid != nil {
+		return errorsmod.Wrapf(types.ErrInvalidAuction, "auction %d already has bids", auctionID)
+	}
+	if err := k.TransferOwnership(ctx, auction.DenomId, auction.OnftId, k.GetModuleAddress(), sender); err != nil {
+		return err
+	}
+
+	k.deleteAuctionByEndTime(ctx, auction)
+	auction.Status = types.AuctionStatusCancelled
+	k.SetAuction(ctx, auction)
+	k.emitCancelAuctionEvent(ctx, auctionID, auction.Seller)
+	return nil
+}
+
+// SettleAuctions settles all the active auctions that ended at or before the
+// current block time. Each auction settles in its own cached context, an
+// auction that fails to settle is closed and its assets are returned.
+func (k Keeper) SettleAuctions(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(
+		types.KeyAuctionByEndTime(time.Time{}, 0),
+		sdk.PrefixEndBytes(types.KeyAuctionByEndTime(ctx.BlockTime(), 0)),
+	)
+	var auctionIDs []uint64
+	for ; iterator.Valid(); iterator.Next() {
+		auctionIDs = append(auctionIDs, sdk.BigEndianToUint64(iterator.Value()))
+	}
+	iterator.Close()
+
+	for _, auctionID := range auctionIDs {
+		auction, err := k.GetAuction(ctx, auctionID)
+		if err != nil {
+			continue
+		}
+		cacheCtx, write := ctx.CacheContext()
+		if err := k.settleAuction(cacheCtx, auction); err != nil {
+			k.Logger(ctx).Error("failed to settle auction", "auction-id", auctionID, "err", err)
+			cacheCtx, write = ctx.CacheContext()
+			if err := k.closeUnsettledAuction(cacheCtx, auction); err != nil {
+				k.Logger(ctx).Error("failed to close auction", "auction-id", auctionID, "err", err)
+				continue
+			}
+		}
+		write()
+		ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+	}
+}
+
+// settleAuction sells the oNFT of an ended auction to the highest bidder, or
+// returns it to the seller when there are no bids.
+func (k Keeper) settleAuction(ctx sdk.Context, auction types.Auction) error {
+	seller, err := sdk.AccAddressFromBech32(auction.Seller)
+	if err != nil {
+		return err
+	}
+	k.deleteAuctionByEndTime(ctx, auction)
+	if auction.HighestBid == nil {
+		if err := k.TransferOwnership(ctx, auction.DenomId, auction.OnftId, k.GetModuleAddress(), seller); err != nil {
+			return err
+		}
+		auction.Status = types.AuctionStatusUnsold
+		k.SetAuction(ctx, auction)
+		k.emitSettleAuctionEvent(ctx, auction.Id, auction.DenomId, auction.OnftId, auction.Seller, "", "", auction.Status.String())
+		return nil
+	}
+
+	winner, err := sdk.AccAddressFromBech32(auction.HighestBid.Bidder)
+	if err != nil {
+		return err
+	}
+	if err := k.settleSale(ctx, auction.DenomId, auction.OnftId, auction.HighestBid.Amount, seller); err != nil {
+		return err
+	}
+	if err := k.TransferOwnership(ctx, auction.DenomId, auction.OnftId, k.GetModuleAddress(), winner); err != nil {
+		return err
+	}
+	auction.Status = types.AuctionStatusSold
+	k.SetAuction(ctx, auction)
+	k.emitSettleAuctionEvent(ctx, auction.Id, auction.DenomId, auction.OnftId, auction.Seller, auction.HighestBid.Bidder, auction.HighestBid.Amount.String(), auction.Status.String())
+	return nil
+}
+
+// closeUnsettledAuction refunds the highest bid and returns the oNFT to the
+// seller of an auction that could not be settled.
+func (k Keeper) closeUnsettledAuction(ctx sdk.Context, auction types.Auction) error {
+	seller, err := sdk.AccAddressFromBech32(auction.Seller)
+	if err != nil {
+		return err
+	}
+	k.deleteAuctionByEndTime(ctx, auction)
+	if auction.HighestBid != nil {
+		if err := k.refundBid(ctx, *auction.HighestBid); err != nil {
+			return err
+		}
+	}
+	if err := k.TransferOwnership(ctx, auction.DenomId, auction.OnftId, k.GetModuleAddress(), seller); err != nil {
+		return err
+	}
+	auction.Status = types.AuctionStatusUnsold
+	k.SetAuction(ctx, auction)
+	k.emitSettleAuctionEvent(ctx, auction.Id, auction.DenomId, auction.OnftId, auction.Seller, "", "", auction.Status.String())
+	return nil
+}
+
+func (k Keeper) refundBid(ctx sdk.Context, bid types.Bid) error {
+	bidder, err := sdk.AccAddressFromBech32(bid.Bidder)
+	if err != nil {
+		return err
+	}
+	return k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, bidder, sdk.NewCoins(bid.Amount))
+}
+
+func (k Keeper) GetNextAuctionID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.NextAuctionIDKey)
+	if len(bz) == 0 {
+		return 1
+	}
+	return types.MustUnMarshalSupply(k.cdc, bz)
+}
+
+func (k Keeper) SetNextAuctionID(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.NextAuctionIDKey, types.MustMarshalSupply(k.cdc, id))
+}
+
+func (k Keeper) GetAuction(ctx sdk.Context, id uint64) (auction types.Auction, err error) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyAuction(id))
+	if bz == nil {
+		return auction, errorsmod.Wrapf(types.ErrUnknownAuction, "auction %d not found", id)
+	}
+	k.cdc.MustUnmarshal(bz, &auction)
+	return auction, nil
+}
+
+func (k Keeper) GetAuctions(ctx sdk.Context) (auctions []types.Auction) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyAuction(0))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var auction types.Auction
+		k.cdc.MustUnmarshal(iterator.Value(), &auction)
+		auctions = append(auctions, auction)
+	}
+	return auctions
+}
+
+// SetAuction stores an auction and indexes active auctions by end time. The
+// end time index of an auction must be removed before its end time changes.
+func (k Keeper) SetAuction(ctx sdk.Context, auction types.Auction) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&auction)
+	store.Set(types.KeyAuction(auction.Id), bz)
+	if auction.Status == types.AuctionStatusActive {
+		store.Set(types.KeyAuctionByEndTime(auction.EndTime, auction.Id), sdk.Uint64ToBigEndian(auction.Id))
+	}
+}
+
+func (k Keeper) deleteAuctionByEndTime(ctx sdk.Context, auction types.Auction) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.KeyAuctionByEndTime(auction.EndTime, auction.Id))
+}
+
+// GetBids returns the bid history of all auctions
+func (k Keeper) GetBids(ctx sdk.Context) (bids []types.Bid) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyBid(0, 0))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var bid types.Bid
+		k.cdc.MustUnmarshal(iterator.Value(), &bid)
+		bids = append(bids, bid)
+	}
+	return bids
+}
+
+// SetBids stores the bid history of an auction in the given order
+func (k Keeper) SetBids(ctx sdk.Context, bids []types.Bid) {
+	seqs := make(map[uint64]uint64)
+	for _, bid := range bids {
+		seqs[bid.AuctionId]++
+		k.setBid(ctx, seqs[bid.AuctionId], bid)
+	}
+}
+
+func (k Keeper) setBid(ctx sdk.Context, seq uint64, bid types.Bid) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&bid)
+	store.Set(types.KeyBid(bid.AuctionId, seq), bz)
+}
@@ -0,0 +1,135 @@
+package keeper_test
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/OmniFlix/onft/types"
+)
+
+func (s *KeeperTestSuite) englishAuction(endTime time.Time) types.Auction {
+	return types.Auction{
+		DenomId:         denomID,
+		OnftId:          onftID,
+		AuctionType:     types.AuctionTypeEnglish,
+		StartPrice:      sdk.NewInt64Coin(feeDenom, 100),
+		MinIncrement:    sdk.NewInt(10),
+		EndTime:         endTime,
+		ExtensionWindow: 10 * time.Minute,
+	}
+}
+
+func (s *KeeperTestSuite) TestEnglishAuction() {
+	s.createDenom(denomID, s.creator)
+	s.mint(denomID, onftID, s.creator, s.alice)
+	carol := testAddr("carol")
+	s.fund(s.bob, sdk.NewInt64Coin(feeDenom, 1000))
+	s.fund(carol, sdk.NewInt64Coin(feeDenom, 1000))
+
+	endTime := s.ctx.BlockTime().Add(time.Hour)
+	auctionID, err := s.keeper.CreateAuction(s.ctx, s.englishAuction(endTime), s.alice)
+	s.Require().NoError(err)
+	s.Require().Equal(s.keeper.GetModuleAddress(), s.owner(denomID, onftID))
+
+	s.Require().ErrorIs(s.keeper.PlaceBid(s.ctx, auctionID, sdk.NewInt64Coin(feeDenom, 99), s.bob), types.ErrInvalidBid)
+	s.Require().NoError(s.keeper.PlaceBid(s.ctx, auctionID, sdk.NewInt64Coin(feeDenom, 100), s.bob))
+	s.Require().Equal(int64(100), s.moduleBalance(feeDenom))
+
+	// an equal bid does not outbid the highest bid
+	s.Require().ErrorIs(s.keeper.PlaceBid(s.ctx, auctionID, sdk.NewInt64Coin(feeDenom, 100), carol), types.ErrInvalidBid)
+	s.Require().ErrorIs(s.keeper.PlaceBid(s.ctx, auctionID, sdk.NewInt64Coin(feeDenom, 109), carol), types.ErrInvalidBid)
+
+	// a bid within the extension window extends the auction and refunds the outbid bidder
+	s.nextBlock(55 * time.Minute)
+	s.Require().NoError(s.keeper.PlaceBid(s.ctx, auctionID, sdk.NewInt64Coin(feeDenom, 500), carol))
+	s.Require().Equal(int64(1000), s.balance(s.bob, feeDenom))
+	s.Require().Equal(int64(500), s.moduleBalance(feeDenom))
+	auction, err := s.keeper.GetAuction(s.ctx, auctionID)
+	s.Require().NoError(err)
+	s.Require().Equal(s.ctx.BlockTime().Add(10*time.Minute), auction.EndTime)
+	s.Require().Equal(uint64(2), auction.BidCount)
+
+	s.nextBlock(5 * time.Minute)
+	s.keeper.SettleAuctions(s.ctx)
+	s.Require().Equal(s.keeper.GetModuleAddress(), s.owner(denomID, onftID))
+
+	s.nextBlock(5 * time.Minute)
+	s.ctx = s.ctx.WithEventManager(sdk.NewEventManager())
+	s.keeper.SettleAuctions(s.ctx)
+	s.Require().Equal(carol, s.owner(denomID, onftID))
+	s.Require().Contains(s.ctx.EventManager().Events(), sdk.NewEvent(types.EventTypeSettleAuction,
+		sdk.NewAttribute(types.AttributeKeyAuctionID, fmt.Sprintf("%d", auctionID)),
+		sdk.NewAttribute(types.AttributeKeyDenomID, denomID),
+		sdk.NewAttribute(types.AttributeKeyNFTID, onftID),
+		sdk.NewAttribute(types.AttributeKeySeller, s.alice.String()),
+		sdk.NewAttribute(types.AttributeKeyBidder, carol.String()),
+		sdk.NewAttribute(types.AttributeKeyAmount, "500"+feeDenom),
+		sdk.NewAttribute(types.AttributeKeyStatus, types.AuctionStatusSold.String()),
+	))
+	s.Require().Equal(int64(50), s.balance(s.creator, feeDenom))
+	s.Require().Equal(int64(450), s.balance(s.alice, feeDenom))
+	s.Require().Equal(int64(0), s.moduleBalance(feeDenom))
+	auction, err = s.keeper.GetAuction(s.ctx, auctionID)
+	s.Require().NoError(err)
+	s.Require().Equal(types.AuctionStatusSold, auction.Status)
+}
+
+func (s *KeeperTestSuite) TestDutchAuction() {
+	s.createDenom(denomID, s.creator)
+	s.mint(denomID, onftID, s.creator, s.alice)
+	s.fund(s.bob, sdk.NewInt64Coin(feeDenom, 1000))
+
+	auctionID, err := s.keeper.CreateAuction(s.ctx, types.Auction{
+		DenomId:           denomID,
+		OnftId:            onftID,
+		AuctionType:       types.AuctionTypeDutch,
+		StartPrice:        sdk.NewInt64Coin(feeDenom, 1000),
+		FloorPrice:        sdk.NewInt64Coin(feeDenom, 200),
+		Decrement:         sdk.NewInt(100),
+		DecrementInterval: time.Minute,
+		EndTime:           s.ctx.BlockTime().Add(24 * time.Hour),
+	}, s.alice)
+	s.Require().NoError(err)
+
+	// a bid above the current price pays the current price
+	s.nextBlock(23 * time.Hour)
+	s.Require().NoError(s.keeper.PlaceBid(s.ctx, auctionID, sdk.NewInt64Coin(feeDenom, 1000), s.bob))
+	s.Require().Equal(int64(800), s.balance(s.bob, feeDenom))
+	s.Require().Equal(int64(200), s.moduleBalance(feeDenom))
+
+	s.keeper.SettleAuctions(s.ctx)
+	s.Require().Equal(s.bob, s.owner(denomID, onftID))
+	s.Require().Equal(int64(20), s.balance(s.creator, feeDenom))
+	s.Require().Equal(int64(180), s.balance(s.alice, feeDenom))
+	s.Require().Equal(int64(0), s.moduleBalance(feeDenom))
+}
+
+func (s *KeeperTestSuite) TestUnsoldAndCancelledAuctions() {
+	s.createDenom(denomID, s.creator)
+	s.mint(denomID, onftID, s.creator, s.alice)
+	s.mint(denomID, onftID2, s.creator, s.alice)
+
+	endTime := s.ctx.BlockTime().Add(time.Hour)
+	unsoldID, err := s.keeper.CreateAuction(s.ctx, s.englishAuction(endTime), s.alice)
+	s.Require().NoError(err)
+	cancelled := s.englishAuction(endTime)
+	cancelled.OnftId = onftID2
+	cancelledID, err := s.keeper.CreateAuction(s.ctx, cancelled, s.alice)
+	s.Require().NoError(err)
+
+	s.Require().ErrorIs(s.keeper.CancelAuction(s.ctx, cancelledID, s.bob), types.ErrUnauthorized)
+	s.Require().NoError(s.keeper.CancelAuction(s.ctx, cancelledID, s.alice))
+	s.Require().Equal(s.alice, s.owner(denomID, onftID2))
+
+	s.nextBlock(time.Hour)
+	s.keeper.SettleAuctions(s.ctx)
+	s.Require().Equal(s.alice, s.owner(denomID, onftID))
+	auction, err := s.keeper.GetAuction(s.ctx, unsoldID)
+	s.Require().NoError(err)
+	s.Require().Equal(types.AuctionStatusUnsold, auction.Status)
+	auction, err = s.keeper.GetAuction(s.ctx, cancelledID)
+	s.Require().NoError(err)
+	s.Require().Equal(types.AuctionStatusCancelled, auction.Status)
+}
@@ -0,0 +1,258 @@
+package keeper
+
+import (
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/OmniFlix/onft/types"
+)
+
+// CreateClaim registers a hash locked claim. An existing oNFT is escrowed in the
+// module account, otherwise the creator must be allowed to mint the template.
+func (k Keeper) CreateClaim(
+	ctx sdk.Context,
+	denomID, onftID string,
+	template *types.ONFTTemplate,
+	hash string,
+	expiry time.Time,
+	maxClaims uint64,
+	creator sdk.AccAddress,
+) (uint64, error) {
+	if !k.HasDenomID(ctx, denomID) {
+		return 0, errorsmod.Wrapf(types.ErrInvalidDenom, "denomID %s not exists", denomID)
+	}
+	if !expiry.After(ctx.BlockTime()) {
+		return 0, errorsmod.Wrapf(types.ErrInvalidClaim, "expiry %s must be in the future", expiry)
+	}
+	if len(onftID) > 0 {
+		if err := k.TransferOwnership(ctx, denomID, onftID, creator, k.GetModuleAddress()); err != nil {
+			return 0, err
+		}
+	} else if !k.HasPermissionToMint(ctx, denomID, creator) {
+		return 0, errorsmod.Wrapf(types.ErrUnauthorized, "only creator of denom has permission to mint")
+	}
+
+	claimID := k.GetNextClaimID(ctx)
+	k.SetNextClaimID(ctx, claimID+1)
+	k.SetClaim(ctx, types.Claim{
+		Id:        claimID,
+		Creator:   creator.String(),
+		DenomId:   denomID,
+		OnftId:    onftID,
+		Template:  template,
+		Hash:      hash,
+		Expiry:    expiry,
+		MaxClaims: maxClaims,
+	})
+	k.emitCreateClaimEvent(ctx, claimID, denomID, onftID, creator.String())
+	return claimID, nil
+}
+
+// CommitClaim stores the claimant's commitment to a claim secret. The secret can
+// only be revealed in a later block, so it can't be front-run from the mempool.
+func (k Keeper) CommitClaim(ctx sdk.Context, claimID uint64, commitment string, claimant sdk.AccAddress) error {
+	claim, err := k.GetClaim(ctx, claimID)
+	if err != nil {
+		return err
+	}
+	if claim.IsExpired(ctx.BlockTime()) {
+		return errorsmod.Wrapf(types.ErrClaimExpired, "claim %d expired at %s", claimID, claim.Expiry)
+	}
+	if k.HasClaimRecord(ctx, claimID, claimant) {
+		return errorsmod.Wrapf(types.ErrAlreadyClaimed, "%s already claimed from claim %d", claimant, claimID)
+	}
+	k.SetClaimCommitment(ctx, types.ClaimCommitment{
+		ClaimId:    claimID,
+		Claimant:   claimant.String(),
+		Commitment: commitment,
+		Height:     ctx.BlockHeight(),
+	})
+	k.emitCommitClaimEvent(ctx, claimID, claimant.String())
+	return nil
+}
+
+// ClaimONFT reveals the secret of a claim and sends the escrowed oNFT, or mints
+// a new oNFT from the claim template, to the claimant.
+func (k Keeper) ClaimONFT(ctx sdk.Context, claimID uint64, secret string, claimant sdk.AccAddress) (string, string, error) {
+	claim, err := k.GetClaim(ctx, claimID)
+	if err != nil {
+		return "", "", err
+	}
+	if claim.IsExpired(ctx.BlockTime()) {
+		return "", "", errorsmod.Wrapf(types.ErrClaimExpired, "claim %d expired at %s", claimID, claim.Expiry)
+	}
+	if claim.Claimed >= claim.MaxClaims {
+		return "", "", errorsmod.Wrapf(types.ErrClaimExhausted, "claim %d", claimID)
+	}
+	if k.HasClaimRecord(ctx, claimID, claimant) {
+		return "", "", errorsmod.Wrapf(types.ErrAlreadyClaimed, "%s already claimed from claim %d", claimant, claimID)
+	}
+	if !types.MatchClaimSecret(claim.Hash, secret) {
+		return "", "", errorsmod.Wrapf(types.ErrInvalidSecret, "secret does not match claim %d", claimID)
+	}
+	commitment, found := k.GetClaimCommitment(ctx, claimID, claimant)
+	if !found || commitment.Height >= ctx.BlockHeight() {
+		return "", "", errorsmod.Wrapf(types.ErrInvalidCommitment, "no commitment of %s in a previous block", claimant)
+	}
+	if !types.MatchClaimCommitment(commitment.Commitment, secret, claimant) {
+		return "", "", errorsmod.Wrapf(types.ErrInvalidCommitment, "commitment does not match secret and claimant")
+	}
+
+	onftID := claim.OnftId
+	if claim.IsMintClaim() {
+		creator, err := sdk.AccAddressFromBech32(claim.Creator)
+		if err != nil {
+			return "", "", err
+		}
+		onftID = types.ClaimONFTID(claimID, claim.Claimed+1)
+		if err := k.mintONFT(ctx, claim.DenomId, claim.Template.NewONFT(onftID, claimant, ctx.BlockTime()), creator, claimant); err != nil {
+			return "", "", err
+		}
+	} else if err := k.TransferOwnership(ctx, claim.DenomId, onftID, k.GetModuleAddress(), claimant); err != nil {
+		return "", "", err
+	}
+
+	claim.Claimed++
+	k.SetClaim(ctx, claim)
+	k.setClaimRecord(ctx, claimID, claimant)
+	k.deleteClaimCommitment(ctx, claimID, claimant)
+	k.emitClaimEvent(ctx, claimID, claim.DenomId, onftID, claimant.String())
+	return claim.DenomId, onftID, nil
+}
+
+// RefundClaim closes a claim and returns the escrowed oNFT, if it was not
+// claimed yet, to the creator.
+func (k Keeper) RefundClaim(ctx sdk.Context, claimID uint64, sender sdk.AccAddress) error {
+	claim, err := k.GetClaim(ctx, claimID)
+	if err != nil {
+		return err
+	}
+	if sender.String() != claim.Creator {
+		return errorsmod.Wrap(types.ErrUnauthorized, sender.String())
+	}
+	if !claim.IsMintClaim() && claim.Claimed == 0 {
+		if err := k.TransferOwnership(ctx, claim.DenomId, claim.OnftId, k.GetModuleAddress(), sender); err != nil {
+			return err
+		}
+	}
+
+	k.deleteClaim(ctx, claimID)
+	k.emitRefundClaimEvent(ctx, claimID, claim.DenomId, claim.OnftId, claim.Creator)
+	return nil
+}
+
+func (k Keeper) GetNextClaimID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.NextClaimIDKey)
+	if len(bz) == 0 {
+		return 1
+	}
+	return types.MustUnMarshalSupply(k.cdc, bz)
+}
+
+func (k Keeper) SetNextClaimID(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.NextClaimIDKey, types.MustMarshalSupply(k.cdc, id))
+}
+
+func (k Keeper) GetClaim(ctx sdk.Context, id uint64) (claim types.Claim, err error) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyClaim(id))
+	if bz == nil {
+		return claim, errorsmod.Wrapf(types.ErrUnknownClaim, "claim %d not found", id)
+	}
+	k.cdc.MustUnmarshal(bz, &claim)
+	return claim, nil
+}
+
+func (k Keeper) GetClaims(ctx sdk.Context) (claims []types.Claim) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyClaim(0))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var claim types.Claim
+		k.cdc.MustUnmarshal(iterator.Value(), &claim)
+		claims = append(claims, claim)
+	}
+	return claims
+}
+
+func (k Keeper) SetClaim(ctx sdk.Context, claim types.Claim) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&claim)
+	store.Set(types.KeyClaim(claim.Id), bz)
+}
+
+func (k Keeper) deleteClaim(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.KeyClaim(id))
+	k.deleteByPrefix(ctx, types.KeyClaimCommitment(id, nil))
+	k.deleteByPrefix(ctx, types.KeyClaimRecord(id, nil))
+}
+
+func (k Keeper) GetClaimCommitment(ctx sdk.Context, id uint64, claimant sdk.AccAddress) (commitment types.ClaimCommitment, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyClaimCommitment(id, claimant))
+	if bz == nil {
+		return commitment, false
+	}
+	k.cdc.MustUnmarshal(bz, &commitment)
+	return commitment, true
+}
+
+func (k Keeper) GetClaimCommitments(ctx sdk.Context) (commitments []types.ClaimCommitment) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyClaimCommitment(0, nil))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var commitment types.ClaimCommitment
+		k.cdc.MustUnmarshal(iterator.Value(), &commitment)
+		commitments = append(commitments, commitment)
+	}
+	return commitments
+}
+
+func (k Keeper) SetClaimCommitment(ctx sdk.Context, commitment types.ClaimCommitment) {
+	claimant, _ := sdk.AccAddressFromBech32(commitment.Claimant)
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&commitment)
+	store.Set(types.KeyClaimCommitment(commitment.ClaimId, claimant), bz)
+}
+
+func (k Keeper) deleteClaimCommitment(ctx sdk.Context, id uint64, claimant sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.KeyClaimCommitment(id, claimant))
+}
+
+func (k Keeper) HasClaimRecord(ctx sdk.Context, id uint64, claimant sdk.AccAddress) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.KeyClaimRecord(id, claimant))
+}
+
+func (k Keeper) GetClaimRecords(ctx sdk.Context) (records []types.ClaimRecord) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyClaimRecord(0, nil))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var record types.ClaimRecord
+		k.cdc.MustUnmarshal(iterator.Value(), &record)
+		records = append(records, record)
+	}
+	return records
+}
+
+func (k Keeper) SetClaimRecord(ctx sdk.Context, record types.ClaimRecord) {
+	claimant, _ := sdk.AccAddressFromBech32(record.Claimant)
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&record)
+	store.Set(types.KeyClaimRecord(record.ClaimId, claimant), bz)
+}
+
+func (k Keeper) setClaimRecord(ctx sdk.Context, id uint64, claimant sdk.AccAddress) {
+	k.SetClaimRecord(ctx, types.ClaimRecord{ClaimId: id, Claimant: claimant.String()})
+}
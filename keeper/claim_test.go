@@ -0,0 +1,74 @@
+package keeper_test
+
+import (
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/OmniFlix/onft/types"
+)
+
+const claimSecret = "onft claim secret"
+
+func (s *KeeperTestSuite) TestClaimEscrowedONFT() {
+	s.createDenom(denomID, s.creator)
+	s.mint(denomID, onftID, s.creator, s.creator)
+
+	expiry := s.ctx.BlockTime().Add(time.Hour)
+	claimID, err := s.keeper.CreateClaim(s.ctx, denomID, onftID, nil, types.HashClaimSecret(claimSecret), expiry, 1, s.creator)
+	s.Require().NoError(err)
+	s.Require().Equal(s.keeper.GetModuleAddress(), s.owner(denomID, onftID))
+
+	commitment := types.ClaimCommitmentHash(claimSecret, s.alice)
+	s.Require().NoError(s.keeper.CommitClaim(s.ctx, claimID, commitment, s.alice))
+	_, _, err = s.keeper.ClaimONFT(s.ctx, claimID, claimSecret, s.alice)
+	s.Require().ErrorIs(err, types.ErrInvalidCommitment)
+
+	s.nextBlock(time.Second)
+	_, _, err = s.keeper.ClaimONFT(s.ctx, claimID, "wrong secret", s.alice)
+	s.Require().ErrorIs(err, types.ErrInvalidSecret)
+	_, claimedID, err := s.keeper.ClaimONFT(s.ctx, claimID, claimSecret, s.alice)
+	s.Require().NoError(err)
+	s.Require().Equal(onftID, claimedID)
+	s.Require().Equal(s.alice, s.owner(denomID, onftID))
+
+	_, _, err = s.keeper.ClaimONFT(s.ctx, claimID, claimSecret, s.bob)
+	s.Require().ErrorIs(err, types.ErrClaimExhausted)
+}
+
+func (s *KeeperTestSuite) TestClaimUppercaseHash() {
+	s.createDenom(denomID, s.creator)
+	template := types.NewONFTTemplate(
+		types.Metadata{Name: "name", MediaURI: "https://onft.test/media"}, "{}",
+		true, true, false, sdk.NewDecWithPrec(1, 1),
+	)
+
+	hash := strings.ToUpper(types.HashClaimSecret(claimSecret))
+	expiry := s.ctx.BlockTime().Add(time.Hour)
+	claimID, err := s.keeper.CreateClaim(s.ctx, denomID, "", &template, hash, expiry, 2, s.creator)
+	s.Require().NoError(err)
+
+	commitment := strings.ToUpper(types.ClaimCommitmentHash(claimSecret, s.alice))
+	s.Require().NoError(s.keeper.CommitClaim(s.ctx, claimID, commitment, s.alice))
+	s.nextBlock(time.Second)
+	_, claimedID, err := s.keeper.ClaimONFT(s.ctx, claimID, claimSecret, s.alice)
+	s.Require().NoError(err)
+	s.Require().Equal(types.ClaimONFTID(claimID, 1), claimedID)
+	s.Require().Equal(s.alice, s.owner(denomID, claimedID))
+}
+
+func (s *KeeperTestSuite) TestRefundClaim() {
+	s.createDenom(denomID, s.creator)
+	s.mint(denomID, onftID, s.creator, s.creator)
+
+	expiry := s.ctx.BlockTime().Add(time.Hour)
+	claimID, err := s.keeper.CreateClaim(s.ctx, denomID, onftID, nil, types.HashClaimSecret(claimSecret), expiry, 1, s.creator)
+	s.Require().NoError(err)
+
+	s.Require().ErrorIs(s.keeper.RefundClaim(s.ctx, claimID, s.alice), types.ErrUnauthorized)
+	s.Require().NoError(s.keeper.RefundClaim(s.ctx, claimID, s.creator))
+	s.Require().Equal(s.creator, s.owner(denomID, onftID))
+	_, err = s.keeper.GetClaim(s.ctx, claimID)
+	s.Require().ErrorIs(err, types.ErrUnknownClaim)
+}
@@ -0,0 +1,72 @@
+package keeper
+
+import (
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/OmniFlix/onft/types"
+)
+
+// EnableClawback lets the creator claw back the oNFTs of the denom. It can
+// only be enabled before anything is minted, so holders always know.
+func (k Keeper) EnableClawback(ctx sdk.Context, denomID string, sender sdk.AccAddress) error {
+	denom, err := k.AuthorizeDenomCreator(ctx, denomID, sender)
+	if err != nil {
+		return err
+	}
+	if k.GetTotalSupply(ctx, denomID) > 0 {
+		return errorsmod.Wrapf(types.ErrInvalidClawback, "denom %s already has minted onfts", denomID)
+	}
+	denom.ClawbackEnabled = true
+	k.SetDenom(ctx, denom)
+	return nil
+}
+
+// ClawbackONFT moves an oNFT of a clawback enabled denom to the recipient,
+// or burns it when no recipient is given. The owner does not need to agree,
+// and the transferable flag and creator pauses do not apply.
+func (k Keeper) ClawbackONFT(ctx sdk.Context, denomID, onftID string, recipient, sender sdk.AccAddress) error {
+	denom, err := k.AuthorizeDenomCreator(ctx, denomID, sender)
+	if err != nil {
+		return err
+	}
+	if !denom.ClawbackEnabled {
+		return errorsmod.Wrapf(types.ErrInvalidClawback, "clawback is not enabled on denom %s", denomID)
+	}
+	nft, err := k.GetONFT(ctx, denomID, onftID)
+	if err != nil {
+		return err
+	}
+	onft := nft.(types.ONFT)
+	owner := onft.GetOwner()
+	if owner.Equals(k.GetModuleAddress()) {
+		return errorsmod.Wrapf(types.ErrInvalidClawback, "onft %s is held in escrow", onftID)
+	}
+
+	burn := recipient.Empty()
+	if !burn && k.IsDenomFrozen(ctx, denomID) {
+		return errorsmod.Wrapf(types.ErrDenomFrozen, "denom %s is frozen", denomID)
+	}
+
+	// the oNFT leaves its parent and its children are released to the owner
+	ref := types.NewONFTRef(denomID, onftID)
+	if parent, ok := k.GetNestingParent(ctx, denomID, onftID); ok {
+		k.deleteNesting(ctx, types.NewNesting(ref, parent))
+	}
+	for _, child := range k.GetNestedChildren(ctx, denomID, onftID) {
+		k.deleteNesting(ctx, types.NewNesting(child, ref))
+	}
+
+	if burn {
+		err = k.burnONFT(ctx, denomID, onft)
+	} else {
+		err = k.moveONFT(ctx, denomID, onft, owner, recipient)
+	}
+	if err != nil {
+		return err
+	}
+	k.emitClawbackONFTEvent(ctx, denomID, onftID, owner.String(), recipient.String(), sender.String(), strconv.FormatBool(burn))
+	return nil
+}
@@ -0,0 +1,58 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/OmniFlix/onft/types"
+)
+
+func (s *KeeperTestSuite) TestClawbackONFT() {
+	s.createDenom(denomID, s.creator)
+	s.Require().NoError(s.keeper.EnableClawback(s.ctx, denomID, s.creator))
+	s.mint(denomID, onftID, s.creator, s.alice)
+	s.mint(denomID, onftID2, s.creator, s.alice)
+	s.Require().NoError(s.keeper.PauseDenom(s.ctx, denomID, types.DenomPause{Transfer: true}, s.creator))
+
+	s.Require().ErrorIs(s.keeper.ClawbackONFT(s.ctx, denomID, onftID, s.bob, s.alice), types.ErrUnauthorized)
+	s.Require().NoError(s.keeper.ClawbackONFT(s.ctx, denomID, onftID, s.bob, s.creator))
+	s.Require().Equal(s.bob, s.owner(denomID, onftID))
+
+	// without a recipient the oNFT is burned
+	s.Require().NoError(s.keeper.ClawbackONFT(s.ctx, denomID, onftID2, nil, s.creator))
+	s.Require().False(s.keeper.HasONFT(s.ctx, denomID, onftID2))
+}
+
+func (s *KeeperTestSuite) TestClawbackONFTReleasesNesting() {
+	s.createDenom(denomID, s.creator)
+	s.createDenom(denomID2, s.creator)
+	s.Require().NoError(s.keeper.EnableClawback(s.ctx, denomID2, s.creator))
+	s.mint(denomID, onftID, s.creator, s.alice)
+	s.mint(denomID2, onftID2, s.creator, s.alice)
+	s.Require().NoError(s.keeper.NestONFT(s.ctx, childRef, parentRef, s.alice))
+
+	s.Require().NoError(s.keeper.ClawbackONFT(s.ctx, denomID2, onftID2, s.bob, s.creator))
+	s.Require().False(s.keeper.IsNested(s.ctx, denomID2, onftID2))
+	s.Require().Equal(s.bob, s.owner(denomID2, onftID2))
+	s.Require().Equal(s.alice, s.owner(denomID, onftID))
+}
+
+func (s *KeeperTestSuite) TestClawbackONFTRequiresEnabledDenom() {
+	s.createDenom(denomID, s.creator)
+	s.mint(denomID, onftID, s.creator, s.alice)
+
+	s.Require().ErrorIs(s.keeper.EnableClawback(s.ctx, denomID, s.creator), types.ErrInvalidClawback)
+	s.Require().ErrorIs(s.keeper.ClawbackONFT(s.ctx, denomID, onftID, s.bob, s.creator), types.ErrInvalidClawback)
+}
+
+func (s *KeeperTestSuite) TestClawbackONFTSkipsEscrow() {
+	s.createDenom(denomID, s.creator)
+	s.Require().NoError(s.keeper.EnableClawback(s.ctx, denomID, s.creator))
+	s.mint(denomID, onftID, s.creator, s.alice)
+	_, err := s.keeper.ListONFT(s.ctx, denomID, onftID, sdk.NewInt64Coin(feeDenom, 100), time.Time{}, s.alice)
+	s.Require().NoError(err)
+
+	s.Require().ErrorIs(s.keeper.ClawbackONFT(s.ctx, denomID, onftID, s.bob, s.creator), types.ErrInvalidClawback)
+	s.Require().Equal(s.keeper.GetModuleAddress(), s.owner(denomID, onftID))
+}
@@ -25,39 +25,22 @@ func (k Keeper) SetCollection(ctx sdk.Context, collection types.Collection) erro
 	if k.HasDenomSymbol(ctx, denom.Symbol) {
 		return errorsmod.Wrapf(types.ErrInvalidDenom, "denomSymbol %s has already exists", denom.Symbol)
 	}
-	k.SetDenom(ctx, types.NewDenom(
-		denom.Id,
-		denom.Symbol,
-		denom.Name,
-		denom.Schema,
-		creator,
-		denom.Description,
-		denom.PreviewURI,
-	))
+	k.SetDenom(ctx, denom)
 
 	k.setDenomOwner(ctx, denom.Id, creator)
 
 	for _, onft := range collection.ONFTs {
-		metadata := types.Metadata{
-			Name:        onft.GetName(),
-			Description: onft.GetDescription(),
-			MediaURI:    onft.GetMediaURI(),
-			PreviewURI:  onft.GetPreviewURI(),
+		if k.HasONFT(ctx, denom.Id, onft.GetID()) {
+			return errorsmod.Wrapf(types.ErrONFTAlreadyExists, "ONFT %s already exists in collection %s", onft.GetID(), denom.Id)
 		}
-
-		if err := k.MintONFT(ctx,
-			collection.Denom.Id,
-			onft.GetID(),
-			metadata,
-			onft.GetData(),
-			onft.IsTransferable(),
-			onft.IsExtensible(),
-			onft.IsNSFW(),
-			onft.RoyaltyShare,
-			creator,
-			onft.GetOwner(),
-		); err != nil {
-			return err
+		k.setONFT(ctx, denom.Id, onft)
+		k.setOwner(ctx, denom.Id, onft.GetID(), onft.GetOwner())
+		k.increaseSupply(ctx, denom.Id)
+		if onft.IsEdition() {
+			k.setEdition(ctx, denom.Id, onft.MasterId, onft.EditionNumber, onft.GetID())
+		}
+		if onft.ExpiresAt != nil {
+			k.setExpiring(ctx, *onft.ExpiresAt, denom.Id, onft.GetID())
 		}
 	}
 	return nil
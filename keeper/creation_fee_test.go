@@ -0,0 +1,59 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/OmniFlix/onft/types"
+)
+
+const usdcDenom = "ibc/usdc"
+
+// acceptCreationFees sets the accepted denom creation fees
+func (s *KeeperTestSuite) acceptCreationFees(fees ...sdk.Coin) {
+	params := s.keeper.GetParams(s.ctx)
+	params.DenomCreationFees = sdk.NewCoins(fees...)
+	s.Require().NoError(s.keeper.SetParams(s.ctx, params))
+}
+
+func (s *KeeperTestSuite) createDenomWithFee(id string, creator sdk.AccAddress, fee sdk.Coin) error {
+	msg := types.NewMsgCreateDenom(id, "name", "{}", "", "", creator.String(), fee)
+	msg.Id = id
+	_, err := s.msgServer.CreateDenom(s.ctx, msg)
+	return err
+}
+
+func (s *KeeperTestSuite) TestCreateDenomAcceptsAnyCreationFee() {
+	s.acceptCreationFees(sdk.NewInt64Coin(feeDenom, 100), sdk.NewInt64Coin(usdcDenom, 20))
+	s.fund(s.alice, sdk.NewInt64Coin(feeDenom, 100))
+	s.fund(s.bob, sdk.NewInt64Coin(usdcDenom, 20))
+
+	s.Require().NoError(s.createDenomWithFee(denomID, s.alice, sdk.NewInt64Coin(feeDenom, 100)))
+	s.Require().NoError(s.createDenomWithFee(denomID2, s.bob, sdk.NewInt64Coin(usdcDenom, 20)))
+	s.Require().Equal(int64(0), s.balance(s.alice, feeDenom))
+	s.Require().Equal(int64(0), s.balance(s.bob, usdcDenom))
+	s.Require().True(s.keeper.HasDenomID(s.ctx, denomID))
+	s.Require().True(s.keeper.HasDenomID(s.ctx, denomID2))
+}
+
+func (s *KeeperTestSuite) TestCreateDenomRejectsCreationFee() {
+	s.acceptCreationFees(sdk.NewInt64Coin(feeDenom, 100), sdk.NewInt64Coin(usdcDenom, 20))
+	s.fund(s.alice, sdk.NewInt64Coin(feeDenom, 1000), sdk.NewInt64Coin(bondDenom, 1000))
+
+	testCases := []struct {
+		name string
+		fee  sdk.Coin
+		err  error
+	}{
+		{"not accepted denom", sdk.NewInt64Coin(bondDenom, 100), types.ErrInvalidFeeDenom},
+		{"not enough", sdk.NewInt64Coin(feeDenom, 99), types.ErrNotEnoughFeeAmount},
+		{"too much", sdk.NewInt64Coin(feeDenom, 101), types.ErrInvalidDenomCreationFee},
+		{"amount of another accepted fee", sdk.NewInt64Coin(feeDenom, 20), types.ErrNotEnoughFeeAmount},
+	}
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.Require().ErrorIs(s.createDenomWithFee(denomID, s.alice, tc.fee), tc.err)
+			s.Require().False(s.keeper.HasDenomID(s.ctx, denomID))
+		})
+	}
+	s.Require().Equal(int64(1000), s.balance(s.alice, feeDenom))
+}
@@ -0,0 +1,112 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/OmniFlix/onft/types"
+)
+
+// EnableCredentialMode puts a denom without oNFTs in credential mode. Its
+// oNFTs are minted non-transferable and can be revoked by the issuer.
+func (k Keeper) EnableCredentialMode(ctx sdk.Context, denomID string, sender sdk.AccAddress) error {
+	denom, err := k.AuthorizeDenomCreator(ctx, denomID, sender)
+	if err != nil {
+		return err
+	}
+	if k.GetTotalSupply(ctx, denomID) > 0 {
+		return errorsmod.Wrapf(types.ErrInvalidCredential, "denom %s already has minted onfts", denomID)
+	}
+	denom.Credential = true
+	k.SetDenom(ctx, denom)
+	return nil
+}
+
+// IsCredentialDenom returns true if the denom is in credential mode
+func (k Keeper) IsCredentialDenom(ctx sdk.Context, denomID string) bool {
+	denom, err := k.GetDenom(ctx, denomID)
+	if err != nil {
+		return false
+	}
+	return denom.Credential
+}
+
+// RevokeONFT revokes a credential oNFT. The oNFT stays with its holder, and a
+// tombstone records the reason and time of the revocation. Anyone allowed to
+// mint into the denom can revoke its credentials.
+func (k Keeper) RevokeONFT(ctx sdk.Context, denomID, onftID, reason string, sender sdk.AccAddress) error {
+	if !k.IsCredentialDenom(ctx, denomID) {
+		return errorsmod.Wrapf(types.ErrInvalidCredential, "denom %s is not a credential denom", denomID)
+	}
+	if !k.HasPermissionToMint(ctx, denomID, sender) {
+		return errorsmod.Wrapf(types.ErrUnauthorized, "%s is not allowed to revoke credentials of denom %s", sender, denomID)
+	}
+	onft, err := k.GetONFT(ctx, denomID, onftID)
+	if err != nil {
+		return err
+	}
+	if k.IsRevoked(ctx, denomID, onftID) {
+		return errorsmod.Wrapf(types.ErrONFTRevoked, "onft %s of denom %s is already revoked", onftID, denomID)
+	}
+
+	k.SetRevocation(ctx, types.NewRevocation(denomID, onftID, onft.GetOwner(), sender, reason, ctx.BlockTime()))
+	k.emitRevokeONFTEvent(ctx, denomID, onftID, onft.GetOwner().String(), reason)
+	return nil
+}
+
+// GetCredentialStatus returns the status of a credential for verifiers. With
+// a holder, a valid credential held by another address is reported as not held.
+func (k Keeper) GetCredentialStatus(
+	ctx sdk.Context,
+	denomID, onftID string,
+	holder sdk.AccAddress,
+) (status types.CredentialStatus, owner string, revocation *types.Revocation) {
+	if !k.IsCredentialDenom(ctx, denomID) {
+		return types.CredentialStatusNotFound, "", nil
+	}
+	if r, err := k.GetRevocation(ctx, denomID, onftID); err == nil {
+		return types.CredentialStatusRevoked, r.Owner, &r
+	}
+	onft, err := k.GetONFT(ctx, denomID, onftID)
+	if err != nil {
+		return types.CredentialStatusNotFound, "", nil
+	}
+	owner = onft.GetOwner().String()
+	if holder != nil && !holder.Equals(onft.GetOwner()) {
+		return types.CredentialStatusNotHeld, owner, nil
+	}
+	return types.CredentialStatusValid, owner, nil
+}
+
+func (k Keeper) IsRevoked(ctx sdk.Context, denomID, onftID string) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.KeyRevocation(denomID, onftID))
+}
+
+func (k Keeper) GetRevocation(ctx sdk.Context, denomID, onftID string) (revocation types.Revocation, err error) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyRevocation(denomID, onftID))
+	if bz == nil {
+		return revocation, errorsmod.Wrapf(types.ErrInvalidCredential, "onft %s of denom %s is not revoked", onftID, denomID)
+	}
+	k.cdc.MustUnmarshal(bz, &revocation)
+	return revocation, nil
+}
+
+func (k Keeper) GetRevocations(ctx sdk.Context) (revocations []types.Revocation) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyRevocation("", ""))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var revocation types.Revocation
+		k.cdc.MustUnmarshal(iterator.Value(), &revocation)
+		revocations = append(revocations, revocation)
+	}
+	return revocations
+}
+
+func (k Keeper) SetRevocation(ctx sdk.Context, revocation types.Revocation) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.KeyRevocation(revocation.DenomId, revocation.OnftId), k.cdc.MustMarshal(&revocation))
+}
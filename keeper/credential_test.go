@@ -0,0 +1,46 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/OmniFlix/onft/types"
+)
+
+func (s *KeeperTestSuite) TestRevokeCredential() {
+	s.createDenom(denomID, s.creator)
+	s.Require().ErrorIs(s.keeper.EnableCredentialMode(s.ctx, denomID, s.alice), types.ErrUnauthorized)
+	s.Require().NoError(s.keeper.EnableCredentialMode(s.ctx, denomID, s.creator))
+	s.mint(denomID, onftID, s.creator, s.alice)
+
+	// credentials are minted non-transferable
+	s.Require().ErrorIs(s.keeper.TransferOwnership(s.ctx, denomID, onftID, s.alice, s.bob), types.ErrNotTransferable)
+	status, owner, _ := s.keeper.GetCredentialStatus(s.ctx, denomID, onftID, s.alice)
+	s.Require().Equal(types.CredentialStatusValid, status)
+	s.Require().Equal(s.alice.String(), owner)
+	status, _, _ = s.keeper.GetCredentialStatus(s.ctx, denomID, onftID, s.bob)
+	s.Require().Equal(types.CredentialStatusNotHeld, status)
+
+	s.Require().ErrorIs(s.keeper.RevokeONFT(s.ctx, denomID, onftID, "expired", s.alice), types.ErrUnauthorized)
+	s.Require().NoError(s.keeper.RevokeONFT(s.ctx, denomID, onftID, "expired", s.creator))
+	s.Require().ErrorIs(s.keeper.RevokeONFT(s.ctx, denomID, onftID, "expired", s.creator), types.ErrONFTRevoked)
+	status, _, revocation := s.keeper.GetCredentialStatus(s.ctx, denomID, onftID, s.alice)
+	s.Require().Equal(types.CredentialStatusRevoked, status)
+	s.Require().Equal("expired", revocation.Reason)
+
+	// a revoked credential can not be minted again after burning it
+	s.Require().NoError(s.keeper.BurnONFT(s.ctx, denomID, onftID, s.alice))
+	s.Require().ErrorIs(s.keeper.MintONFT(
+		s.ctx, denomID, onftID, types.Metadata{Name: "name"}, "{}",
+		true, true, false, sdk.ZeroDec(), s.creator, s.alice,
+	), types.ErrONFTRevoked)
+}
+
+func (s *KeeperTestSuite) TestEnableCredentialModeRequiresEmptyDenom() {
+	s.createDenom(denomID, s.creator)
+	s.mint(denomID, onftID, s.creator, s.alice)
+
+	s.Require().ErrorIs(s.keeper.EnableCredentialMode(s.ctx, denomID, s.creator), types.ErrInvalidCredential)
+	s.Require().ErrorIs(s.keeper.RevokeONFT(s.ctx, denomID, onftID, "", s.creator), types.ErrInvalidCredential)
+	status, _, _ := s.keeper.GetCredentialStatus(s.ctx, denomID, onftID, nil)
+	s.Require().Equal(types.CredentialStatusNotFound, status)
+}
@@ -0,0 +1,127 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/OmniFlix/onft/types"
+)
+
+// PrintEdition mints a numbered copy of a master oNFT. The copy shares the
+// metadata, data, flags and royalty share of the master.
+func (k Keeper) PrintEdition(
+	ctx sdk.Context,
+	denomID, masterID, onftID string,
+	sender, recipient sdk.AccAddress,
+) (uint64, error) {
+	master, err := k.GetONFT(ctx, denomID, masterID)
+	if err != nil {
+		return 0, err
+	}
+	masterONFT := master.(types.ONFT)
+	if !masterONFT.IsMasterEdition() {
+		return 0, errorsmod.Wrapf(types.ErrNotMasterEdition, "onft %s of denom %s", masterID, denomID)
+	}
+	if k.IsUnrevealed(ctx, denomID, masterID) {
+		return 0, errorsmod.Wrapf(types.ErrONFTUnrevealed, "editions of onft %s can not be printed before it is revealed", masterID)
+	}
+	printed := k.GetEditionsPrinted(ctx, denomID, masterID)
+	if printed >= masterONFT.MaxEditions {
+		return 0, errorsmod.Wrapf(
+			types.ErrEditionsExhausted,
+			"%d of %d editions of onft %s are printed", printed, masterONFT.MaxEditions, masterID,
+		)
+	}
+
+	edition := types.NewONFT(
+		onftID,
+		masterONFT.Metadata,
+		masterONFT.Data,
+		masterONFT.Transferable,
+		masterONFT.Extensible,
+		recipient,
+		ctx.BlockHeader().Time,
+		masterONFT.Nsfw,
+		masterONFT.RoyaltyShare,
+	)
+	edition.EditionNumber = printed + 1
+	edition.MasterId = masterID
+	edition.MaxUses = masterONFT.MaxUses
+	if err := k.mintONFT(ctx, denomID, edition, sender, sender); err != nil {
+		return 0, err
+	}
+	k.setEdition(ctx, denomID, masterID, edition.EditionNumber, onftID)
+	k.setEditionsPrinted(ctx, denomID, masterID, edition.EditionNumber)
+
+	k.emitPrintEditionEvent(ctx, onftID, denomID, masterID, edition.EditionNumber, recipient.String())
+	return edition.EditionNumber, nil
+}
+
+// GetEditionsPrinted returns the number of editions printed from a master oNFT
+func (k Keeper) GetEditionsPrinted(ctx sdk.Context, denomID, masterID string) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyEditionSupply(denomID, masterID))
+	if len(bz) == 0 {
+		return 0
+	}
+	return types.MustUnMarshalSupply(k.cdc, bz)
+}
+
+// GetEditions returns the oNFTs printed from a master oNFT that are not burned
+func (k Keeper) GetEditions(ctx sdk.Context, denomID, masterID string) (onfts []types.ONFT) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyEdition(denomID, masterID, 0))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		onftID := types.MustUnMarshalONFTID(k.cdc, iterator.Value())
+		onft, err := k.GetONFT(ctx, denomID, onftID)
+		if err != nil {
+			continue
+		}
+		onfts = append(onfts, onft.(types.ONFT))
+	}
+	return onfts
+}
+
+// GetEditionCounts returns the printed edition counters of all master oNFTs
+func (k Keeper) GetEditionCounts(ctx sdk.Context) (counts []types.EditionCount) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyEditionSupply("", ""))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		denomID, masterID, err := types.SplitKeyDenom(iterator.Key()[len(types.PrefixEditionSupply)+1:])
+		if err != nil {
+			continue
+		}
+		counts = append(counts, types.EditionCount{
+			DenomId:  denomID,
+			MasterId: masterID,
+			Printed:  types.MustUnMarshalSupply(k.cdc, iterator.Value()),
+		})
+	}
+	return counts
+}
+
+// SetEditionCount sets the printed edition counter of a master oNFT
+func (k Keeper) SetEditionCount(ctx sdk.Context, count types.EditionCount) {
+	k.setEditionsPrinted(ctx, count.DenomId, count.MasterId, count.Printed)
+}
+
+func (k Keeper) setEditionsPrinted(ctx sdk.Context, denomID, masterID string, printed uint64) {
+	store := ctx.KVStore(k.storeKey)
+	bz := types.MustMarshalSupply(k.cdc, printed)
+	store.Set(types.KeyEditionSupply(denomID, masterID), bz)
+}
+
+func (k Keeper) setEdition(ctx sdk.Context, denomID, masterID string, editionNumber uint64, onftID string) {
+	store := ctx.KVStore(k.storeKey)
+	bz := types.MustMarshalONFTID(k.cdc, onftID)
+	store.Set(types.KeyEdition(denomID, masterID, editionNumber), bz)
+}
+
+func (k Keeper) deleteEdition(ctx sdk.Context, denomID, masterID string, editionNumber uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.KeyEdition(denomID, masterID, editionNumber))
+}
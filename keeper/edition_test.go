@@ -0,0 +1,48 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/OmniFlix/onft/types"
+)
+
+func (s *KeeperTestSuite) TestPrintEdition() {
+	s.createDenom(denomID, s.creator)
+	msg := types.NewMsgMintONFT(
+		denomID, s.creator.String(), s.creator.String(),
+		types.Metadata{Name: "master", MediaURI: "https://onft.test/media"}, "{}",
+		true, true, false, sdk.NewDecWithPrec(1, 1),
+	)
+	msg.Id = onftID
+	msg.MaxEditions = 2
+	_, err := s.msgServer.MintONFT(s.ctx, msg)
+	s.Require().NoError(err)
+
+	number, err := s.keeper.PrintEdition(s.ctx, denomID, onftID, "edition1", s.creator, s.alice)
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(1), number)
+	number, err = s.keeper.PrintEdition(s.ctx, denomID, onftID, "edition2", s.creator, s.bob)
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(2), number)
+
+	edition, err := s.keeper.GetONFT(s.ctx, denomID, "edition2")
+	s.Require().NoError(err)
+	s.Require().Equal(s.bob, edition.GetOwner())
+	s.Require().Equal("master", edition.GetName())
+	s.Require().Equal(onftID, edition.(types.ONFT).MasterId)
+	s.Require().Len(s.keeper.GetEditions(s.ctx, denomID, onftID), 2)
+
+	_, err = s.keeper.PrintEdition(s.ctx, denomID, onftID, "edition3", s.creator, s.bob)
+	s.Require().ErrorIs(err, types.ErrEditionsExhausted)
+	s.Require().Equal(uint64(2), s.keeper.GetEditionsPrinted(s.ctx, denomID, onftID))
+}
+
+func (s *KeeperTestSuite) TestPrintEditionRequiresMaster() {
+	s.createDenom(denomID, s.creator)
+	s.mint(denomID, onftID, s.creator, s.creator)
+
+	_, err := s.keeper.PrintEdition(s.ctx, denomID, onftID, "edition1", s.creator, s.alice)
+	s.Require().ErrorIs(err, types.ErrNotMasterEdition)
+	_, err = s.keeper.PrintEdition(s.ctx, denomID, "missing", "edition1", s.creator, s.alice)
+	s.Require().Error(err)
+}
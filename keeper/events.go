@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"fmt"
+
 	onfttypes "github.com/OmniFlix/onft/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -29,49 +31,643 @@ func (k Keeper) emitUpdateONFTDenomEvent(ctx sdk.Context, denomId, symbol, name,
 	)
 }
 
-func (k Keeper) emitTransferONFTDenomEvent(ctx sdk.Context, denomId, symbol, sender, recipient string) {
+func (k Keeper) emitTransferONFTDenomEvent(ctx sdk.Context, denom onfttypes.Denom, sender, recipient string) error {
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			onfttypes.EventTypeTransferONFTDenom,
-			sdk.NewAttribute(onfttypes.AttributeKeyDenomID, denomId),
-			sdk.NewAttribute(onfttypes.AttributeKeySymbol, symbol),
+			sdk.NewAttribute(onfttypes.AttributeKeyDenomID, denom.Id),
+			sdk.NewAttribute(onfttypes.AttributeKeySymbol, denom.Symbol),
 			sdk.NewAttribute(onfttypes.AttributeKeySender, sender),
 			sdk.NewAttribute(onfttypes.AttributeKeyRecipient, recipient),
 		),
 	)
+	return ctx.EventManager().EmitTypedEvent(&onfttypes.EventTransferDenom{
+		Denom:     denom,
+		Sender:    sender,
+		Recipient: recipient,
+	})
+}
+
+// emitTypedCreateDenomEvent emits the denom as stored, after the options of
+// the creation message are applied
+func (k Keeper) emitTypedCreateDenomEvent(ctx sdk.Context, denomId string) error {
+	denom, err := k.GetDenom(ctx, denomId)
+	if err != nil {
+		return err
+	}
+	return ctx.EventManager().EmitTypedEvent(&onfttypes.EventCreateDenom{Denom: denom})
+}
+
+// emitTypedUpdateDenomEvent emits the denom as stored, after all the updates
+// of the message are applied
+func (k Keeper) emitTypedUpdateDenomEvent(ctx sdk.Context, denomId, sender string) error {
+	denom, err := k.GetDenom(ctx, denomId)
+	if err != nil {
+		return err
+	}
+	return ctx.EventManager().EmitTypedEvent(&onfttypes.EventUpdateDenom{Denom: denom, Sender: sender})
 }
 
-func (k Keeper) emitMintONFTEvent(ctx sdk.Context, nftId, denomId, uri, owner string) {
+func (k Keeper) emitMintONFTEvent(ctx sdk.Context, denomId string, onft onfttypes.ONFT, sender string) error {
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			onfttypes.EventTypeMintONFT,
-			sdk.NewAttribute(onfttypes.AttributeKeyNFTID, nftId),
+			sdk.NewAttribute(onfttypes.AttributeKeyNFTID, onft.Id),
 			sdk.NewAttribute(onfttypes.AttributeKeyDenomID, denomId),
-			sdk.NewAttribute(onfttypes.AttributeKeyMediaURI, uri),
-			sdk.NewAttribute(onfttypes.AttributeKeyOwner, owner),
+			sdk.NewAttribute(onfttypes.AttributeKeyMediaURI, onft.Metadata.MediaURI),
+			sdk.NewAttribute(onfttypes.AttributeKeyOwner, onft.Owner),
 		),
 	)
+	return ctx.EventManager().EmitTypedEvent(&onfttypes.EventMintONFT{
+		DenomId: denomId,
+		Onft:    onft,
+		Sender:  sender,
+	})
 }
 
-func (k Keeper) emitTransferONFTEvent(ctx sdk.Context, nftId, denomId, sender, recipient string) {
+func (k Keeper) emitTransferONFTEvent(ctx sdk.Context, denomId string, onft onfttypes.ONFT, sender, recipient string) error {
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			onfttypes.EventTypeTransferONFT,
-			sdk.NewAttribute(onfttypes.AttributeKeyNFTID, nftId),
+			sdk.NewAttribute(onfttypes.AttributeKeyNFTID, onft.Id),
 			sdk.NewAttribute(onfttypes.AttributeKeyDenomID, denomId),
 			sdk.NewAttribute(onfttypes.AttributeKeySender, sender),
 			sdk.NewAttribute(onfttypes.AttributeKeyRecipient, recipient),
 		),
 	)
+	return ctx.EventManager().EmitTypedEvent(&onfttypes.EventTransferONFT{
+		DenomId:   denomId,
+		Onft:      onft,
+		Sender:    sender,
+		Recipient: recipient,
+	})
 }
 
-func (k Keeper) emitBurnONFTEvent(ctx sdk.Context, nftId, denomId, owner string) {
+func (k Keeper) emitBurnONFTEvent(ctx sdk.Context, denomId string, onft onfttypes.ONFT) error {
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			onfttypes.EventTypeBurnONFT,
+			sdk.NewAttribute(onfttypes.AttributeKeyNFTID, onft.Id),
+			sdk.NewAttribute(onfttypes.AttributeKeyDenomID, denomId),
+			sdk.NewAttribute(onfttypes.AttributeKeyOwner, onft.Owner),
+		),
+	)
+	return ctx.EventManager().EmitTypedEvent(&onfttypes.EventBurnONFT{
+		DenomId: denomId,
+		Onft:    onft,
+	})
+}
+
+func (k Keeper) emitPrintEditionEvent(ctx sdk.Context, nftId, denomId, masterId string, edition uint64, owner string) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			onfttypes.EventTypePrintEdition,
+			sdk.NewAttribute(onfttypes.AttributeKeyNFTID, nftId),
+			sdk.NewAttribute(onfttypes.AttributeKeyDenomID, denomId),
+			sdk.NewAttribute(onfttypes.AttributeKeyMasterID, masterId),
+			sdk.NewAttribute(onfttypes.AttributeKeyEdition, fmt.Sprintf("%d", edition)),
+			sdk.NewAttribute(onfttypes.AttributeKeyOwner, owner),
+		),
+	)
+}
+
+func (k Keeper) emitCreateClaimEvent(ctx sdk.Context, claimId uint64, denomId, nftId, creator string) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			onfttypes.EventTypeCreateClaim,
+			sdk.NewAttribute(onfttypes.AttributeKeyClaimID, fmt.Sprintf("%d", claimId)),
+			sdk.NewAttribute(onfttypes.AttributeKeyDenomID, denomId),
+			sdk.NewAttribute(onfttypes.AttributeKeyNFTID, nftId),
+			sdk.NewAttribute(onfttypes.AttributeKeyCreator, creator),
+		),
+	)
+}
+
+func (k Keeper) emitCommitClaimEvent(ctx sdk.Context, claimId uint64, claimant string) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			onfttypes.EventTypeCommitClaim,
+			sdk.NewAttribute(onfttypes.AttributeKeyClaimID, fmt.Sprintf("%d", claimId)),
+			sdk.NewAttribute(onfttypes.AttributeKeyClaimant, claimant),
+		),
+	)
+}
+
+func (k Keeper) emitClaimEvent(ctx sdk.Context, claimId uint64, denomId, nftId, claimant string) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			onfttypes.EventTypeClaim,
+			sdk.NewAttribute(onfttypes.AttributeKeyClaimID, fmt.Sprintf("%d", claimId)),
+			sdk.NewAttribute(onfttypes.AttributeKeyDenomID, denomId),
+			sdk.NewAttribute(onfttypes.AttributeKeyNFTID, nftId),
+			sdk.NewAttribute(onfttypes.AttributeKeyClaimant, claimant),
+		),
+	)
+}
+
+func (k Keeper) emitRefundClaimEvent(ctx sdk.Context, claimId uint64, denomId, nftId, creator string) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			onfttypes.EventTypeRefundClaim,
+			sdk.NewAttribute(onfttypes.AttributeKeyClaimID, fmt.Sprintf("%d", claimId)),
+			sdk.NewAttribute(onfttypes.AttributeKeyDenomID, denomId),
+			sdk.NewAttribute(onfttypes.AttributeKeyNFTID, nftId),
+			sdk.NewAttribute(onfttypes.AttributeKeyCreator, creator),
+		),
+	)
+}
+
+func (k Keeper) emitCreateMintAirdropEvent(ctx sdk.Context, airdropId uint64, denomId, merkleRoot, creator string) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			onfttypes.EventTypeCreateMintAirdrop,
+			sdk.NewAttribute(onfttypes.AttributeKeyAirdropID, fmt.Sprintf("%d", airdropId)),
+			sdk.NewAttribute(onfttypes.AttributeKeyDenomID, denomId),
+			sdk.NewAttribute(onfttypes.AttributeKeyMerkleRoot, merkleRoot),
+			sdk.NewAttribute(onfttypes.AttributeKeyCreator, creator),
+		),
+	)
+}
+
+func (k Keeper) emitClaimAirdropEvent(ctx sdk.Context, airdropId uint64, denomId, nftId, claimant string) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			onfttypes.EventTypeClaimAirdrop,
+			sdk.NewAttribute(onfttypes.AttributeKeyAirdropID, fmt.Sprintf("%d", airdropId)),
+			sdk.NewAttribute(onfttypes.AttributeKeyDenomID, denomId),
+			sdk.NewAttribute(onfttypes.AttributeKeyNFTID, nftId),
+			sdk.NewAttribute(onfttypes.AttributeKeyClaimant, claimant),
+		),
+	)
+}
+
+func (k Keeper) emitCreateSwapEvent(ctx sdk.Context, swapId uint64, creator, counterparty string) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			onfttypes.EventTypeCreateSwap,
+			sdk.NewAttribute(onfttypes.AttributeKeySwapID, fmt.Sprintf("%d", swapId)),
+			sdk.NewAttribute(onfttypes.AttributeKeyCreator, creator),
+			sdk.NewAttribute(onfttypes.AttributeKeyRecipient, counterparty),
+		),
+	)
+}
+
+func (k Keeper) emitAcceptSwapEvent(ctx sdk.Context, swapId uint64, creator, acceptor string) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			onfttypes.EventTypeAcceptSwap,
+			sdk.NewAttribute(onfttypes.AttributeKeySwapID, fmt.Sprintf("%d", swapId)),
+			sdk.NewAttribute(onfttypes.AttributeKeyCreator, creator),
+			sdk.NewAttribute(onfttypes.AttributeKeyAcceptor, acceptor),
+		),
+	)
+}
+
+func (k Keeper) emitCancelSwapEvent(ctx sdk.Context, swapId uint64, creator, sender string) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			onfttypes.EventTypeCancelSwap,
+			sdk.NewAttribute(onfttypes.AttributeKeySwapID, fmt.Sprintf("%d", swapId)),
+			sdk.NewAttribute(onfttypes.AttributeKeyCreator, creator),
+			sdk.NewAttribute(onfttypes.AttributeKeySender, sender),
+		),
+	)
+}
+
+func (k Keeper) emitListONFTEvent(ctx sdk.Context, listingId uint64, denomId, nftId, seller, price string) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			onfttypes.EventTypeListONFT,
+			sdk.NewAttribute(onfttypes.AttributeKeyListingID, fmt.Sprintf("%d", listingId)),
+			sdk.NewAttribute(onfttypes.AttributeKeyDenomID, denomId),
+			sdk.NewAttribute(onfttypes.AttributeKeyNFTID, nftId),
+			sdk.NewAttribute(onfttypes.AttributeKeySeller, seller),
+			sdk.NewAttribute(onfttypes.AttributeKeyPrice, price),
+		),
+	)
+}
+
+func (k Keeper) emitBuyONFTEvent(ctx sdk.Context, listingId uint64, denomId, nftId, seller, buyer, price string) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			onfttypes.EventTypeBuyONFT,
+			sdk.NewAttribute(onfttypes.AttributeKeyListingID, fmt.Sprintf("%d", listingId)),
+			sdk.NewAttribute(onfttypes.AttributeKeyDenomID, denomId),
+			sdk.NewAttribute(onfttypes.AttributeKeyNFTID, nftId),
+			sdk.NewAttribute(onfttypes.AttributeKeySeller, seller),
+			sdk.NewAttribute(onfttypes.AttributeKeyBuyer, buyer),
+			sdk.NewAttribute(onfttypes.AttributeKeyPrice, price),
+		),
+	)
+}
+
+func (k Keeper) emitDelistONFTEvent(ctx sdk.Context, listingId uint64, denomId, nftId, seller, sender string) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			onfttypes.EventTypeDelistONFT,
+			sdk.NewAttribute(onfttypes.AttributeKeyListingID, fmt.Sprintf("%d", listingId)),
+			sdk.NewAttribute(onfttypes.AttributeKeyDenomID, denomId),
+			sdk.NewAttribute(onfttypes.AttributeKeyNFTID, nftId),
+			sdk.NewAttribute(onfttypes.AttributeKeySeller, seller),
+			sdk.NewAttribute(onfttypes.AttributeKeySender, sender),
+		),
+	)
+}
+
+func (k Keeper) emitMakeOfferEvent(ctx sdk.Context, offerId uint64, denomId, nftId, buyer, amount string) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			onfttypes.EventTypeMakeOffer,
+			sdk.NewAttribute(onfttypes.AttributeKeyOfferID, fmt.Sprintf("%d", offerId)),
+			sdk.NewAttribute(onfttypes.AttributeKeyDenomID, denomId),
+			sdk.NewAttribute(onfttypes.AttributeKeyNFTID, nftId),
+			sdk.NewAttribute(onfttypes.AttributeKeyBuyer, buyer),
+			sdk.NewAttribute(onfttypes.AttributeKeyAmount, amount),
+		),
+	)
+}
+
+func (k Keeper) emitAcceptOfferEvent(ctx sdk.Context, offerId uint64, denomId, nftId, seller, buyer, amount string) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			onfttypes.EventTypeAcceptOffer,
+			sdk.NewAttribute(onfttypes.AttributeKeyOfferID, fmt.Sprintf("%d", offerId)),
+			sdk.NewAttribute(onfttypes.AttributeKeyDenomID, denomId),
+			sdk.NewAttribute(onfttypes.AttributeKeyNFTID, nftId),
+			sdk.NewAttribute(onfttypes.AttributeKeySeller, seller),
+			sdk.NewAttribute(onfttypes.AttributeKeyBuyer, buyer),
+			sdk.NewAttribute(onfttypes.AttributeKeyAmount, amount),
+		),
+	)
+}
+
+func (k Keeper) emitCancelOfferEvent(ctx sdk.Context, offerId uint64, buyer, sender string) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			onfttypes.EventTypeCancelOffer,
+			sdk.NewAttribute(onfttypes.AttributeKeyOfferID, fmt.Sprintf("%d", offerId)),
+			sdk.NewAttribute(onfttypes.AttributeKeyBuyer, buyer),
+			sdk.NewAttribute(onfttypes.AttributeKeySender, sender),
+		),
+	)
+}
+
+func (k Keeper) emitRoyaltyPaidEvent(ctx sdk.Context, denomId, nftId, royalty, platformFee string) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			onfttypes.EventTypeRoyaltyPaid,
+			sdk.NewAttribute(onfttypes.AttributeKeyDenomID, denomId),
+			sdk.NewAttribute(onfttypes.AttributeKeyNFTID, nftId),
+			sdk.NewAttribute(onfttypes.AttributeKeyRoyalty, royalty),
+			sdk.NewAttribute(onfttypes.AttributeKeyPlatformFee, platformFee),
+		),
+	)
+}
+
+func (k Keeper) emitCreateAuctionEvent(ctx sdk.Context, auctionId uint64, denomId, nftId, seller, auctionType string) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			onfttypes.EventTypeCreateAuction,
+			sdk.NewAttribute(onfttypes.AttributeKeyAuctionID, fmt.Sprintf("%d", auctionId)),
+			sdk.NewAttribute(onfttypes.AttributeKeyDenomID, denomId),
+			sdk.NewAttribute(onfttypes.AttributeKeyNFTID, nftId),
+			sdk.NewAttribute(onfttypes.AttributeKeySeller, seller),
+			sdk.NewAttribute(onfttypes.AttributeKeyAuctionType, auctionType),
+		),
+	)
+}
+
+func (k Keeper) emitPlaceBidEvent(ctx sdk.Context, auctionId uint64, bidder, amount, endTime string) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			onfttypes.EventTypePlaceBid,
+			sdk.NewAttribute(onfttypes.AttributeKeyAuctionID, fmt.Sprintf("%d", auctionId)),
+			sdk.NewAttribute(onfttypes.AttributeKeyBidder, bidder),
+			sdk.NewAttribute(onfttypes.AttributeKeyAmount, amount),
+			sdk.NewAttribute(onfttypes.AttributeKeyEndTime, endTime),
+		),
+	)
+}
+
+func (k Keeper) emitCancelAuctionEvent(ctx sdk.Context, auctionId uint64, seller string) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			onfttypes.EventTypeCancelAuction,
+			sdk.NewAttribute(onfttypes.AttributeKeyAuctionID, fmt.Sprintf("%d", auctionId)),
+			sdk.NewAttribute(onfttypes.AttributeKeySeller, seller),
+		),
+	)
+}
+
+func (k Keeper) emitSettleAuctionEvent(ctx sdk.Context, auctionId uint64, denomId, nftId, seller, winner, amount, status string) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			onfttypes.EventTypeSettleAuction,
+			sdk.NewAttribute(onfttypes.AttributeKeyAuctionID, fmt.Sprintf("%d", auctionId)),
+			sdk.NewAttribute(onfttypes.AttributeKeyDenomID, denomId),
+			sdk.NewAttribute(onfttypes.AttributeKeyNFTID, nftId),
+			sdk.NewAttribute(onfttypes.AttributeKeySeller, seller),
+			sdk.NewAttribute(onfttypes.AttributeKeyBidder, winner),
+			sdk.NewAttribute(onfttypes.AttributeKeyAmount, amount),
+			sdk.NewAttribute(onfttypes.AttributeKeyStatus, status),
+		),
+	)
+}
+
+func (k Keeper) emitRequestLoanEvent(ctx sdk.Context, loanId uint64, denomId, nftId, borrower, principal string) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			onfttypes.EventTypeRequestLoan,
+			sdk.NewAttribute(onfttypes.AttributeKeyLoanID, fmt.Sprintf("%d", loanId)),
+			sdk.NewAttribute(onfttypes.AttributeKeyDenomID, denomId),
 			sdk.NewAttribute(onfttypes.AttributeKeyNFTID, nftId),
+			sdk.NewAttribute(onfttypes.AttributeKeyBorrower, borrower),
+			sdk.NewAttribute(onfttypes.AttributeKeyAmount, principal),
+		),
+	)
+}
+
+func (k Keeper) emitFundLoanEvent(ctx sdk.Context, loanId uint64, borrower, lender, dueTime string) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			onfttypes.EventTypeFundLoan,
+			sdk.NewAttribute(onfttypes.AttributeKeyLoanID, fmt.Sprintf("%d", loanId)),
+			sdk.NewAttribute(onfttypes.AttributeKeyBorrower, borrower),
+			sdk.NewAttribute(onfttypes.AttributeKeyLender, lender),
+			sdk.NewAttribute(onfttypes.AttributeKeyDueTime, dueTime),
+		),
+	)
+}
+
+func (k Keeper) emitRepayLoanEvent(ctx sdk.Context, loanId uint64, borrower, lender, amount string) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			onfttypes.EventTypeRepayLoan,
+			sdk.NewAttribute(onfttypes.AttributeKeyLoanID, fmt.Sprintf("%d", loanId)),
+			sdk.NewAttribute(onfttypes.AttributeKeyBorrower, borrower),
+			sdk.NewAttribute(onfttypes.AttributeKeyLender, lender),
+			sdk.NewAttribute(onfttypes.AttributeKeyAmount, amount),
+		),
+	)
+}
+
+func (k Keeper) emitClaimDefaultEvent(ctx sdk.Context, loanId uint64, borrower, lender string) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			onfttypes.EventTypeClaimDefault,
+			sdk.NewAttribute(onfttypes.AttributeKeyLoanID, fmt.Sprintf("%d", loanId)),
+			sdk.NewAttribute(onfttypes.AttributeKeyBorrower, borrower),
+			sdk.NewAttribute(onfttypes.AttributeKeyLender, lender),
+		),
+	)
+}
+
+func (k Keeper) emitCancelLoanEvent(ctx sdk.Context, loanId uint64, borrower string) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			onfttypes.EventTypeCancelLoan,
+			sdk.NewAttribute(onfttypes.AttributeKeyLoanID, fmt.Sprintf("%d", loanId)),
+			sdk.NewAttribute(onfttypes.AttributeKeyBorrower, borrower),
+		),
+	)
+}
+
+func (k Keeper) emitFractionalizeEvent(ctx sdk.Context, denomId, onftId, owner, shares string) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			onfttypes.EventTypeFractionalize,
+			sdk.NewAttribute(onfttypes.AttributeKeyDenomID, denomId),
+			sdk.NewAttribute(onfttypes.AttributeKeyNFTID, onftId),
+			sdk.NewAttribute(onfttypes.AttributeKeyOwner, owner),
+			sdk.NewAttribute(onfttypes.AttributeKeyShares, shares),
+		),
+	)
+}
+
+func (k Keeper) emitRedeemEvent(ctx sdk.Context, denomId, onftId, sender, shares, amount string) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			onfttypes.EventTypeRedeem,
+			sdk.NewAttribute(onfttypes.AttributeKeyDenomID, denomId),
+			sdk.NewAttribute(onfttypes.AttributeKeyNFTID, onftId),
+			sdk.NewAttribute(onfttypes.AttributeKeySender, sender),
+			sdk.NewAttribute(onfttypes.AttributeKeyShares, shares),
+			sdk.NewAttribute(onfttypes.AttributeKeyAmount, amount),
+		),
+	)
+}
+
+func (k Keeper) emitBuyoutEvent(ctx sdk.Context, denomId, onftId, buyer, shares, price string) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			onfttypes.EventTypeBuyout,
+			sdk.NewAttribute(onfttypes.AttributeKeyDenomID, denomId),
+			sdk.NewAttribute(onfttypes.AttributeKeyNFTID, onftId),
+			sdk.NewAttribute(onfttypes.AttributeKeyBuyer, buyer),
+			sdk.NewAttribute(onfttypes.AttributeKeyShares, shares),
+			sdk.NewAttribute(onfttypes.AttributeKeyPrice, price),
+		),
+	)
+}
+
+func (k Keeper) emitNestONFTEvent(ctx sdk.Context, denomId, onftId, parent, sender string) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			onfttypes.EventTypeNestONFT,
+			sdk.NewAttribute(onfttypes.AttributeKeyDenomID, denomId),
+			sdk.NewAttribute(onfttypes.AttributeKeyNFTID, onftId),
+			sdk.NewAttribute(onfttypes.AttributeKeyParent, parent),
+			sdk.NewAttribute(onfttypes.AttributeKeySender, sender),
+		),
+	)
+}
+
+func (k Keeper) emitUnnestONFTEvent(ctx sdk.Context, denomId, onftId, parent, sender string) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			onfttypes.EventTypeUnnestONFT,
+			sdk.NewAttribute(onfttypes.AttributeKeyDenomID, denomId),
+			sdk.NewAttribute(onfttypes.AttributeKeyNFTID, onftId),
+			sdk.NewAttribute(onfttypes.AttributeKeyParent, parent),
+			sdk.NewAttribute(onfttypes.AttributeKeySender, sender),
+		),
+	)
+}
+
+func (k Keeper) emitCreateTokenAccountEvent(ctx sdk.Context, denomId, onftId, owner, account string) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			onfttypes.EventTypeCreateTokenAccount,
+			sdk.NewAttribute(onfttypes.AttributeKeyDenomID, denomId),
+			sdk.NewAttribute(onfttypes.AttributeKeyNFTID, onftId),
+			sdk.NewAttribute(onfttypes.AttributeKeyOwner, owner),
+			sdk.NewAttribute(onfttypes.AttributeKeyAccount, account),
+		),
+	)
+}
+
+func (k Keeper) emitExecuteFromTokenAccountEvent(ctx sdk.Context, denomId, onftId, sender, account string) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			onfttypes.EventTypeExecuteFromTokenAccount,
+			sdk.NewAttribute(onfttypes.AttributeKeyDenomID, denomId),
+			sdk.NewAttribute(onfttypes.AttributeKeyNFTID, onftId),
+			sdk.NewAttribute(onfttypes.AttributeKeySender, sender),
+			sdk.NewAttribute(onfttypes.AttributeKeyAccount, account),
+		),
+	)
+}
+
+func (k Keeper) emitCreateRecipeEvent(ctx sdk.Context, recipeId uint64, denomId, creator string) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			onfttypes.EventTypeCreateRecipe,
+			sdk.NewAttribute(onfttypes.AttributeKeyRecipeID, fmt.Sprintf("%d", recipeId)),
+			sdk.NewAttribute(onfttypes.AttributeKeyDenomID, denomId),
+			sdk.NewAttribute(onfttypes.AttributeKeyCreator, creator),
+		),
+	)
+}
+
+func (k Keeper) emitExecuteRecipeEvent(ctx sdk.Context, recipeId uint64, denomId, onftId, sender string) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			onfttypes.EventTypeExecuteRecipe,
+			sdk.NewAttribute(onfttypes.AttributeKeyRecipeID, fmt.Sprintf("%d", recipeId)),
+			sdk.NewAttribute(onfttypes.AttributeKeyDenomID, denomId),
+			sdk.NewAttribute(onfttypes.AttributeKeyNFTID, onftId),
+			sdk.NewAttribute(onfttypes.AttributeKeySender, sender),
+		),
+	)
+}
+
+func (k Keeper) emitDeleteRecipeEvent(ctx sdk.Context, recipeId uint64, creator string) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			onfttypes.EventTypeDeleteRecipe,
+			sdk.NewAttribute(onfttypes.AttributeKeyRecipeID, fmt.Sprintf("%d", recipeId)),
+			sdk.NewAttribute(onfttypes.AttributeKeyCreator, creator),
+		),
+	)
+}
+
+func (k Keeper) emitRevealONFTEvent(ctx sdk.Context, denomId, onftId, name, mediaURI, previewURI string) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			onfttypes.EventTypeRevealONFT,
+			sdk.NewAttribute(onfttypes.AttributeKeyDenomID, denomId),
+			sdk.NewAttribute(onfttypes.AttributeKeyNFTID, onftId),
+			sdk.NewAttribute(onfttypes.AttributeKeyName, name),
+			sdk.NewAttribute(onfttypes.AttributeKeyMediaURI, mediaURI),
+			sdk.NewAttribute(onfttypes.AttributeKeyPreviewURI, previewURI),
+		),
+	)
+}
+
+func (k Keeper) emitRevealDenomEvent(ctx sdk.Context, denomId, metadataRoot, sender string) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			onfttypes.EventTypeRevealDenom,
+			sdk.NewAttribute(onfttypes.AttributeKeyDenomID, denomId),
+			sdk.NewAttribute(onfttypes.AttributeKeyMerkleRoot, metadataRoot),
+			sdk.NewAttribute(onfttypes.AttributeKeySender, sender),
+		),
+	)
+}
+
+func (k Keeper) emitRevokeONFTEvent(ctx sdk.Context, denomId, onftId, owner, reason string) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			onfttypes.EventTypeRevokeONFT,
+			sdk.NewAttribute(onfttypes.AttributeKeyDenomID, denomId),
+			sdk.NewAttribute(onfttypes.AttributeKeyNFTID, onftId),
+			sdk.NewAttribute(onfttypes.AttributeKeyOwner, owner),
+			sdk.NewAttribute(onfttypes.AttributeKeyReason, reason),
+		),
+	)
+}
+
+func (k Keeper) emitRedeemONFTEvent(ctx sdk.Context, denomId, onftId, owner, redeemer string, uses uint64) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			onfttypes.EventTypeRedeemONFT,
+			sdk.NewAttribute(onfttypes.AttributeKeyDenomID, denomId),
+			sdk.NewAttribute(onfttypes.AttributeKeyNFTID, onftId),
+			sdk.NewAttribute(onfttypes.AttributeKeyOwner, owner),
+			sdk.NewAttribute(onfttypes.AttributeKeyRedeemer, redeemer),
+			sdk.NewAttribute(onfttypes.AttributeKeyUses, fmt.Sprintf("%d", uses)),
+		),
+	)
+}
+
+func (k Keeper) emitRenewONFTEvent(ctx sdk.Context, denomId, onftId, payer, amount, expiresAt string) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			onfttypes.EventTypeRenewONFT,
 			sdk.NewAttribute(onfttypes.AttributeKeyDenomID, denomId),
+			sdk.NewAttribute(onfttypes.AttributeKeyNFTID, onftId),
+			sdk.NewAttribute(onfttypes.AttributeKeySender, payer),
+			sdk.NewAttribute(onfttypes.AttributeKeyAmount, amount),
+			sdk.NewAttribute(onfttypes.AttributeKeyExpiresAt, expiresAt),
+		),
+	)
+}
+
+func (k Keeper) emitExpireONFTEvent(ctx sdk.Context, denomId, onftId, owner string) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			onfttypes.EventTypeExpireONFT,
+			sdk.NewAttribute(onfttypes.AttributeKeyDenomID, denomId),
+			sdk.NewAttribute(onfttypes.AttributeKeyNFTID, onftId),
 			sdk.NewAttribute(onfttypes.AttributeKeyOwner, owner),
 		),
 	)
 }
+
+func (k Keeper) emitModerationEvent(ctx sdk.Context, eventType, denomId, key string, value bool, reason string) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			eventType,
+			sdk.NewAttribute(onfttypes.AttributeKeyDenomID, denomId),
+			sdk.NewAttribute(key, fmt.Sprintf("%t", value)),
+			sdk.NewAttribute(onfttypes.AttributeKeyReason, reason),
+		),
+	)
+}
+
+func (k Keeper) emitPauseEvent(ctx sdk.Context, eventType, denomId, sender, actions, paused string) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			eventType,
+			sdk.NewAttribute(onfttypes.AttributeKeyDenomID, denomId),
+			sdk.NewAttribute(onfttypes.AttributeKeySender, sender),
+			sdk.NewAttribute(onfttypes.AttributeKeyActions, actions),
+			sdk.NewAttribute(onfttypes.AttributeKeyPaused, paused),
+		),
+	)
+}
+
+func (k Keeper) emitClawbackONFTEvent(ctx sdk.Context, denomId, nftId, owner, recipient, sender, burned string) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			onfttypes.EventTypeClawbackONFT,
+			sdk.NewAttribute(onfttypes.AttributeKeyDenomID, denomId),
+			sdk.NewAttribute(onfttypes.AttributeKeyNFTID, nftId),
+			sdk.NewAttribute(onfttypes.AttributeKeyOwner, owner),
+			sdk.NewAttribute(onfttypes.AttributeKeyRecipient, recipient),
+			sdk.NewAttribute(onfttypes.AttributeKeySender, sender),
+			sdk.NewAttribute(onfttypes.AttributeKeyBurned, burned),
+		),
+	)
+}
+
+func (k Keeper) emitSetFeeExemptionEvent(ctx sdk.Context, address, discount, expiresAt string) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			onfttypes.EventTypeSetFeeExemption,
+			sdk.NewAttribute(onfttypes.AttributeKeyAddress, address),
+			sdk.NewAttribute(onfttypes.AttributeKeyDiscount, discount),
+			sdk.NewAttribute(onfttypes.AttributeKeyExpiresAt, expiresAt),
+		),
+	)
+}
@@ -0,0 +1,55 @@
+package keeper_test
+
+import (
+	"bytes"
+
+	"github.com/cosmos/gogoproto/proto"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/OmniFlix/onft/types"
+)
+
+// requireTypedEvent checks the typed event was emitted and resets the event manager
+func (s *KeeperTestSuite) requireTypedEvent(expected proto.Message) {
+	defer func() { s.ctx = s.ctx.WithEventManager(sdk.NewEventManager()) }()
+	want, err := proto.Marshal(expected)
+	s.Require().NoError(err)
+	for _, event := range s.ctx.EventManager().ABCIEvents() {
+		msg, err := sdk.ParseTypedEvent(event)
+		if err != nil {
+			continue
+		}
+		if got, err := proto.Marshal(msg); err == nil && bytes.Equal(want, got) {
+			return
+		}
+	}
+	s.Failf("typed event not emitted", "%s", expected)
+}
+
+func (s *KeeperTestSuite) TestTypedEvents() {
+	s.ctx = s.ctx.WithEventManager(sdk.NewEventManager())
+	s.createDenom(denomID, s.creator)
+	denom, err := s.keeper.GetDenom(s.ctx, denomID)
+	s.Require().NoError(err)
+	s.requireTypedEvent(&types.EventCreateDenom{Denom: denom})
+
+	s.mint(denomID, onftID, s.creator, s.alice)
+	onft, err := s.keeper.GetONFT(s.ctx, denomID, onftID)
+	s.Require().NoError(err)
+	minted := onft.(types.ONFT)
+	s.Require().Equal(sdk.NewDecWithPrec(1, 1), minted.RoyaltyShare)
+	s.requireTypedEvent(&types.EventMintONFT{
+		DenomId: denomID, Onft: minted, Sender: s.creator.String(),
+	})
+
+	s.Require().NoError(s.keeper.TransferOwnership(s.ctx, denomID, onftID, s.alice, s.bob))
+	transferred := minted
+	transferred.Owner = s.bob.String()
+	s.requireTypedEvent(&types.EventTransferONFT{
+		DenomId: denomID, Onft: transferred, Sender: s.alice.String(), Recipient: s.bob.String(),
+	})
+
+	s.Require().NoError(s.keeper.BurnONFT(s.ctx, denomID, onftID, s.bob))
+	s.requireTypedEvent(&types.EventBurnONFT{DenomId: denomID, Onft: transferred})
+}
@@ -0,0 +1,55 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/OmniFlix/onft/types"
+)
+
+// ChargeMintFee collects the mint fee of the params from the payer
+func (k Keeper) ChargeMintFee(ctx sdk.Context, payer sdk.AccAddress) error {
+	return k.distributeFee(ctx, k.GetParams(ctx).GetMintFee(), payer)
+}
+
+// ChargeTransferFee collects the transfer fee of the params from the payer
+func (k Keeper) ChargeTransferFee(ctx sdk.Context, payer sdk.AccAddress) error {
+	return k.distributeFee(ctx, k.GetParams(ctx).GetTransferFee(), payer)
+}
+
+// distributeFee collects a fee from the payer and splits it as set by the fee
+// distribution of the params. Rounding leftovers go to the community pool.
+func (k Keeper) distributeFee(ctx sdk.Context, fee sdk.Coin, payer sdk.AccAddress) error {
+	if !fee.IsPositive() {
+		return nil
+	}
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, payer, types.ModuleName, sdk.NewCoins(fee)); err != nil {
+		return err
+	}
+	distribution := k.GetParams(ctx).GetFeeDistribution()
+	remaining := fee.Amount
+
+	burn := sdk.NewCoin(fee.Denom, sdk.NewDecFromInt(fee.Amount).Mul(distribution.Burn).TruncateInt())
+	if burn.IsPositive() {
+		if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, sdk.NewCoins(burn)); err != nil {
+			return err
+		}
+		remaining = remaining.Sub(burn.Amount)
+	}
+
+	collected := sdk.NewCoin(fee.Denom, sdk.NewDecFromInt(fee.Amount).Mul(distribution.FeeCollector).TruncateInt())
+	if collected.IsPositive() {
+		collector, err := sdk.AccAddressFromBech32(distribution.FeeCollectorAddress)
+		if err != nil {
+			return err
+		}
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, collector, sdk.NewCoins(collected)); err != nil {
+			return err
+		}
+		remaining = remaining.Sub(collected.Amount)
+	}
+
+	if remaining.IsPositive() {
+		return k.distributionKeeper.FundCommunityPool(ctx, sdk.NewCoins(sdk.NewCoin(fee.Denom, remaining)), k.GetModuleAddress())
+	}
+	return nil
+}
@@ -0,0 +1,80 @@
+package keeper
+
+import (
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/OmniFlix/onft/types"
+)
+
+// SetFeeExemption grants an address a discount on the denom creation fee, or
+// removes its exemption when the discount is zero. It is called for the
+// module authority.
+func (k Keeper) SetFeeExemption(ctx sdk.Context, address sdk.AccAddress, discount sdk.Dec, expiresAt *time.Time) error {
+	if err := types.ValidateFeeDiscount(discount); err != nil {
+		return err
+	}
+	exemption := types.NewFeeExemption(address, discount, expiresAt)
+	if !discount.IsPositive() {
+		k.deleteFeeExemption(ctx, address)
+	} else {
+		if !exemption.IsActive(ctx.BlockTime()) {
+			return errorsmod.Wrapf(types.ErrInvalidFeeExemption, "expiry %s must be in the future", expiresAt)
+		}
+		k.SetFeeExemptionEntry(ctx, exemption)
+	}
+
+	expiry := ""
+	if expiresAt != nil {
+		expiry = expiresAt.String()
+	}
+	k.emitSetFeeExemptionEvent(ctx, address.String(), discount.String(), expiry)
+	return nil
+}
+
+// GetFeeExemption returns the exemption of an address, expired or not
+func (k Keeper) GetFeeExemption(ctx sdk.Context, address sdk.AccAddress) (exemption types.FeeExemption, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyFeeExemption(address))
+	if bz == nil {
+		return exemption, false
+	}
+	k.cdc.MustUnmarshal(bz, &exemption)
+	return exemption, true
+}
+
+func (k Keeper) GetFeeExemptions(ctx sdk.Context) (exemptions []types.FeeExemption) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyFeeExemption(nil))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var exemption types.FeeExemption
+		k.cdc.MustUnmarshal(iterator.Value(), &exemption)
+		exemptions = append(exemptions, exemption)
+	}
+	return exemptions
+}
+
+func (k Keeper) SetFeeExemptionEntry(ctx sdk.Context, exemption types.FeeExemption) {
+	store := ctx.KVStore(k.storeKey)
+	address := sdk.MustAccAddressFromBech32(exemption.Address)
+	store.Set(types.KeyFeeExemption(address), k.cdc.MustMarshal(&exemption))
+}
+
+func (k Keeper) deleteFeeExemption(ctx sdk.Context, address sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.KeyFeeExemption(address))
+}
+
+// discountedCreationFee returns the denom creation fee left to pay by the
+// creator after an active exemption
+func (k Keeper) discountedCreationFee(ctx sdk.Context, creator sdk.AccAddress, fee sdk.Coin) sdk.Coin {
+	exemption, found := k.GetFeeExemption(ctx, creator)
+	if !found || !exemption.IsActive(ctx.BlockTime()) {
+		return fee
+	}
+	return exemption.Apply(fee)
+}
@@ -0,0 +1,71 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/OmniFlix/onft/types"
+)
+
+func (s *KeeperTestSuite) setFeeExemption(address sdk.AccAddress, discount sdk.Dec, expiresAt *time.Time) error {
+	_, err := s.msgServer.SetFeeExemption(s.ctx, &types.MsgSetFeeExemption{
+		Authority: s.keeper.GetAuthority(),
+		Address:   address.String(),
+		Discount:  discount,
+		ExpiresAt: expiresAt,
+	})
+	return err
+}
+
+func (s *KeeperTestSuite) TestCreateDenomWithFeeExemption() {
+	s.acceptCreationFees(sdk.NewInt64Coin(feeDenom, 100))
+	expiresAt := genesisTime.Add(time.Hour)
+	s.Require().NoError(s.setFeeExemption(s.alice, sdk.NewDecWithPrec(75, 2), &expiresAt))
+	s.Require().NoError(s.setFeeExemption(s.bob, sdk.OneDec(), nil))
+	s.fund(s.alice, sdk.NewInt64Coin(feeDenom, 100))
+	s.fund(s.bob, sdk.NewInt64Coin(feeDenom, 100))
+
+	s.Require().NoError(s.createDenomWithFee(denomID, s.alice, sdk.NewInt64Coin(feeDenom, 100)))
+	s.Require().Equal(int64(75), s.balance(s.alice, feeDenom))
+	s.Require().NoError(s.createDenomWithFee(denomID2, s.bob, sdk.NewInt64Coin(feeDenom, 100)))
+	s.Require().Equal(int64(100), s.balance(s.bob, feeDenom))
+
+	// the full fee is charged once the exemption expired
+	s.nextBlock(time.Hour)
+	s.Require().ErrorIs(s.createDenomWithFee("onftdenomtest3", s.alice, sdk.NewInt64Coin(feeDenom, 100)), sdkerrors.ErrInsufficientFunds)
+	s.fund(s.alice, sdk.NewInt64Coin(feeDenom, 25))
+	s.Require().NoError(s.createDenomWithFee("onftdenomtest3", s.alice, sdk.NewInt64Coin(feeDenom, 100)))
+	s.Require().True(s.keeper.HasDenomID(s.ctx, "onftdenomtest3"))
+	s.Require().Equal(int64(0), s.balance(s.alice, feeDenom))
+}
+
+func (s *KeeperTestSuite) TestSetFeeExemption() {
+	_, err := s.msgServer.SetFeeExemption(s.ctx, &types.MsgSetFeeExemption{
+		Authority: s.alice.String(),
+		Address:   s.alice.String(),
+		Discount:  sdk.OneDec(),
+	})
+	s.Require().ErrorIs(err, govtypes.ErrInvalidSigner)
+
+	past := genesisTime.Add(-time.Hour)
+	s.Require().ErrorIs(s.setFeeExemption(s.alice, sdk.OneDec(), &past), types.ErrInvalidFeeExemption)
+	s.Require().ErrorIs(s.setFeeExemption(s.alice, sdk.NewDecWithPrec(11, 1), nil), types.ErrInvalidFeeExemption)
+	s.Require().ErrorIs(s.setFeeExemption(s.alice, sdk.NewDecWithPrec(-1, 1), nil), types.ErrInvalidFeeExemption)
+
+	s.Require().NoError(s.setFeeExemption(s.alice, sdk.NewDecWithPrec(5, 1), nil))
+	s.Require().NoError(s.setFeeExemption(s.bob, sdk.OneDec(), nil))
+	res, err := s.keeper.FeeExemptions(s.ctx, &types.QueryFeeExemptionsRequest{})
+	s.Require().NoError(err)
+	s.Require().Len(res.FeeExemptions, 2)
+
+	// a zero discount removes the exemption
+	s.Require().NoError(s.setFeeExemption(s.alice, sdk.ZeroDec(), nil))
+	_, found := s.keeper.GetFeeExemption(s.ctx, s.alice)
+	s.Require().False(found)
+	res, err = s.keeper.FeeExemptions(s.ctx, &types.QueryFeeExemptionsRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal([]types.FeeExemption{types.NewFeeExemption(s.bob, sdk.OneDec(), nil)}, res.FeeExemptions)
+}
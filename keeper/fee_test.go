@@ -0,0 +1,112 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/OmniFlix/onft/types"
+)
+
+// setFees sets the mint and transfer fee and splits fees between burn, the
+// collector and the community pool
+func (s *KeeperTestSuite) setFees(mintFee, transferFee int64, distribution types.FeeDistribution) {
+	params := s.keeper.GetParams(s.ctx)
+	params.MintFee = sdk.NewInt64Coin(feeDenom, mintFee)
+	params.TransferFee = sdk.NewInt64Coin(feeDenom, transferFee)
+	params.FeeDistribution = distribution
+	s.Require().NoError(s.keeper.SetParams(s.ctx, params))
+}
+
+func (s *KeeperTestSuite) communityPool() int64 {
+	return s.app.DistrKeeper.GetFeePoolCommunityCoins(s.ctx).AmountOf(feeDenom).TruncateInt64()
+}
+
+func (s *KeeperTestSuite) TestMintAndTransferFees() {
+	s.setFees(7, 3, types.DefaultFeeDistribution)
+	s.createDenom(denomID, s.creator)
+	s.fund(s.creator, sdk.NewInt64Coin(feeDenom, 1000))
+	s.fund(s.alice, sdk.NewInt64Coin(feeDenom, 1000))
+	s.fund(s.bob, sdk.NewInt64Coin(feeDenom, 1000))
+
+	pool := s.communityPool()
+	s.mint(denomID, onftID, s.creator, s.alice)
+	s.Require().Equal(int64(993), s.balance(s.creator, feeDenom))
+
+	_, err := s.msgServer.TransferONFT(s.ctx, types.NewMsgTransferONFT(onftID, denomID, s.alice.String(), s.bob.String()))
+	s.Require().NoError(err)
+	s.Require().Equal(int64(997), s.balance(s.alice, feeDenom))
+	s.Require().Equal(pool+10, s.communityPool())
+
+	// escrow in and out of the module account is not charged
+	listingID, err := s.keeper.ListONFT(s.ctx, denomID, onftID, sdk.NewInt64Coin(feeDenom, 100), time.Time{}, s.bob)
+	s.Require().NoError(err)
+	s.Require().Equal(int64(1000), s.balance(s.bob, feeDenom))
+	s.Require().NoError(s.keeper.BuyONFT(s.ctx, listingID, s.alice))
+	s.Require().Equal(s.alice, s.owner(denomID, onftID))
+	s.Require().Equal(int64(897), s.balance(s.alice, feeDenom))
+	s.Require().Equal(int64(0), s.moduleBalance(feeDenom))
+
+	// a sender without funds for the fee can not transfer
+	poor := testAddr("poor")
+	_, err = s.msgServer.TransferONFT(s.ctx, types.NewMsgTransferONFT(onftID, denomID, s.alice.String(), poor.String()))
+	s.Require().NoError(err)
+	_, err = s.msgServer.TransferONFT(s.ctx, types.NewMsgTransferONFT(onftID, denomID, poor.String(), s.alice.String()))
+	s.Require().ErrorIs(err, sdkerrors.ErrInsufficientFunds)
+	s.Require().Equal(poor, s.owner(denomID, onftID))
+}
+
+func (s *KeeperTestSuite) TestFeeDistribution() {
+	collector := testAddr("collector")
+	s.setFees(0, 100, types.NewFeeDistribution(
+		sdk.NewDecWithPrec(25, 2), sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(25, 2), collector.String(),
+	))
+	s.createDenom(denomID, s.creator)
+	s.mint(denomID, onftID, s.creator, s.alice)
+	s.fund(s.alice, sdk.NewInt64Coin(feeDenom, 100))
+
+	supply := s.app.BankKeeper.GetSupply(s.ctx, feeDenom).Amount.Int64()
+	collected := s.balance(collector, feeDenom)
+	pool := s.communityPool()
+	s.Require().NoError(s.keeper.TransferOwnership(s.ctx, denomID, onftID, s.alice, s.bob))
+	s.Require().Equal(int64(0), s.balance(s.alice, feeDenom))
+	s.Require().Equal(supply-50, s.app.BankKeeper.GetSupply(s.ctx, feeDenom).Amount.Int64())
+	s.Require().Equal(collected+25, s.balance(collector, feeDenom))
+	s.Require().Equal(pool+25, s.communityPool())
+	s.Require().Equal(int64(0), s.moduleBalance(feeDenom))
+}
+
+func (s *KeeperTestSuite) TestUpdateParamsRejectsBlockedFeeReceivers() {
+	authority := s.keeper.GetAuthority()
+	blocked := []sdk.AccAddress{
+		authtypes.NewModuleAddress(authtypes.FeeCollectorName),
+		s.keeper.GetModuleAddress(),
+	}
+
+	params := s.keeper.GetParams(s.ctx)
+	_, err := s.msgServer.UpdateParams(s.ctx, &types.MsgUpdateParams{Authority: s.alice.String(), Params: params})
+	s.Require().ErrorIs(err, govtypes.ErrInvalidSigner)
+
+	for _, addr := range blocked {
+		params := s.keeper.GetParams(s.ctx)
+		params.FeeDistribution = types.NewFeeDistribution(sdk.ZeroDec(), sdk.ZeroDec(), sdk.OneDec(), addr.String())
+		_, err := s.msgServer.UpdateParams(s.ctx, &types.MsgUpdateParams{Authority: authority, Params: params})
+		s.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+		params = s.keeper.GetParams(s.ctx)
+		params.PlatformFeePercentage = sdk.NewDecWithPrec(5, 2)
+		params.PlatformFeeReceiver = addr.String()
+		_, err = s.msgServer.UpdateParams(s.ctx, &types.MsgUpdateParams{Authority: authority, Params: params})
+		s.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+	}
+	s.Require().Equal(types.DefaultFeeDistribution, s.keeper.GetParams(s.ctx).GetFeeDistribution())
+
+	params = s.keeper.GetParams(s.ctx)
+	params.FeeDistribution = types.NewFeeDistribution(sdk.ZeroDec(), sdk.ZeroDec(), sdk.OneDec(), s.bob.String())
+	_, err = s.msgServer.UpdateParams(s.ctx, &types.MsgUpdateParams{Authority: authority, Params: params})
+	s.Require().NoError(err)
+	s.Require().Equal(s.bob.String(), s.keeper.GetParams(s.ctx).GetFeeDistribution().FeeCollectorAddress)
+}
@@ -0,0 +1,191 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/OmniFlix/onft/types"
+)
+
+// Fractionalize escrows the oNFT of the owner in the module account and mints
+// shares coins of the derived shares denom to the owner.
+func (k Keeper) Fractionalize(
+	ctx sdk.Context,
+	denomID, onftID string,
+	shares sdk.Int, buyoutThreshold sdk.Dec, buyoutPrice sdk.Coin,
+	owner sdk.AccAddress,
+) (string, error) {
+	if existing, err := k.GetFractionalization(ctx, denomID, onftID); err == nil {
+		return "", errorsmod.Wrapf(types.ErrInvalidFractionalization,
+			"%s/%s has %s%s outstanding, outstanding shares must be redeemed first",
+			denomID, onftID, existing.OutstandingShares, existing.SharesDenom)
+	}
+	if err := k.TransferOwnership(ctx, denomID, onftID, owner, k.GetModuleAddress()); err != nil {
+		return "", err
+	}
+
+	sharesDenom := types.SharesDenom(denomID, onftID)
+	sharesCoins := sdk.NewCoins(sdk.NewCoin(sharesDenom, shares))
+	if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, sharesCoins); err != nil {
+		return "", err
+	}
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, owner, sharesCoins); err != nil {
+		return "", err
+	}
+
+	k.SetFractionalization(ctx, types.Fractionalization{
+		DenomId:           denomID,
+		OnftId:            onftID,
+		Owner:             owner.String(),
+		SharesDenom:       sharesDenom,
+		TotalShares:       shares,
+		BuyoutThreshold:   buyoutThreshold,
+		BuyoutPrice:       buyoutPrice,
+		OutstandingShares: sdk.ZeroInt(),
+		BuyoutProceeds:    sdk.NewCoin(buyoutPrice.Denom, sdk.ZeroInt()),
+	})
+	k.emitFractionalizeEvent(ctx, denomID, onftID, owner.String(), sharesCoins.String())
+	return sharesDenom, nil
+}
+
+// Redeem burns all shares of a fractionalized oNFT held by the sender and
+// returns the oNFT to the sender. Once the oNFT was bought out, the shares
+// held by the sender are burned for their part of the buyout proceeds.
+func (k Keeper) Redeem(ctx sdk.Context, denomID, onftID string, sender sdk.AccAddress) error {
+	fractionalization, err := k.GetFractionalization(ctx, denomID, onftID)
+	if err != nil {
+		return err
+	}
+	held := k.bankKeeper.GetBalance(ctx, sender, fractionalization.SharesDenom)
+
+	if !fractionalization.IsBoughtOut() {
+		if held.Amount.LT(fractionalization.TotalShares) {
+			return errorsmod.Wrapf(types.ErrInsufficientShares,
+				"redeem requires %s%s, holding %s", fractionalization.TotalShares, fractionalization.SharesDenom, held)
+		}
+		shares := sdk.NewCoin(fractionalization.SharesDenom, fractionalization.TotalShares)
+		if err := k.burnShares(ctx, sender, shares); err != nil {
+			return err
+		}
+		if err := k.TransferOwnership(ctx, denomID, onftID, k.GetModuleAddress(), sender); err != nil {
+			return err
+		}
+		k.deleteFractionalization(ctx, denomID, onftID)
+		k.emitRedeemEvent(ctx, denomID, onftID, sender.String(), shares.String(), "")
+		return nil
+	}
+
+	if !held.IsPositive() {
+		return errorsmod.Wrapf(types.ErrInsufficientShares, "%s holds no %s", sender, fractionalization.SharesDenom)
+	}
+	proceeds := fractionalization.ProceedsFor(held.Amount)
+	if err := k.burnShares(ctx, sender, held); err != nil {
+		return err
+	}
+	if proceeds.IsPositive() {
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, sender, sdk.NewCoins(proceeds)); err != nil {
+			return err
+		}
+	}
+
+	fractionalization.OutstandingShares = fractionalization.OutstandingShares.Sub(held.Amount)
+	fractionalization.BuyoutProceeds = fractionalization.BuyoutProceeds.Sub(proceeds)
+	if fractionalization.OutstandingShares.IsPositive() {
+		k.SetFractionalization(ctx, fractionalization)
+	} else {
+		k.deleteFractionalization(ctx, denomID, onftID)
+	}
+	k.emitRedeemEvent(ctx, denomID, onftID, sender.String(), held.String(), proceeds.String())
+	return nil
+}
+
+// Buyout lets a holder of at least the buyout threshold of the shares pay the
+// buyout price for the shares held by others and take the oNFT. The payment
+// stays in the module account until the other holders redeem their shares.
+func (k Keeper) Buyout(ctx sdk.Context, denomID, onftID string, buyer sdk.AccAddress) error {
+	fractionalization, err := k.GetFractionalization(ctx, denomID, onftID)
+	if err != nil {
+		return err
+	}
+	if fractionalization.IsBoughtOut() {
+		return errorsmod.Wrapf(types.ErrInvalidFractionalization,
+			"%s/%s was already bought out by %s", denomID, onftID, fractionalization.Buyer)
+	}
+	held := k.bankKeeper.GetBalance(ctx, buyer, fractionalization.SharesDenom)
+	if !held.IsPositive() || !fractionalization.CanBuyout(held.Amount) {
+		return errorsmod.Wrapf(types.ErrInsufficientShares,
+			"buyout requires %s of %s%s, holding %s",
+			fractionalization.BuyoutThreshold, fractionalization.TotalShares, fractionalization.SharesDenom, held)
+	}
+
+	cost := fractionalization.BuyoutCost(held.Amount)
+	if cost.IsPositive() {
+		if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, buyer, types.ModuleName, sdk.NewCoins(cost)); err != nil {
+			return err
+		}
+	}
+	if err := k.burnShares(ctx, buyer, held); err != nil {
+		return err
+	}
+	if err := k.TransferOwnership(ctx, denomID, onftID, k.GetModuleAddress(), buyer); err != nil {
+		return err
+	}
+
+	outstanding := fractionalization.TotalShares.Sub(held.Amount)
+	if outstanding.IsPositive() {
+		fractionalization.Buyer = buyer.String()
+		fractionalization.OutstandingShares = outstanding
+		fractionalization.BuyoutProceeds = cost
+		k.SetFractionalization(ctx, fractionalization)
+	} else {
+		k.deleteFractionalization(ctx, denomID, onftID)
+	}
+	k.emitBuyoutEvent(ctx, denomID, onftID, buyer.String(), held.String(), cost.String())
+	return nil
+}
+
+func (k Keeper) burnShares(ctx sdk.Context, holder sdk.AccAddress, shares sdk.Coin) error {
+	coins := sdk.NewCoins(shares)
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, holder, types.ModuleName, coins); err != nil {
+		return err
+	}
+	return k.bankKeeper.BurnCoins(ctx, types.ModuleName, coins)
+}
+
+func (k Keeper) GetFractionalization(
+	ctx sdk.Context,
+	denomID, onftID string,
+) (fractionalization types.Fractionalization, err error) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyFractionalization(denomID, onftID))
+	if bz == nil {
+		return fractionalization, errorsmod.Wrapf(types.ErrUnknownFractionalization,
+			"onft %s/%s is not fractionalized", denomID, onftID)
+	}
+	k.cdc.MustUnmarshal(bz, &fractionalization)
+	return fractionalization, nil
+}
+
+func (k Keeper) GetFractionalizations(ctx sdk.Context) (fractionalizations []types.Fractionalization) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyFractionalization("", ""))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var fractionalization types.Fractionalization
+		k.cdc.MustUnmarshal(iterator.Value(), &fractionalization)
+		fractionalizations = append(fractionalizations, fractionalization)
+	}
+	return fractionalizations
+}
+
+func (k Keeper) SetFractionalization(ctx sdk.Context, fractionalization types.Fractionalization) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&fractionalization)
+	store.Set(types.KeyFractionalization(fractionalization.DenomId, fractionalization.OnftId), bz)
+}
+
+func (k Keeper) deleteFractionalization(ctx sdk.Context, denomID, onftID string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.KeyFractionalization(denomID, onftID))
+}
@@ -0,0 +1,82 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/OmniFlix/onft/types"
+)
+
+func (s *KeeperTestSuite) fractionalize(owner sdk.AccAddress) string {
+	sharesDenom, err := s.keeper.Fractionalize(
+		s.ctx, denomID, onftID,
+		sdk.NewInt(100), sdk.NewDecWithPrec(6, 1), sdk.NewInt64Coin(feeDenom, 1000),
+		owner,
+	)
+	s.Require().NoError(err)
+	s.Require().Equal(types.SharesDenom(denomID, onftID), sharesDenom)
+	s.Require().Equal(s.keeper.GetModuleAddress(), s.owner(denomID, onftID))
+	return sharesDenom
+}
+
+func (s *KeeperTestSuite) TestFractionalizeAndRedeem() {
+	s.createDenom(denomID, s.creator)
+	s.mint(denomID, onftID, s.creator, s.alice)
+	sharesDenom := s.fractionalize(s.alice)
+	s.Require().Equal(int64(100), s.balance(s.alice, sharesDenom))
+
+	// redeeming requires all shares
+	s.Require().NoError(s.app.BankKeeper.SendCoins(s.ctx, s.alice, s.bob, sdk.NewCoins(sdk.NewInt64Coin(sharesDenom, 1))))
+	s.Require().ErrorIs(s.keeper.Redeem(s.ctx, denomID, onftID, s.alice), types.ErrInsufficientShares)
+	s.Require().NoError(s.app.BankKeeper.SendCoins(s.ctx, s.bob, s.alice, sdk.NewCoins(sdk.NewInt64Coin(sharesDenom, 1))))
+
+	s.Require().NoError(s.keeper.Redeem(s.ctx, denomID, onftID, s.alice))
+	s.Require().Equal(s.alice, s.owner(denomID, onftID))
+	s.Require().Equal(int64(0), s.balance(s.alice, sharesDenom))
+	s.Require().True(s.app.BankKeeper.GetSupply(s.ctx, sharesDenom).IsZero())
+	_, err := s.keeper.GetFractionalization(s.ctx, denomID, onftID)
+	s.Require().ErrorIs(err, types.ErrUnknownFractionalization)
+}
+
+func (s *KeeperTestSuite) TestBuyout() {
+	s.createDenom(denomID, s.creator)
+	s.mint(denomID, onftID, s.creator, s.alice)
+	sharesDenom := s.fractionalize(s.alice)
+	s.Require().NoError(s.app.BankKeeper.SendCoins(s.ctx, s.alice, s.bob, sdk.NewCoins(sdk.NewInt64Coin(sharesDenom, 70))))
+	s.fund(s.bob, sdk.NewInt64Coin(feeDenom, 1000))
+
+	s.Require().ErrorIs(s.keeper.Buyout(s.ctx, denomID, onftID, s.alice), types.ErrInsufficientShares)
+	s.Require().NoError(s.keeper.Buyout(s.ctx, denomID, onftID, s.bob))
+	s.Require().Equal(s.bob, s.owner(denomID, onftID))
+	s.Require().Equal(int64(700), s.balance(s.bob, feeDenom))
+	s.Require().Equal(int64(300), s.moduleBalance(feeDenom))
+	s.Require().ErrorIs(s.keeper.Buyout(s.ctx, denomID, onftID, s.bob), types.ErrInvalidFractionalization)
+
+	s.Require().NoError(s.keeper.Redeem(s.ctx, denomID, onftID, s.alice))
+	s.Require().Equal(int64(300), s.balance(s.alice, feeDenom))
+	s.Require().Equal(int64(0), s.moduleBalance(feeDenom))
+	s.Require().True(s.app.BankKeeper.GetSupply(s.ctx, sharesDenom).IsZero())
+	_, err := s.keeper.GetFractionalization(s.ctx, denomID, onftID)
+	s.Require().ErrorIs(err, types.ErrUnknownFractionalization)
+}
+
+func (s *KeeperTestSuite) TestFractionalizeWithOutstandingShares() {
+	s.createDenom(denomID, s.creator)
+	s.mint(denomID, onftID, s.creator, s.alice)
+	sharesDenom := s.fractionalize(s.alice)
+	s.Require().NoError(s.app.BankKeeper.SendCoins(s.ctx, s.alice, s.bob, sdk.NewCoins(sdk.NewInt64Coin(sharesDenom, 70))))
+	s.fund(s.bob, sdk.NewInt64Coin(feeDenom, 1000))
+	s.Require().NoError(s.keeper.Buyout(s.ctx, denomID, onftID, s.bob))
+
+	// the buyer can not fractionalize again while alice holds outstanding shares
+	_, err := s.keeper.Fractionalize(
+		s.ctx, denomID, onftID,
+		sdk.NewInt(10), sdk.OneDec(), sdk.NewInt64Coin(feeDenom, 10),
+		s.bob,
+	)
+	s.Require().ErrorIs(err, types.ErrInvalidFractionalization)
+	s.Require().Equal(s.bob, s.owner(denomID, onftID))
+	s.Require().Equal(int64(30), s.balance(s.alice, sharesDenom))
+
+	s.Require().NoError(s.keeper.Redeem(s.ctx, denomID, onftID, s.alice))
+	s.fractionalize(s.bob)
+}
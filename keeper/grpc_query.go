@@ -2,7 +2,9 @@ package keeper
 
 import (
 	"context"
+	"encoding/hex"
 	"strings"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	"github.com/cosmos/cosmos-sdk/types/query"
@@ -45,8 +47,9 @@ func (k Keeper) Collection(c context.Context, request *types.QueryCollectionRequ
 		return nil, err
 	}
 	return &types.QueryCollectionResponse{
-		Collection: &collection,
-		Pagination: pagination,
+		Collection:       &collection,
+		Pagination:       pagination,
+		ModerationStatus: k.moderationStatusPtr(ctx, collection.Denom.Id),
 	}, nil
 }
 
@@ -60,7 +63,8 @@ func (k Keeper) Denom(c context.Context, request *types.QueryDenomRequest) (*typ
 	}
 
 	return &types.QueryDenomResponse{
-		Denom: &denomObject,
+		Denom:            &denomObject,
+		ModerationStatus: k.moderationStatusPtr(ctx, denom),
 	}, nil
 }
 
@@ -79,11 +83,16 @@ func (k Keeper) Denoms(c context.Context, request *types.QueryDenomsRequest) (*t
 			return nil, err
 		}
 		denomStore := prefix.NewStore(store, types.KeyDenomCreator(owner, ""))
-		pagination, err = query.Paginate(denomStore, request.Pagination, func(key []byte, value []byte) error {
+		pagination, err = query.FilteredPaginate(denomStore, request.Pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
 			denomId := types.MustUnMarshalDenomID(k.cdc, value)
-			denom, _ := k.GetDenom(ctx, denomId)
-			denoms = append(denoms, denom)
-			return nil
+			if k.IsDenomHidden(ctx, denomId) {
+				return false, nil
+			}
+			if accumulate {
+				denom, _ := k.GetDenom(ctx, denomId)
+				denoms = append(denoms, denom)
+			}
+			return true, nil
 		})
 		if err != nil {
 			return nil, status.Errorf(codes.InvalidArgument, "paginate: %v", err)
@@ -91,11 +100,16 @@ func (k Keeper) Denoms(c context.Context, request *types.QueryDenomsRequest) (*t
 
 	} else {
 		denomStore := prefix.NewStore(store, types.KeyDenomID(""))
-		pagination, err = query.Paginate(denomStore, request.Pagination, func(key []byte, value []byte) error {
+		pagination, err = query.FilteredPaginate(denomStore, request.Pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
 			var denom types.Denom
 			k.cdc.MustUnmarshal(value, &denom)
-			denoms = append(denoms, denom)
-			return nil
+			if k.IsDenomHidden(ctx, denom.Id) {
+				return false, nil
+			}
+			if accumulate {
+				denoms = append(denoms, denom)
+			}
+			return true, nil
 		})
 		if err != nil {
 			return nil, status.Errorf(codes.InvalidArgument, "paginate: %v", err)
@@ -122,8 +136,18 @@ func (k Keeper) ONFT(c context.Context, request *types.QueryONFTRequest) (*types
 		return nil, errorsmod.Wrapf(types.ErrUnknownONFT, "invalid type ONFT %s from collection %s", request.Id, request.DenomId)
 	}
 
+	var parent *types.ONFTRef
+	if ref, ok := k.GetNestingParent(ctx, denom, onftID); ok {
+		parent = &ref
+	}
+
 	return &types.QueryONFTResponse{
-		ONFT: &oNFT,
+		ONFT:             &oNFT,
+		RootOwner:        oNFT.Owner,
+		Parent:           parent,
+		Children:         k.GetNestedChildren(ctx, denom, onftID),
+		Unrevealed:       k.IsUnrevealed(ctx, denom, onftID),
+		ModerationStatus: k.moderationStatusPtr(ctx, denom),
 	}, nil
 }
 
@@ -140,6 +164,7 @@ func (k Keeper) OwnerONFTs(c context.Context, request *types.QueryOwnerONFTsRequ
 		IDCollections: types.IDCollections{},
 	}
 	var ownerCollections []types.OwnerONFTCollection
+	var nestings []types.Nesting
 	idsMap := make(map[string][]string)
 	store := ctx.KVStore(k.storeKey)
 	onftStore := prefix.NewStore(store, types.KeyOwner(address, request.DenomId, ""))
@@ -167,6 +192,9 @@ func (k Keeper) OwnerONFTs(c context.Context, request *types.QueryOwnerONFTsRequ
 		for _, onftid := range owner.IDCollections[i].OnftIds {
 			onft, _ := k.GetONFT(ctx, denom.Id, onftid)
 			onfts = append(onfts, onft.(types.ONFT))
+			if parent, ok := k.GetNestingParent(ctx, denom.Id, onftid); ok {
+				nestings = append(nestings, types.NewNesting(types.NewONFTRef(denom.Id, onftid), parent))
+			}
 		}
 		ownerCollection := types.OwnerONFTCollection{
 			Denom: denom,
@@ -178,6 +206,802 @@ func (k Keeper) OwnerONFTs(c context.Context, request *types.QueryOwnerONFTsRequ
 		Owner:       address.String(),
 		Collections: ownerCollections,
 		Pagination:  pagination,
+		Nestings:    nestings,
+	}, nil
+}
+
+func (k Keeper) Editions(c context.Context, request *types.QueryEditionsRequest) (*types.QueryEditionsResponse, error) {
+	denom := strings.ToLower(strings.TrimSpace(request.DenomId))
+	masterID := strings.ToLower(strings.TrimSpace(request.MasterId))
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var onfts []types.ONFT
+	store := ctx.KVStore(k.storeKey)
+	editionStore := prefix.NewStore(store, types.KeyEdition(denom, masterID, 0))
+	pagination, err := query.Paginate(editionStore, request.Pagination, func(key []byte, value []byte) error {
+		onftID := types.MustUnMarshalONFTID(k.cdc, value)
+		onft, err := k.GetONFT(ctx, denom, onftID)
+		if err != nil {
+			return err
+		}
+		onfts = append(onfts, onft.(types.ONFT))
+		return nil
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "paginate: %v", err)
+	}
+
+	return &types.QueryEditionsResponse{
+		Onfts:      onfts,
+		Pagination: pagination,
+	}, nil
+}
+
+func (k Keeper) EditionSupply(c context.Context, request *types.QueryEditionSupplyRequest) (*types.QueryEditionSupplyResponse, error) {
+	denom := strings.ToLower(strings.TrimSpace(request.DenomId))
+	masterID := strings.ToLower(strings.TrimSpace(request.MasterId))
+	ctx := sdk.UnwrapSDKContext(c)
+
+	nft, err := k.GetONFT(ctx, denom, masterID)
+	if err != nil {
+		return nil, errorsmod.Wrapf(types.ErrUnknownONFT, "invalid ONFT %s from collection %s", request.MasterId, request.DenomId)
+	}
+	master := nft.(types.ONFT)
+	if !master.IsMasterEdition() {
+		return nil, errorsmod.Wrapf(types.ErrNotMasterEdition, "onft %s of denom %s", request.MasterId, request.DenomId)
+	}
+
+	printed := k.GetEditionsPrinted(ctx, denom, masterID)
+	var remaining uint64
+	if printed < master.MaxEditions {
+		remaining = master.MaxEditions - printed
+	}
+	return &types.QueryEditionSupplyResponse{
+		MaxEditions: master.MaxEditions,
+		Printed:     printed,
+		Remaining:   remaining,
+	}, nil
+}
+
+func (k Keeper) RedemptionStatus(
+	c context.Context,
+	request *types.QueryRedemptionStatusRequest,
+) (*types.QueryRedemptionStatusResponse, error) {
+	denom := strings.ToLower(strings.TrimSpace(request.DenomId))
+	onftID := strings.ToLower(strings.TrimSpace(request.OnftId))
+	ctx := sdk.UnwrapSDKContext(c)
+
+	nft, err := k.GetONFT(ctx, denom, onftID)
+	if err != nil {
+		return nil, errorsmod.Wrapf(types.ErrUnknownONFT, "invalid ONFT %s from collection %s", request.OnftId, request.DenomId)
+	}
+	onft := nft.(types.ONFT)
+	redeemer, err := k.GetDenomRedeemer(ctx, denom)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryRedemptionStatusResponse{
+		MaxUses:   onft.MaxUses,
+		Uses:      onft.Uses,
+		Remaining: onft.RemainingUses(),
+		Redeemer:  redeemer,
+	}, nil
+}
+
+func (k Keeper) Claim(c context.Context, request *types.QueryClaimRequest) (*types.QueryClaimResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	claim, err := k.GetClaim(ctx, request.Id)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryClaimResponse{Claim: &claim}, nil
+}
+
+func (k Keeper) Claims(c context.Context, request *types.QueryClaimsRequest) (*types.QueryClaimsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	if request.Creator != "" {
+		if _, err := sdk.AccAddressFromBech32(request.Creator); err != nil {
+			return nil, err
+		}
+	}
+
+	var claims []types.Claim
+	store := ctx.KVStore(k.storeKey)
+	claimStore := prefix.NewStore(store, types.KeyClaim(0))
+	pagination, err := query.FilteredPaginate(claimStore, request.Pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
+		var claim types.Claim
+		k.cdc.MustUnmarshal(value, &claim)
+		if request.Creator != "" && claim.Creator != request.Creator {
+			return false, nil
+		}
+		if accumulate {
+			claims = append(claims, claim)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "paginate: %v", err)
+	}
+
+	return &types.QueryClaimsResponse{
+		Claims:     claims,
+		Pagination: pagination,
+	}, nil
+}
+
+func (k Keeper) Airdrop(c context.Context, request *types.QueryAirdropRequest) (*types.QueryAirdropResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	airdrop, err := k.GetMintAirdrop(ctx, request.Id)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryAirdropResponse{Airdrop: &airdrop}, nil
+}
+
+func (k Keeper) Airdrops(c context.Context, request *types.QueryAirdropsRequest) (*types.QueryAirdropsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	if request.Creator != "" {
+		if _, err := sdk.AccAddressFromBech32(request.Creator); err != nil {
+			return nil, err
+		}
+	}
+
+	var airdrops []types.MintAirdrop
+	store := ctx.KVStore(k.storeKey)
+	airdropStore := prefix.NewStore(store, types.KeyAirdrop(0))
+	pagination, err := query.FilteredPaginate(airdropStore, request.Pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
+		var airdrop types.MintAirdrop
+		k.cdc.MustUnmarshal(value, &airdrop)
+		if request.Creator != "" && airdrop.Creator != request.Creator {
+			return false, nil
+		}
+		if accumulate {
+			airdrops = append(airdrops, airdrop)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "paginate: %v", err)
+	}
+
+	return &types.QueryAirdropsResponse{
+		Airdrops:   airdrops,
+		Pagination: pagination,
+	}, nil
+}
+
+func (k Keeper) AirdropClaimed(c context.Context, request *types.QueryAirdropClaimedRequest) (*types.QueryAirdropClaimedResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	leaf, err := hex.DecodeString(request.LeafHash)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid leaf hash: %v", err)
+	}
+	if _, err := k.GetMintAirdrop(ctx, request.Id); err != nil {
+		return nil, err
+	}
+	return &types.QueryAirdropClaimedResponse{Claimed: k.IsAirdropLeafClaimed(ctx, request.Id, leaf)}, nil
+}
+
+func (k Keeper) Swap(c context.Context, request *types.QuerySwapRequest) (*types.QuerySwapResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	swap, err := k.GetSwap(ctx, request.Id)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QuerySwapResponse{Swap: &swap}, nil
+}
+
+func (k Keeper) Swaps(c context.Context, request *types.QuerySwapsRequest) (*types.QuerySwapsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	for _, addr := range []string{request.Creator, request.Counterparty} {
+		if addr != "" {
+			if _, err := sdk.AccAddressFromBech32(addr); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	var swaps []types.Swap
+	store := ctx.KVStore(k.storeKey)
+	swapStore := prefix.NewStore(store, types.KeySwap(0))
+	pagination, err := query.FilteredPaginate(swapStore, request.Pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
+		var swap types.Swap
+		k.cdc.MustUnmarshal(value, &swap)
+		if request.Creator != "" && swap.Creator != request.Creator {
+			return false, nil
+		}
+		if request.Counterparty != "" && swap.Counterparty != request.Counterparty {
+			return false, nil
+		}
+		if accumulate {
+			swaps = append(swaps, swap)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "paginate: %v", err)
+	}
+
+	return &types.QuerySwapsResponse{
+		Swaps:      swaps,
+		Pagination: pagination,
+	}, nil
+}
+
+func (k Keeper) Listing(c context.Context, request *types.QueryListingRequest) (*types.QueryListingResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	listing, err := k.GetListing(ctx, request.Id)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryListingResponse{Listing: &listing}, nil
+}
+
+// Listings iterates the most selective listing index for the given filters,
+// listings filtered by price denom are returned in ascending price order.
+func (k Keeper) Listings(c context.Context, request *types.QueryListingsRequest) (*types.QueryListingsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	var seller sdk.AccAddress
+	if request.Seller != "" {
+		var err error
+		seller, err = sdk.AccAddressFromBech32(request.Seller)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	var listingStore prefix.Store
+	switch {
+	case request.PriceDenom != "":
+		listingStore = prefix.NewStore(store, types.KeyListingByPrice(sdk.Coin{Denom: request.PriceDenom}, 0))
+	case request.DenomId != "":
+		listingStore = prefix.NewStore(store, types.KeyListingByDenom(request.DenomId, 0))
+	case seller != nil:
+		listingStore = prefix.NewStore(store, types.KeyListingBySeller(seller, 0))
+	default:
+		listingStore = prefix.NewStore(store, types.KeyListing(0))
+	}
+	indexed := request.PriceDenom != "" || request.DenomId != "" || seller != nil
+
+	var listings []types.Listing
+	pagination, err := query.FilteredPaginate(listingStore, request.Pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
+		var listing types.Listing
+		if indexed {
+			bz := store.Get(types.KeyListing(sdk.BigEndianToUint64(value)))
+			if bz == nil {
+				return false, nil
+			}
+			value = bz
+		}
+		k.cdc.MustUnmarshal(value, &listing)
+		if request.DenomId != "" && listing.DenomId != request.DenomId {
+			return false, nil
+		}
+		if request.Seller != "" && listing.Seller != request.Seller {
+			return false, nil
+		}
+		if request.PriceDenom != "" && listing.Price.Denom != request.PriceDenom {
+			return false, nil
+		}
+		if accumulate {
+			listings = append(listings, listing)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "paginate: %v", err)
+	}
+
+	return &types.QueryListingsResponse{
+		Listings:   listings,
+		Pagination: pagination,
+	}, nil
+}
+
+func (k Keeper) Offer(c context.Context, request *types.QueryOfferRequest) (*types.QueryOfferResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	offer, err := k.GetOffer(ctx, request.Id)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryOfferResponse{Offer: &offer}, nil
+}
+
+func (k Keeper) Offers(c context.Context, request *types.QueryOffersRequest) (*types.QueryOffersResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	if request.Buyer != "" {
+		if _, err := sdk.AccAddressFromBech32(request.Buyer); err != nil {
+			return nil, err
+		}
+	}
+
+	var offers []types.Offer
+	store := ctx.KVStore(k.storeKey)
+	offerStore := prefix.NewStore(store, types.KeyOffer(0))
+	pagination, err := query.FilteredPaginate(offerStore, request.Pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
+		var offer types.Offer
+		k.cdc.MustUnmarshal(value, &offer)
+		if request.DenomId != "" && offer.DenomId != request.DenomId {
+			return false, nil
+		}
+		if request.OnftId != "" && offer.OnftId != request.OnftId {
+			return false, nil
+		}
+		if request.Buyer != "" && offer.Buyer != request.Buyer {
+			return false, nil
+		}
+		if accumulate {
+			offers = append(offers, offer)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "paginate: %v", err)
+	}
+
+	return &types.QueryOffersResponse{
+		Offers:     offers,
+		Pagination: pagination,
+	}, nil
+}
+
+func (k Keeper) Auction(c context.Context, request *types.QueryAuctionRequest) (*types.QueryAuctionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	auction, err := k.GetAuction(ctx, request.Id)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryAuctionResponse{
+		Auction:      &auction,
+		CurrentPrice: auction.CurrentPrice(ctx.BlockTime()),
+	}, nil
+}
+
+func (k Keeper) Auctions(c context.Context, request *types.QueryAuctionsRequest) (*types.QueryAuctionsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	if request.Seller != "" {
+		if _, err := sdk.AccAddressFromBech32(request.Seller); err != nil {
+			return nil, err
+		}
+	}
+
+	var auctions []types.Auction
+	store := ctx.KVStore(k.storeKey)
+	auctionStore := prefix.NewStore(store, types.KeyAuction(0))
+	pagination, err := query.FilteredPaginate(auctionStore, request.Pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
+		var auction types.Auction
+		k.cdc.MustUnmarshal(value, &auction)
+		if request.Status != types.AuctionStatusUnspecified && auction.Status != request.Status {
+			return false, nil
+		}
+		if request.Seller != "" && auction.Seller != request.Seller {
+			return false, nil
+		}
+		if request.DenomId != "" && auction.DenomId != request.DenomId {
+			return false, nil
+		}
+		if accumulate {
+			auctions = append(auctions, auction)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "paginate: %v", err)
+	}
+
+	return &types.QueryAuctionsResponse{
+		Auctions:   auctions,
+		Pagination: pagination,
+	}, nil
+}
+
+func (k Keeper) Bids(c context.Context, request *types.QueryBidsRequest) (*types.QueryBidsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	if request.AuctionId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "auction id must be positive")
+	}
+
+	var bids []types.Bid
+	store := ctx.KVStore(k.storeKey)
+	bidStore := prefix.NewStore(store, types.KeyBid(request.AuctionId, 0))
+	pagination, err := query.Paginate(bidStore, request.Pagination, func(key []byte, value []byte) error {
+		var bid types.Bid
+		k.cdc.MustUnmarshal(value, &bid)
+		bids = append(bids, bid)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "paginate: %v", err)
+	}
+
+	return &types.QueryBidsResponse{
+		Bids:       bids,
+		Pagination: pagination,
+	}, nil
+}
+
+func (k Keeper) Loan(c context.Context, request *types.QueryLoanRequest) (*types.QueryLoanResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	loan, err := k.GetLoan(ctx, request.Id)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryLoanResponse{Loan: &loan}, nil
+}
+
+func (k Keeper) Loans(c context.Context, request *types.QueryLoansRequest) (*types.QueryLoansResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	for _, addr := range []string{request.Borrower, request.Lender} {
+		if addr != "" {
+			if _, err := sdk.AccAddressFromBech32(addr); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	var loans []types.Loan
+	store := ctx.KVStore(k.storeKey)
+	loanStore := prefix.NewStore(store, types.KeyLoan(0))
+	pagination, err := query.FilteredPaginate(loanStore, request.Pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
+		var loan types.Loan
+		k.cdc.MustUnmarshal(value, &loan)
+		if request.Borrower != "" && loan.Borrower != request.Borrower {
+			return false, nil
+		}
+		if request.Lender != "" && loan.Lender != request.Lender {
+			return false, nil
+		}
+		if request.Status != types.LoanStatusUnspecified && loan.Status != request.Status {
+			return false, nil
+		}
+		if accumulate {
+			loans = append(loans, loan)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "paginate: %v", err)
+	}
+
+	return &types.QueryLoansResponse{
+		Loans:      loans,
+		Pagination: pagination,
+	}, nil
+}
+
+func (k Keeper) Fractionalization(
+	c context.Context,
+	request *types.QueryFractionalizationRequest,
+) (*types.QueryFractionalizationResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	fractionalization, err := k.GetFractionalization(ctx, request.DenomId, request.OnftId)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryFractionalizationResponse{Fractionalization: &fractionalization}, nil
+}
+
+func (k Keeper) Fractionalizations(
+	c context.Context,
+	request *types.QueryFractionalizationsRequest,
+) (*types.QueryFractionalizationsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	if request.Owner != "" {
+		if _, err := sdk.AccAddressFromBech32(request.Owner); err != nil {
+			return nil, err
+		}
+	}
+
+	var fractionalizations []types.Fractionalization
+	store := ctx.KVStore(k.storeKey)
+	fractionalizationStore := prefix.NewStore(store, types.KeyFractionalization(request.DenomId, ""))
+	pagination, err := query.FilteredPaginate(fractionalizationStore, request.Pagination,
+		func(key []byte, value []byte, accumulate bool) (bool, error) {
+			var fractionalization types.Fractionalization
+			k.cdc.MustUnmarshal(value, &fractionalization)
+			if request.Owner != "" && fractionalization.Owner != request.Owner {
+				return false, nil
+			}
+			if accumulate {
+				fractionalizations = append(fractionalizations, fractionalization)
+			}
+			return true, nil
+		})
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "paginate: %v", err)
+	}
+
+	return &types.QueryFractionalizationsResponse{
+		Fractionalizations: fractionalizations,
+		Pagination:         pagination,
+	}, nil
+}
+
+func (k Keeper) TokenAccount(
+	c context.Context,
+	request *types.QueryTokenAccountRequest,
+) (*types.QueryTokenAccountResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	if !k.HasONFT(ctx, request.DenomId, request.OnftId) {
+		return nil, errorsmod.Wrapf(types.ErrUnknownONFT, "invalid ONFT %s from collection %s", request.OnftId, request.DenomId)
+	}
+	account := types.TokenAccountAddress(request.DenomId, request.OnftId)
+	return &types.QueryTokenAccountResponse{
+		Address:  account.String(),
+		Enabled:  k.HasTokenAccount(ctx, request.DenomId, request.OnftId),
+		Balances: k.bankKeeper.GetAllBalances(ctx, account),
+	}, nil
+}
+
+func (k Keeper) Recipe(c context.Context, request *types.QueryRecipeRequest) (*types.QueryRecipeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	recipe, err := k.GetRecipe(ctx, request.Id)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryRecipeResponse{Recipe: &recipe}, nil
+}
+
+// Recipes returns all recipes, or the recipes minting the given output denom
+func (k Keeper) Recipes(c context.Context, request *types.QueryRecipesRequest) (*types.QueryRecipesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	store := ctx.KVStore(k.storeKey)
+	var recipeStore prefix.Store
+	if request.OutputDenomId != "" {
+		recipeStore = prefix.NewStore(store, types.KeyRecipeByDenom(request.OutputDenomId, 0))
+	} else {
+		recipeStore = prefix.NewStore(store, types.KeyRecipe(0))
+	}
+
+	var recipes []types.Recipe
+	pagination, err := query.FilteredPaginate(recipeStore, request.Pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
+		var recipe types.Recipe
+		if request.OutputDenomId != "" {
+			bz := store.Get(types.KeyRecipe(sdk.BigEndianToUint64(value)))
+			if bz == nil {
+				return false, nil
+			}
+			value = bz
+		}
+		k.cdc.MustUnmarshal(value, &recipe)
+		if request.OutputDenomId != "" && recipe.OutputDenomId != request.OutputDenomId {
+			return false, nil
+		}
+		if accumulate {
+			recipes = append(recipes, recipe)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "paginate: %v", err)
+	}
+
+	return &types.QueryRecipesResponse{
+		Recipes:    recipes,
+		Pagination: pagination,
+	}, nil
+}
+
+func (k Keeper) MetadataCommitment(
+	c context.Context,
+	request *types.QueryMetadataCommitmentRequest,
+) (*types.QueryMetadataCommitmentResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	commitment, err := k.GetMetadataCommitment(ctx, strings.ToLower(strings.TrimSpace(request.DenomId)))
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryMetadataCommitmentResponse{Commitment: &commitment}, nil
+}
+
+// IsValidCredential reports the status of a credential oNFT to verifiers
+func (k Keeper) IsValidCredential(
+	c context.Context,
+	request *types.QueryIsValidCredentialRequest,
+) (*types.QueryIsValidCredentialResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	var holder sdk.AccAddress
+	if request.Holder != "" {
+		var err error
+		holder, err = sdk.AccAddressFromBech32(request.Holder)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid holder address %s", request.Holder)
+		}
+	}
+
+	credentialStatus, owner, revocation := k.GetCredentialStatus(
+		ctx,
+		strings.ToLower(strings.TrimSpace(request.DenomId)),
+		strings.ToLower(strings.TrimSpace(request.OnftId)),
+		holder,
+	)
+	return &types.QueryIsValidCredentialResponse{
+		Valid:      credentialStatus == types.CredentialStatusValid,
+		Status:     credentialStatus,
+		Owner:      owner,
+		Revocation: revocation,
+	}, nil
+}
+
+func (k Keeper) Expiry(c context.Context, request *types.QueryExpiryRequest) (*types.QueryExpiryResponse, error) {
+	denom := strings.ToLower(strings.TrimSpace(request.DenomId))
+	onftID := strings.ToLower(strings.TrimSpace(request.OnftId))
+	ctx := sdk.UnwrapSDKContext(c)
+
+	config, err := k.GetDenomSubscription(ctx, denom)
+	if err != nil {
+		return nil, err
+	}
+	nft, err := k.GetONFT(ctx, denom, onftID)
+	if err != nil {
+		return nil, errorsmod.Wrapf(types.ErrUnknownONFT, "invalid ONFT %s from collection %s", request.OnftId, request.DenomId)
+	}
+	onft := nft.(types.ONFT)
+	if onft.ExpiresAt == nil {
+		return nil, errorsmod.Wrapf(types.ErrInvalidSubscription, "onft %s has no expiry", request.OnftId)
+	}
+	return &types.QueryExpiryResponse{
+		ExpiresAt:    *onft.ExpiresAt,
+		Lapsed:       onft.IsExpired(ctx.BlockTime()),
+		Owner:        onft.Owner,
+		Subscription: config,
+	}, nil
+}
+
+func (k Keeper) RenewalHistory(
+	c context.Context,
+	request *types.QueryRenewalHistoryRequest,
+) (*types.QueryRenewalHistoryResponse, error) {
+	denom := strings.ToLower(strings.TrimSpace(request.DenomId))
+	onftID := strings.ToLower(strings.TrimSpace(request.OnftId))
+	if err := types.ValidateDenomID(denom); err != nil {
+		return nil, err
+	}
+	if err := types.ValidateONFTID(onftID); err != nil {
+		return nil, err
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var renewals []types.Renewal
+	store := ctx.KVStore(k.storeKey)
+	renewalStore := prefix.NewStore(store, types.KeyRenewal(denom, onftID, time.Time{}))
+	pagination, err := query.Paginate(renewalStore, request.Pagination, func(key []byte, value []byte) error {
+		var renewal types.Renewal
+		k.cdc.MustUnmarshal(value, &renewal)
+		renewals = append(renewals, renewal)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "paginate: %v", err)
+	}
+
+	return &types.QueryRenewalHistoryResponse{
+		Renewals:   renewals,
+		Pagination: pagination,
+	}, nil
+}
+
+func (k Keeper) FeeExemptions(
+	c context.Context,
+	request *types.QueryFeeExemptionsRequest,
+) (*types.QueryFeeExemptionsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var exemptions []types.FeeExemption
+	store := ctx.KVStore(k.storeKey)
+	exemptionStore := prefix.NewStore(store, types.KeyFeeExemption(nil))
+	pagination, err := query.Paginate(exemptionStore, request.Pagination, func(key []byte, value []byte) error {
+		var exemption types.FeeExemption
+		k.cdc.MustUnmarshal(value, &exemption)
+		exemptions = append(exemptions, exemption)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "paginate: %v", err)
+	}
+
+	return &types.QueryFeeExemptionsResponse{
+		FeeExemptions: exemptions,
+		Pagination:    pagination,
+	}, nil
+}
+
+// OwnerONFTsV2 queries the oNFTs of an owner across denoms as a flat list.
+// A single denom id paginates over the owner keys of that denom, otherwise the
+// keys span all denoms of the owner and are filtered one by one, so next keys
+// stay valid when a page spans several denoms.
+func (k Keeper) OwnerONFTsV2(
+	c context.Context,
+	request *types.QueryOwnerONFTsV2Request,
+) (*types.QueryOwnerONFTsV2Response, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	address, err := sdk.AccAddressFromBech32(request.Owner)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid owner address %s", request.Owner)
+	}
+	var creator string
+	if request.Creator != "" {
+		creatorAddr, err := sdk.AccAddressFromBech32(request.Creator)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid creator address %s", request.Creator)
+		}
+		creator = creatorAddr.String()
+	}
+	if _, ok := types.BoolFilter_name[int32(request.Nsfw)]; !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid nsfw filter %d", request.Nsfw)
+	}
+	if _, ok := types.BoolFilter_name[int32(request.Transferable)]; !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid transferable filter %d", request.Transferable)
+	}
+
+	denomIDs := make(map[string]bool, len(request.DenomIds))
+	var singleDenomID string
+	for _, denomID := range request.DenomIds {
+		singleDenomID = strings.ToLower(strings.TrimSpace(denomID))
+		denomIDs[singleDenomID] = true
+	}
+	if len(denomIDs) != 1 {
+		singleDenomID = ""
+	}
+
+	var onfts []types.OwnerONFT
+	creators := make(map[string]string)
+	store := ctx.KVStore(k.storeKey)
+	ownerStore := prefix.NewStore(store, types.KeyOwner(address, singleDenomID, ""))
+	pagination, err := query.FilteredPaginate(ownerStore, request.Pagination, func(key []byte, _ []byte, accumulate bool) (bool, error) {
+		denomID, onftID := singleDenomID, string(key)
+		if singleDenomID == "" {
+			var err error
+			denomID, onftID, err = types.SplitKeyDenom(key)
+			if err != nil {
+				return false, err
+			}
+			if len(denomIDs) > 0 && !denomIDs[denomID] {
+				return false, nil
+			}
+		}
+
+		if creator != "" {
+			denomCreator, ok := creators[denomID]
+			if !ok {
+				denom, err := k.GetDenom(ctx, denomID)
+				if err != nil {
+					return false, err
+				}
+				denomCreator = denom.Creator
+				creators[denomID] = denomCreator
+			}
+			if denomCreator != creator {
+				return false, nil
+			}
+		}
+
+		nft, err := k.GetONFT(ctx, denomID, onftID)
+		if err != nil {
+			return false, err
+		}
+		onft := nft.(types.ONFT)
+		if !request.Nsfw.Matches(onft.Nsfw) || !request.Transferable.Matches(onft.Transferable) {
+			return false, nil
+		}
+		if request.MintedAfter != nil && !onft.CreatedAt.After(*request.MintedAfter) {
+			return false, nil
+		}
+
+		if accumulate {
+			onfts = append(onfts, types.OwnerONFT{DenomId: denomID, Onft: onft})
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "paginate: %v", err)
+	}
+
+	return &types.QueryOwnerONFTsV2Response{
+		Onfts:      onfts,
+		Pagination: pagination,
 	}, nil
 }
 
@@ -0,0 +1,144 @@
+package keeper_test
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/OmniFlix/onft/types"
+)
+
+const denomID3 = "onftdenomtest3"
+
+// mintOwnerONFTs mints three oNFTs of every test denom to bob, an hour apart
+// per denom. The second oNFT of a denom is not transferable, the third is nsfw.
+func (s *KeeperTestSuite) mintOwnerONFTs() {
+	s.createDenom(denomID, s.creator)
+	s.createDenom(denomID2, s.alice)
+	s.createDenom(denomID3, s.creator)
+	for _, denom := range []struct {
+		id      string
+		creator sdk.AccAddress
+	}{{denomID, s.creator}, {denomID2, s.alice}, {denomID3, s.creator}} {
+		for i := 1; i <= 3; i++ {
+			s.Require().NoError(s.keeper.MintONFT(
+				s.ctx, denom.id, fmt.Sprintf("onft%d", i),
+				types.Metadata{Name: "name", MediaURI: "https://onft.test/media"}, "{}",
+				i != 2, true, i == 3, sdk.ZeroDec(),
+				denom.creator, s.bob,
+			))
+		}
+		s.nextBlock(time.Hour)
+	}
+	s.mint(denomID, onftID, s.creator, s.alice)
+}
+
+// ownerONFTs pages through the OwnerONFTsV2 query and returns the denom and
+// oNFT ids of all pages
+func (s *KeeperTestSuite) ownerONFTs(request types.QueryOwnerONFTsV2Request, limit uint64, reverse bool) []string {
+	var (
+		ids []string
+		key []byte
+	)
+	for {
+		request.Pagination = &query.PageRequest{Key: key, Limit: limit, Reverse: reverse}
+		res, err := s.keeper.OwnerONFTsV2(s.ctx, &request)
+		s.Require().NoError(err)
+		s.Require().LessOrEqual(uint64(len(res.Onfts)), limit)
+		for _, onft := range res.Onfts {
+			ids = append(ids, onft.DenomId+"/"+onft.Onft.Id)
+		}
+		if res.Pagination.NextKey == nil {
+			return ids
+		}
+		key = res.Pagination.NextKey
+	}
+}
+
+func (s *KeeperTestSuite) TestOwnerONFTsV2() {
+	s.mintOwnerONFTs()
+	owner := s.bob.String()
+	all := []string{
+		denomID + "/onft1", denomID + "/onft2", denomID + "/onft3",
+		denomID2 + "/onft1", denomID2 + "/onft2", denomID2 + "/onft3",
+		denomID3 + "/onft1", denomID3 + "/onft2", denomID3 + "/onft3",
+	}
+	reversed := make([]string, len(all))
+	for i, id := range all {
+		reversed[len(all)-1-i] = id
+	}
+	mintedAfter := genesisTime.Add(30 * time.Minute)
+
+	testCases := []struct {
+		name     string
+		request  types.QueryOwnerONFTsV2Request
+		reverse  bool
+		expected []string
+	}{
+		{"pages span denoms", types.QueryOwnerONFTsV2Request{Owner: owner}, false, all},
+		{"reverse", types.QueryOwnerONFTsV2Request{Owner: owner}, true, reversed},
+		{
+			"single denom",
+			types.QueryOwnerONFTsV2Request{Owner: owner, DenomIds: []string{denomID2}},
+			false, all[3:6],
+		},
+		{
+			"several denoms",
+			types.QueryOwnerONFTsV2Request{Owner: owner, DenomIds: []string{denomID3, denomID}},
+			false, append(append([]string{}, all[:3]...), all[6:]...),
+		},
+		{
+			"transferable and not nsfw",
+			types.QueryOwnerONFTsV2Request{Owner: owner, Nsfw: types.BoolFilterFalse, Transferable: types.BoolFilterTrue},
+			false, []string{all[0], all[3], all[6]},
+		},
+		{
+			"not transferable",
+			types.QueryOwnerONFTsV2Request{Owner: owner, Transferable: types.BoolFilterFalse},
+			true, []string{all[7], all[4], all[1]},
+		},
+		{
+			"creator",
+			types.QueryOwnerONFTsV2Request{Owner: owner, Creator: s.alice.String()},
+			false, all[3:6],
+		},
+		{
+			"minted after",
+			types.QueryOwnerONFTsV2Request{Owner: owner, MintedAfter: &mintedAfter, Nsfw: types.BoolFilterTrue},
+			false, []string{all[5], all[8]},
+		},
+		{"other owner", types.QueryOwnerONFTsV2Request{Owner: s.alice.String()}, false, []string{denomID + "/" + onftID}},
+	}
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			for _, limit := range []uint64{1, 2, 4, 100} {
+				s.Require().Equal(tc.expected, s.ownerONFTs(tc.request, limit, tc.reverse), "limit %d", limit)
+			}
+		})
+	}
+
+	res, err := s.keeper.OwnerONFTsV2(s.ctx, &types.QueryOwnerONFTsV2Request{
+		Owner:      owner,
+		Nsfw:       types.BoolFilterFalse,
+		Pagination: &query.PageRequest{Limit: 2, CountTotal: true},
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(6), res.Pagination.Total)
+	s.Require().Equal(s.bob.String(), res.Onfts[0].Onft.Owner)
+}
+
+func (s *KeeperTestSuite) TestOwnerONFTsV2InvalidRequest() {
+	for _, request := range []*types.QueryOwnerONFTsV2Request{
+		{Owner: "invalid"},
+		{Owner: s.bob.String(), Creator: "invalid"},
+		{Owner: s.bob.String(), Nsfw: 7},
+		{Owner: s.bob.String(), Transferable: 7},
+	} {
+		_, err := s.keeper.OwnerONFTsV2(s.ctx, request)
+		s.Require().Equal(codes.InvalidArgument, status.Code(err))
+	}
+}
@@ -47,6 +47,21 @@ func NewKeeper(
 	}
 }
 
+// deleteByPrefix removes all the store entries with the given key prefix
+func (k Keeper) deleteByPrefix(ctx sdk.Context, prefix []byte) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
 // GetAuthority returns the onft module's authority.
 func (k Keeper) GetAuthority() string {
 	return k.authority
@@ -68,12 +83,7 @@ func (k Keeper) CreateDenom(
 		return errorsmod.Wrapf(types.ErrInvalidDenom, "denomSymbol %s has already exists", symbol)
 	}
 
-	err := k.distributionKeeper.FundCommunityPool(
-		ctx,
-		sdk.NewCoins(fee),
-		creator,
-	)
-	if err != nil {
+	if err := k.distributeFee(ctx, k.discountedCreationFee(ctx, creator, fee), creator); err != nil {
 		return err
 	}
 	// create denom
@@ -124,8 +134,7 @@ func (k Keeper) TransferDenomOwner(ctx sdk.Context, id string, curOwner, newOwne
 	// update denom owner index
 	k.swapDenomOwner(ctx, id, curOwner, newOwner)
 	// emit events
-	k.emitTransferONFTDenomEvent(ctx, denom.Id, denom.Symbol, curOwnerAddr, newOwnerAddr)
-	return nil
+	return k.emitTransferONFTDenomEvent(ctx, denom, curOwnerAddr, newOwnerAddr)
 }
 
 func (k Keeper) MintONFT(
@@ -137,18 +146,7 @@ func (k Keeper) MintONFT(
 	royaltyShare sdk.Dec,
 	sender, recipient sdk.AccAddress,
 ) error {
-	if !k.HasPermissionToMint(ctx, denomID, sender) {
-		return errorsmod.Wrapf(types.ErrUnauthorized, "only creator of denom has permission to mint")
-	}
-	if !k.HasDenomID(ctx, denomID) {
-		return errorsmod.Wrapf(types.ErrInvalidDenom, "denomID %s not exists", denomID)
-	}
-
-	if k.HasONFT(ctx, denomID, onftID) {
-		return errorsmod.Wrapf(types.ErrONFTAlreadyExists, "ONFT %s already exists in collection %s", onftID, denomID)
-	}
-	// create nft
-	k.setONFT(ctx, denomID, types.NewONFT(
+	return k.mintONFT(ctx, denomID, types.NewONFT(
 		onftID,
 		metadata,
 		data,
@@ -158,14 +156,56 @@ func (k Keeper) MintONFT(
 		ctx.BlockHeader().Time,
 		nsfw,
 		royaltyShare,
-	))
+	), sender, sender)
+}
+
+// mintONFT stores a fully populated oNFT under the given denom after checking
+// that sender is allowed to mint into it. The mint fee is charged to payer.
+func (k Keeper) mintONFT(ctx sdk.Context, denomID string, onft types.ONFT, sender, payer sdk.AccAddress) error {
+	if !k.HasPermissionToMint(ctx, denomID, sender) {
+		return errorsmod.Wrapf(types.ErrUnauthorized, "only creator of denom has permission to mint")
+	}
+	if !k.HasDenomID(ctx, denomID) {
+		return errorsmod.Wrapf(types.ErrInvalidDenom, "denomID %s not exists", denomID)
+	}
+
+	if k.HasONFT(ctx, denomID, onft.Id) {
+		return errorsmod.Wrapf(types.ErrONFTAlreadyExists, "ONFT %s already exists in collection %s", onft.Id, denomID)
+	}
+	if k.IsRevoked(ctx, denomID, onft.Id) {
+		return errorsmod.Wrapf(types.ErrONFTRevoked, "ONFT %s of collection %s is revoked", onft.Id, denomID)
+	}
+	if k.IsDenomFrozen(ctx, denomID) {
+		return errorsmod.Wrapf(types.ErrDenomFrozen, "can not mint into frozen denom %s", denomID)
+	}
+	if k.GetDenomPause(ctx, denomID).Mint {
+		return errorsmod.Wrapf(types.ErrDenomPaused, "minting is paused on denom %s", denomID)
+	}
+	if err := k.ChargeMintFee(ctx, payer); err != nil {
+		return err
+	}
+	// governance can force NSFW on a denom
+	if k.IsDenomNSFW(ctx, denomID) {
+		onft.Nsfw = true
+	}
+	// credentials can not be traded
+	if k.IsCredentialDenom(ctx, denomID) {
+		onft.Transferable = false
+	}
+	// subscriptions expire after their first period
+	onft = k.startSubscription(ctx, denomID, onft)
+	// create nft
+	k.setONFT(ctx, denomID, onft)
 	// index nft with owner
-	k.setOwner(ctx, denomID, onftID, recipient)
+	k.setOwner(ctx, denomID, onft.Id, onft.GetOwner())
 	// increase collection supply count
 	k.increaseSupply(ctx, denomID)
+	// flag placeholder metadata of unrevealed denoms
+	if !onft.IsEdition() {
+		k.markUnrevealed(ctx, denomID, onft.Id)
+	}
 	// emit events
-	k.emitMintONFTEvent(ctx, onftID, denomID, metadata.MediaURI, recipient.String())
-	return nil
+	return k.emitMintONFTEvent(ctx, denomID, onft, sender.String())
 }
 
 func (k Keeper) EditONFT(ctx sdk.Context, denomID, onftID string, owner sdk.AccAddress) error {
@@ -181,6 +221,12 @@ func (k Keeper) EditONFT(ctx sdk.Context, denomID, onftID string, owner sdk.AccA
 	if err != nil {
 		return err
 	}
+	if k.IsUnrevealed(ctx, denomID, onftID) {
+		return errorsmod.Wrapf(types.ErrONFTUnrevealed, "onft %s can not be edited before it is revealed", onftID)
+	}
+	if k.GetDenomPause(ctx, denomID).Edit {
+		return errorsmod.Wrapf(types.ErrDenomPaused, "edits are paused on denom %s", denomID)
+	}
 
 	k.setONFT(ctx, denomID, onft)
 	return nil
@@ -198,16 +244,43 @@ func (k Keeper) TransferOwnership(ctx sdk.Context, denomID, onftID string, srcOw
 	if !onft.IsTransferable() {
 		return errorsmod.Wrap(types.ErrNotTransferable, onft.GetID())
 	}
+	if k.IsDenomFrozen(ctx, denomID) {
+		return errorsmod.Wrapf(types.ErrDenomFrozen, "can not transfer onft %s of frozen denom %s", onftID, denomID)
+	}
+	if k.GetDenomPause(ctx, denomID).Transfer {
+		return errorsmod.Wrapf(types.ErrDenomPaused, "transfers are paused on denom %s", denomID)
+	}
+	if k.IsNested(ctx, denomID, onftID) {
+		return errorsmod.Wrapf(types.ErrONFTNested, "onft %s must be unnested first", onftID)
+	}
+	if err := k.validateNestedTransfer(ctx, denomID, onftID); err != nil {
+		return err
+	}
+	// moves in and out of module escrow are not charged
+	moduleAddr := k.GetModuleAddress()
+	if !srcOwner.Equals(moduleAddr) && !dstOwner.Equals(moduleAddr) {
+		if err := k.ChargeTransferFee(ctx, srcOwner); err != nil {
+			return err
+		}
+	}
+	return k.moveONFT(ctx, denomID, onft, srcOwner, dstOwner)
+}
+
+// moveONFT hands an oNFT and the oNFTs nested under it to a new owner
+func (k Keeper) moveONFT(ctx sdk.Context, denomID string, onft types.ONFT, srcOwner, dstOwner sdk.AccAddress) error {
 	// modify owner
 	dstOwnerAddr := dstOwner.String()
 	onft.Owner = dstOwnerAddr
 	// update onft
 	k.setONFT(ctx, denomID, onft)
 	// update nft owner index
-	k.swapOwner(ctx, denomID, onftID, srcOwner, dstOwner)
+	k.swapOwner(ctx, denomID, onft.Id, srcOwner, dstOwner)
 	// emit events
-	k.emitTransferONFTEvent(ctx, onft.Id, denomID, srcOwner.String(), dstOwnerAddr)
-	return nil
+	if err := k.emitTransferONFTEvent(ctx, denomID, onft, srcOwner.String(), dstOwnerAddr); err != nil {
+		return err
+	}
+	// move nested oNFTs along
+	return k.transferNestedONFTs(ctx, denomID, onft.Id, srcOwner, dstOwner)
 }
 
 func (k Keeper) BurnONFT(ctx sdk.Context,
@@ -222,14 +295,37 @@ func (k Keeper) BurnONFT(ctx sdk.Context,
 	if err != nil {
 		return err
 	}
+	if k.IsNested(ctx, denomID, onftID) || k.HasNestedChildren(ctx, denomID, onftID) {
+		return errorsmod.Wrapf(types.ErrONFTNested, "onft %s must be unnested before burning", onftID)
+	}
+	return k.burnONFT(ctx, denomID, onft)
+}
 
+// burnONFT deletes an oNFT with its indexes. The balances of its token account
+// go to the owner.
+func (k Keeper) burnONFT(ctx sdk.Context, denomID string, onft types.ONFT) error {
+	account := types.TokenAccountAddress(denomID, onft.Id)
+	if balances := k.bankKeeper.GetAllBalances(ctx, account); !balances.IsZero() {
+		if err := k.bankKeeper.SendCoins(ctx, account, onft.GetOwner(), balances); err != nil {
+			return err
+		}
+	}
 	// delete oNFT
 	k.deleteONFT(ctx, denomID, onft)
 	// delete nft owner index
-	k.deleteOwner(ctx, denomID, onftID, owner)
+	k.deleteOwner(ctx, denomID, onft.Id, onft.GetOwner())
+	// delete token account
+	k.deleteTokenAccount(ctx, denomID, onft.Id)
+	// delete unrevealed flag
+	k.clearUnrevealed(ctx, denomID, onft.Id)
+	// delete expiry index and renewals
+	k.clearSubscription(ctx, denomID, onft)
+	// delete edition index
+	if onft.IsEdition() {
+		k.deleteEdition(ctx, denomID, onft.MasterId, onft.EditionNumber)
+	}
 	// update nft supply count
 	k.decreaseSupply(ctx, denomID)
 	// emit events
-	k.emitBurnONFTEvent(ctx, onftID, denomID, onft.Owner)
-	return nil
+	return k.emitBurnONFTEvent(ctx, denomID, onft)
 }
@@ -0,0 +1,167 @@
+package keeper_test
+
+import (
+	"encoding/json"
+	"sync"
+	"testing"
+	"time"
+
+	dbm "github.com/cometbft/cometbft-db"
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/crypto/ed25519"
+	"github.com/cometbft/cometbft/libs/log"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	tmtypes "github.com/cometbft/cometbft/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	"github.com/stretchr/testify/suite"
+
+	onftapp "github.com/OmniFlix/onft/app"
+	"github.com/OmniFlix/onft/app/helpers"
+	"github.com/OmniFlix/onft/keeper"
+	"github.com/OmniFlix/onft/types"
+)
+
+const (
+	denomID   = "onftdenomtest"
+	denomID2  = "onftdenomtest2"
+	onftID    = "onfttest"
+	onftID2   = "onfttest2"
+	feeDenom  = "uflix"
+	bondDenom = "stake"
+)
+
+var (
+	configOnce  sync.Once
+	genesisTime = time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	app       *onftapp.App
+	ctx       sdk.Context
+	keeper    keeper.Keeper
+	msgServer types.MsgServer
+
+	creator sdk.AccAddress
+	alice   sdk.AccAddress
+	bob     sdk.AccAddress
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (s *KeeperTestSuite) SetupTest() {
+	configOnce.Do(onftapp.SetConfig)
+
+	encodingConfig := onftapp.MakeEncodingConfig()
+	app := onftapp.New(
+		log.NewNopLogger(), dbm.NewMemDB(), nil, true, map[int64]bool{},
+		s.T().TempDir(), encodingConfig, helpers.EmptyAppOptions{},
+	)
+
+	validator := tmtypes.NewValidator(ed25519.GenPrivKey().PubKey(), 1)
+	valSet := tmtypes.NewValidatorSet([]*tmtypes.Validator{validator})
+	pubKey := secp256k1.GenPrivKey().PubKey()
+	account := authtypes.NewBaseAccount(pubKey.Address().Bytes(), pubKey, 0, 0)
+	balance := banktypes.Balance{
+		Address: account.GetAddress().String(),
+		Coins:   sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 100_000_000_000_000)),
+	}
+	genesisState, err := simtestutil.GenesisStateWithValSet(
+		app.AppCodec(), onftapp.NewDefaultGenesisState(encodingConfig), valSet,
+		[]authtypes.GenesisAccount{account}, balance,
+	)
+	s.Require().NoError(err)
+	stateBytes, err := json.MarshalIndent(genesisState, "", " ")
+	s.Require().NoError(err)
+
+	app.InitChain(abci.RequestInitChain{
+		Validators:      []abci.ValidatorUpdate{},
+		ConsensusParams: simtestutil.DefaultConsensusParams,
+		AppStateBytes:   stateBytes,
+	})
+	app.Commit()
+	header := tmproto.Header{Height: 2, Time: genesisTime}
+	app.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+	s.app = app
+	s.ctx = app.BaseApp.NewContext(false, header)
+	s.keeper = app.ONFTKeeper
+	s.msgServer = keeper.NewMsgServerImpl(app.ONFTKeeper)
+
+	s.creator = testAddr("creator")
+	s.alice = testAddr("alice")
+	s.bob = testAddr("bob")
+}
+
+func testAddr(seed string) sdk.AccAddress {
+	return sdk.AccAddress([]byte(seed + "____________________")[:20])
+}
+
+// fund mints coins to the given account
+func (s *KeeperTestSuite) fund(addr sdk.AccAddress, coins ...sdk.Coin) {
+	amount := sdk.NewCoins(coins...)
+	s.Require().NoError(s.app.BankKeeper.MintCoins(s.ctx, minttypes.ModuleName, amount))
+	s.Require().NoError(s.app.BankKeeper.SendCoinsFromModuleToAccount(s.ctx, minttypes.ModuleName, addr, amount))
+}
+
+func (s *KeeperTestSuite) balance(addr sdk.AccAddress, denom string) int64 {
+	return s.app.BankKeeper.GetBalance(s.ctx, addr, denom).Amount.Int64()
+}
+
+// moduleBalance returns the escrowed balance of the onft module account
+func (s *KeeperTestSuite) moduleBalance(denom string) int64 {
+	return s.balance(s.keeper.GetModuleAddress(), denom)
+}
+
+// createDenom funds the creator with the denom creation fee and creates the denom
+func (s *KeeperTestSuite) createDenom(id string, creator sdk.AccAddress) {
+	fee := s.keeper.GetParams(s.ctx).DenomCreationFees[0]
+	s.fund(creator, fee)
+	msg := types.NewMsgCreateDenom(id, "name", "{}", "", "", creator.String(), fee)
+	msg.Id = id
+	_, err := s.msgServer.CreateDenom(s.ctx, msg)
+	s.Require().NoError(err)
+}
+
+// mint mints an oNFT with a ten percent royalty share from the denom creator to owner
+func (s *KeeperTestSuite) mint(denomID, onftID string, creator, owner sdk.AccAddress) {
+	s.Require().NoError(s.keeper.MintONFT(
+		s.ctx, denomID, onftID,
+		types.Metadata{Name: "name", MediaURI: "https://onft.test/media"}, "{}",
+		true, true, false, sdk.NewDecWithPrec(1, 1),
+		creator, owner,
+	))
+}
+
+func (s *KeeperTestSuite) owner(denomID, onftID string) sdk.AccAddress {
+	onft, err := s.keeper.GetONFT(s.ctx, denomID, onftID)
+	s.Require().NoError(err)
+	return onft.GetOwner()
+}
+
+// nextBlock advances the block height and time
+func (s *KeeperTestSuite) nextBlock(d time.Duration) {
+	s.ctx = s.ctx.WithBlockHeight(s.ctx.BlockHeight() + 1).WithBlockTime(s.ctx.BlockTime().Add(d))
+}
+
+func (s *KeeperTestSuite) TestMintAndTransfer() {
+	s.createDenom(denomID, s.creator)
+	s.mint(denomID, onftID, s.creator, s.alice)
+	s.Require().Equal(s.alice, s.owner(denomID, onftID))
+	s.Require().Equal(uint64(1), s.keeper.GetTotalSupply(s.ctx, denomID))
+
+	s.Require().NoError(s.keeper.TransferOwnership(s.ctx, denomID, onftID, s.alice, s.bob))
+	s.Require().Equal(s.bob, s.owner(denomID, onftID))
+	s.Require().Error(s.keeper.TransferOwnership(s.ctx, denomID, onftID, s.alice, s.bob))
+
+	s.Require().NoError(s.keeper.BurnONFT(s.ctx, denomID, onftID, s.bob))
+	s.Require().False(s.keeper.HasONFT(s.ctx, denomID, onftID))
+}
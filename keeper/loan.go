@@ -0,0 +1,192 @@
+package keeper
+
+import (
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/OmniFlix/onft/types"
+)
+
+// RequestLoan escrows the oNFT of the borrower in the module account as
+// collateral for a loan that any lender can fund.
+func (k Keeper) RequestLoan(
+	ctx sdk.Context,
+	denomID, onftID string,
+	principal, interest sdk.Coin, duration time.Duration,
+	borrower sdk.AccAddress,
+) (uint64, error) {
+	if err := k.TransferOwnership(ctx, denomID, onftID, borrower, k.GetModuleAddress()); err != nil {
+		return 0, err
+	}
+
+	loanID := k.GetNextLoanID(ctx)
+	k.SetNextLoanID(ctx, loanID+1)
+	k.SetLoan(ctx, types.Loan{
+		Id:        loanID,
+		DenomId:   denomID,
+		OnftId:    onftID,
+		Borrower:  borrower.String(),
+		Principal: principal,
+		Interest:  interest,
+		Duration:  duration,
+		Status:    types.LoanStatusRequested,
+	})
+	k.emitRequestLoanEvent(ctx, loanID, denomID, onftID, borrower.String(), principal.String())
+	return loanID, nil
+}
+
+// FundLoan sends the principal from the lender to the borrower and starts the
+// loan, it is due after the loan duration.
+func (k Keeper) FundLoan(ctx sdk.Context, loanID uint64, lender sdk.AccAddress) error {
+	loan, err := k.GetLoan(ctx, loanID)
+	if err != nil {
+		return err
+	}
+	if loan.Status != types.LoanStatusRequested {
+		return errorsmod.Wrapf(types.ErrInvalidLoan, "loan %d is already funded", loanID)
+	}
+	lenderAddr := lender.String()
+	if lenderAddr == loan.Borrower {
+		return errorsmod.Wrap(types.ErrInvalidLoan, "borrower can not fund own loan")
+	}
+	borrower, err := sdk.AccAddressFromBech32(loan.Borrower)
+	if err != nil {
+		return err
+	}
+	if err := k.bankKeeper.SendCoins(ctx, lender, borrower, sdk.NewCoins(loan.Principal)); err != nil {
+		return err
+	}
+
+	loan.Lender = lenderAddr
+	loan.Status = types.LoanStatusActive
+	loan.FundedTime = ctx.BlockTime()
+	loan.DueTime = ctx.BlockTime().Add(loan.Duration)
+	k.SetLoan(ctx, loan)
+	k.emitFundLoanEvent(ctx, loanID, loan.Borrower, lenderAddr, loan.DueTime.String())
+	return nil
+}
+
+// RepayLoan sends principal plus interest from the borrower to the lender and
+// returns the collateral to the borrower. Loans must be repaid before they
+// are due.
+func (k Keeper) RepayLoan(ctx sdk.Context, loanID uint64, borrower sdk.AccAddress) error {
+	loan, err := k.GetLoan(ctx, loanID)
+	if err != nil {
+		return err
+	}
+	if borrower.String() != loan.Borrower {
+		return errorsmod.Wrap(types.ErrUnauthorized, borrower.String())
+	}
+	if loan.Status != types.LoanStatusActive {
+		return errorsmod.Wrapf(types.ErrInvalidLoan, "loan %d is not funded", loanID)
+	}
+	if loan.IsOverdue(ctx.BlockTime()) {
+		return errorsmod.Wrapf(types.ErrLoanOverdue, "loan %d was due at %s", loanID, loan.DueTime)
+	}
+	lender, err := sdk.AccAddressFromBech32(loan.Lender)
+	if err != nil {
+		return err
+	}
+	if err := k.bankKeeper.SendCoins(ctx, borrower, lender, loan.RepayAmount()); err != nil {
+		return err
+	}
+	if err := k.TransferOwnership(ctx, loan.DenomId, loan.OnftId, k.GetModuleAddress(), borrower); err != nil {
+		return err
+	}
+
+	k.deleteLoan(ctx, loanID)
+	k.emitRepayLoanEvent(ctx, loanID, loan.Borrower, loan.Lender, loan.RepayAmount().String())
+	return nil
+}
+
+// ClaimDefault gives the collateral of an overdue loan to the lender
+func (k Keeper) ClaimDefault(ctx sdk.Context, loanID uint64, lender sdk.AccAddress) error {
+	loan, err := k.GetLoan(ctx, loanID)
+	if err != nil {
+		return err
+	}
+	if lender.String() != loan.Lender {
+		return errorsmod.Wrap(types.ErrUnauthorized, lender.String())
+	}
+	if !loan.IsOverdue(ctx.BlockTime()) {
+		return errorsmod.Wrapf(types.ErrInvalidLoan, "loan %d is not due before %s", loanID, loan.DueTime)
+	}
+	if err := k.TransferOwnership(ctx, loan.DenomId, loan.OnftId, k.GetModuleAddress(), lender); err != nil {
+		return err
+	}
+
+	k.deleteLoan(ctx, loanID)
+	k.emitClaimDefaultEvent(ctx, loanID, loan.Borrower, loan.Lender)
+	return nil
+}
+
+// CancelLoan returns the collateral of a loan that was not funded to the borrower
+func (k Keeper) CancelLoan(ctx sdk.Context, loanID uint64, borrower sdk.AccAddress) error {
+	loan, err := k.GetLoan(ctx, loanID)
+	if err != nil {
+		return err
+	}
+	if borrower.String() != loan.Borrower {
+		return errorsmod.Wrap(types.ErrUnauthorized, borrower.String())
+	}
+	if loan.Status != types.LoanStatusRequested {
+		return errorsmod.Wrapf(types.ErrInvalidLoan, "loan %d is already funded", loanID)
+	}
+	if err := k.TransferOwnership(ctx, loan.DenomId, loan.OnftId, k.GetModuleAddress(), borrower); err != nil {
+		return err
+	}
+
+	k.deleteLoan(ctx, loanID)
+	k.emitCancelLoanEvent(ctx, loanID, loan.Borrower)
+	return nil
+}
+
+func (k Keeper) GetNextLoanID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.NextLoanIDKey)
+	if len(bz) == 0 {
+		return 1
+	}
+	return types.MustUnMarshalSupply(k.cdc, bz)
+}
+
+func (k Keeper) SetNextLoanID(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.NextLoanIDKey, types.MustMarshalSupply(k.cdc, id))
+}
+
+func (k Keeper) GetLoan(ctx sdk.Context, id uint64) (loan types.Loan, err error) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyLoan(id))
+	if bz == nil {
+		return loan, errorsmod.Wrapf(types.ErrUnknownLoan, "loan %d not found", id)
+	}
+	k.cdc.MustUnmarshal(bz, &loan)
+	return loan, nil
+}
+
+func (k Keeper) GetLoans(ctx sdk.Context) (loans []types.Loan) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyLoan(0))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var loan types.Loan
+		k.cdc.MustUnmarshal(iterator.Value(), &loan)
+		loans = append(loans, loan)
+	}
+	return loans
+}
+
+func (k Keeper) SetLoan(ctx sdk.Context, loan types.Loan) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&loan)
+	store.Set(types.KeyLoan(loan.Id), bz)
+}
+
+func (k Keeper) deleteLoan(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.KeyLoan(id))
+}
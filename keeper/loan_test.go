@@ -0,0 +1,66 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/OmniFlix/onft/types"
+)
+
+func (s *KeeperTestSuite) requestLoan() uint64 {
+	s.createDenom(denomID, s.creator)
+	s.mint(denomID, onftID, s.creator, s.alice)
+	loanID, err := s.keeper.RequestLoan(
+		s.ctx, denomID, onftID,
+		sdk.NewInt64Coin(feeDenom, 1000), sdk.NewInt64Coin(feeDenom, 50), 24*time.Hour,
+		s.alice,
+	)
+	s.Require().NoError(err)
+	s.Require().Equal(s.keeper.GetModuleAddress(), s.owner(denomID, onftID))
+	return loanID
+}
+
+func (s *KeeperTestSuite) TestRepayLoan() {
+	loanID := s.requestLoan()
+	s.fund(s.bob, sdk.NewInt64Coin(feeDenom, 1000))
+	s.fund(s.alice, sdk.NewInt64Coin(feeDenom, 50))
+
+	s.Require().ErrorIs(s.keeper.FundLoan(s.ctx, loanID, s.alice), types.ErrInvalidLoan)
+	s.Require().NoError(s.keeper.FundLoan(s.ctx, loanID, s.bob))
+	s.Require().Equal(int64(1050), s.balance(s.alice, feeDenom))
+	s.Require().ErrorIs(s.keeper.CancelLoan(s.ctx, loanID, s.alice), types.ErrInvalidLoan)
+	loan, err := s.keeper.GetLoan(s.ctx, loanID)
+	s.Require().NoError(err)
+	s.Require().Equal(s.ctx.BlockTime().Add(24*time.Hour), loan.DueTime)
+
+	s.nextBlock(time.Hour)
+	s.Require().ErrorIs(s.keeper.ClaimDefault(s.ctx, loanID, s.bob), types.ErrInvalidLoan)
+	s.Require().NoError(s.keeper.RepayLoan(s.ctx, loanID, s.alice))
+	s.Require().Equal(s.alice, s.owner(denomID, onftID))
+	s.Require().Equal(int64(0), s.balance(s.alice, feeDenom))
+	s.Require().Equal(int64(1050), s.balance(s.bob, feeDenom))
+	_, err = s.keeper.GetLoan(s.ctx, loanID)
+	s.Require().Error(err)
+}
+
+func (s *KeeperTestSuite) TestClaimDefault() {
+	loanID := s.requestLoan()
+	s.fund(s.bob, sdk.NewInt64Coin(feeDenom, 1000))
+	s.Require().NoError(s.keeper.FundLoan(s.ctx, loanID, s.bob))
+
+	s.nextBlock(24 * time.Hour)
+	s.Require().ErrorIs(s.keeper.RepayLoan(s.ctx, loanID, s.alice), types.ErrLoanOverdue)
+	s.Require().ErrorIs(s.keeper.ClaimDefault(s.ctx, loanID, s.alice), types.ErrUnauthorized)
+	s.Require().NoError(s.keeper.ClaimDefault(s.ctx, loanID, s.bob))
+	s.Require().Equal(s.bob, s.owner(denomID, onftID))
+}
+
+func (s *KeeperTestSuite) TestCancelLoan() {
+	loanID := s.requestLoan()
+
+	s.Require().ErrorIs(s.keeper.CancelLoan(s.ctx, loanID, s.bob), types.ErrUnauthorized)
+	s.Require().NoError(s.keeper.CancelLoan(s.ctx, loanID, s.alice))
+	s.Require().Equal(s.alice, s.owner(denomID, onftID))
+	s.Require().ErrorIs(s.keeper.FundLoan(s.ctx, loanID, s.bob), types.ErrUnknownLoan)
+}
@@ -0,0 +1,415 @@
+package keeper
+
+import (
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/OmniFlix/onft/types"
+)
+
+// SetRoyaltyReceivers replaces the royalty receivers of a denom, only the
+// denom creator can change them.
+func (k Keeper) SetRoyaltyReceivers(ctx sdk.Context, denomID string, receivers []types.WeightedAddress, sender sdk.AccAddress) error {
+	denom, err := k.AuthorizeDenomCreator(ctx, denomID, sender)
+	if err != nil {
+		return err
+	}
+	denom.RoyaltyReceivers = receivers
+	k.SetDenom(ctx, denom)
+	return nil
+}
+
+// ListONFT escrows the oNFT of the owner in the module account and lists it
+// for sale at a fixed price.
+func (k Keeper) ListONFT(
+	ctx sdk.Context,
+	denomID, onftID string,
+	price sdk.Coin, expiry time.Time,
+	owner sdk.AccAddress,
+) (uint64, error) {
+	if !expiry.IsZero() && !expiry.After(ctx.BlockTime()) {
+		return 0, errorsmod.Wrapf(types.ErrInvalidListing, "expiry %s must be in the future", expiry)
+	}
+	if err := k.TransferOwnership(ctx, denomID, onftID, owner, k.GetModuleAddress()); err != nil {
+		return 0, err
+	}
+
+	listingID := k.GetNextListingID(ctx)
+	k.SetNextListingID(ctx, listingID+1)
+	k.SetListing(ctx, types.Listing{
+		Id:      listingID,
+		DenomId: denomID,
+		OnftId:  onftID,
+		Seller:  owner.String(),
+		Price:   price,
+		Expiry:  expiry,
+	})
+	k.emitListONFTEvent(ctx, listingID, denomID, onftID, owner.String(), price.String())
+	return listingID, nil
+}
+
+// BuyONFT pays the listing price from the buyer, settles the sale and
+// releases the listed oNFT to the buyer.
+func (k Keeper) BuyONFT(ctx sdk.Context, listingID uint64, buyer sdk.AccAddress) error {
+	listing, err := k.GetListing(ctx, listingID)
+	if err != nil {
+		return err
+	}
+	if listing.IsExpired(ctx.BlockTime()) {
+		return errorsmod.Wrapf(types.ErrListingExpired, "listing %d expired at %s", listingID, listing.Expiry)
+	}
+	buyerAddr := buyer.String()
+	if buyerAddr == listing.Seller {
+		return errorsmod.Wrap(types.ErrInvalidListing, "seller can not buy own listing")
+	}
+	seller, err := sdk.AccAddressFromBech32(listing.Seller)
+	if err != nil {
+		return err
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, buyer, types.ModuleName, sdk.NewCoins(listing.Price)); err != nil {
+		return err
+	}
+	if err := k.settleSale(ctx, listing.DenomId, listing.OnftId, listing.Price, seller); err != nil {
+		return err
+	}
+	if err := k.TransferOwnership(ctx, listing.DenomId, listing.OnftId, k.GetModuleAddress(), buyer); err != nil {
+		return err
+	}
+
+	k.deleteListing(ctx, listing)
+	k.emitBuyONFTEvent(ctx, listingID, listing.DenomId, listing.OnftId, listing.Seller, buyerAddr, listing.Price.String())
+	return nil
+}
+
+// DelistONFT returns a listed oNFT to its seller. The seller can delist at any
+// time and anyone can delist an expired listing.
+func (k Keeper) DelistONFT(ctx sdk.Context, listingID uint64, sender sdk.AccAddress) error {
+	listing, err := k.GetListing(ctx, listingID)
+	if err != nil {
+		return err
+	}
+	if sender.String() != listing.Seller && !listing.IsExpired(ctx.BlockTime()) {
+		return errorsmod.Wrap(types.ErrUnauthorized, sender.String())
+	}
+	seller, err := sdk.AccAddressFromBech32(listing.Seller)
+	if err != nil {
+		return err
+	}
+	if err := k.TransferOwnership(ctx, listing.DenomId, listing.OnftId, k.GetModuleAddress(), seller); err != nil {
+		return err
+	}
+
+	k.deleteListing(ctx, listing)
+	k.emitDelistONFTEvent(ctx, listingID, listing.DenomId, listing.OnftId, listing.Seller, sender.String())
+	return nil
+}
+
+// MakeOffer escrows the offered amount of the buyer for a single oNFT, or
+// for any oNFT of the denom when no oNFT id is given.
+func (k Keeper) MakeOffer(
+	ctx sdk.Context,
+	denomID, onftID string,
+	amount sdk.Coin, expiry time.Time,
+	buyer sdk.AccAddress,
+) (uint64, error) {
+	if !expiry.IsZero() && !expiry.After(ctx.BlockTime()) {
+		return 0, errorsmod.Wrapf(types.ErrInvalidOffer, "expiry %s must be in the future", expiry)
+	}
+	if !k.HasDenomID(ctx, denomID) {
+		return 0, errorsmod.Wrapf(types.ErrInvalidDenom, "denomID %s not exists", denomID)
+	}
+	if len(onftID) > 0 && !k.HasONFT(ctx, denomID, onftID) {
+		return 0, errorsmod.Wrapf(types.ErrUnknownONFT, "onft %s not found in denom %s", onftID, denomID)
+	}
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, buyer, types.ModuleName, sdk.NewCoins(amount)); err != nil {
+		return 0, err
+	}
+
+	offerID := k.GetNextOfferID(ctx)
+	k.SetNextOfferID(ctx, offerID+1)
+	k.SetOffer(ctx, types.Offer{
+		Id:      offerID,
+		Buyer:   buyer.String(),
+		DenomId: denomID,
+		OnftId:  onftID,
+		Amount:  amount,
+		Expiry:  expiry,
+	})
+	k.emitMakeOfferEvent(ctx, offerID, denomID, onftID, buyer.String(), amount.String())
+	return offerID, nil
+}
+
+// AcceptOffer sells the oNFT of the seller to the buyer of the offer. An oNFT
+// listed by the seller is delisted as part of the sale.
+func (k Keeper) AcceptOffer(ctx sdk.Context, offerID uint64, onftID string, seller sdk.AccAddress) error {
+	offer, err := k.GetOffer(ctx, offerID)
+	if err != nil {
+		return err
+	}
+	if offer.IsExpired(ctx.BlockTime()) {
+		return errorsmod.Wrapf(types.ErrOfferExpired, "offer %d expired at %s", offerID, offer.Expiry)
+	}
+	if !offer.IsCollectionOffer() {
+		if len(onftID) > 0 && onftID != offer.OnftId {
+			return errorsmod.Wrapf(types.ErrInvalidOffer, "offer %d is for onft %s", offerID, offer.OnftId)
+		}
+		onftID = offer.OnftId
+	}
+	if len(onftID) == 0 {
+		return errorsmod.Wrap(types.ErrInvalidOffer, "onft id is required to accept a collection offer")
+	}
+	sellerAddr := seller.String()
+	if sellerAddr == offer.Buyer {
+		return errorsmod.Wrap(types.ErrInvalidOffer, "buyer can not accept own offer")
+	}
+	buyer, err := sdk.AccAddressFromBech32(offer.Buyer)
+	if err != nil {
+		return err
+	}
+
+	listing, listed := k.getListingByONFT(ctx, offer.DenomId, onftID)
+	if listed {
+		if listing.Seller != sellerAddr {
+			return errorsmod.Wrap(types.ErrUnauthorized, sellerAddr)
+		}
+		k.deleteListing(ctx, listing)
+	} else if err := k.TransferOwnership(ctx, offer.DenomId, onftID, seller, k.GetModuleAddress()); err != nil {
+		return err
+	}
+	if err := k.settleSale(ctx, offer.DenomId, onftID, offer.Amount, seller); err != nil {
+		return err
+	}
+	if err := k.TransferOwnership(ctx, offer.DenomId, onftID, k.GetModuleAddress(), buyer); err != nil {
+		return err
+	}
+
+	k.deleteOffer(ctx, offerID)
+	k.emitAcceptOfferEvent(ctx, offerID, offer.DenomId, onftID, sellerAddr, offer.Buyer, offer.Amount.String())
+	return nil
+}
+
+// CancelOffer refunds the escrowed amount of an offer to its buyer. The buyer
+// can cancel at any time and anyone can cancel an expired offer.
+func (k Keeper) CancelOffer(ctx sdk.Context, offerID uint64, sender sdk.AccAddress) error {
+	offer, err := k.GetOffer(ctx, offerID)
+	if err != nil {
+		return err
+	}
+	if sender.String() != offer.Buyer && !offer.IsExpired(ctx.BlockTime()) {
+		return errorsmod.Wrap(types.ErrUnauthorized, sender.String())
+	}
+	buyer, err := sdk.AccAddressFromBech32(offer.Buyer)
+	if err != nil {
+		return err
+	}
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, buyer, sdk.NewCoins(offer.Amount)); err != nil {
+		return err
+	}
+
+	k.deleteOffer(ctx, offerID)
+	k.emitCancelOfferEvent(ctx, offerID, offer.Buyer, sender.String())
+	return nil
+}
+
+// settleSale distributes a sale price held by the module account. The
+// platform fee goes to the platform fee receiver, or the community pool when
+// no receiver is set, the royalty share of the oNFT is split between the
+// royalty receivers of the denom, or paid to the denom creator, and the rest
+// goes to the seller. The royalty is capped at what is left after the platform
+// fee, so a sale never pays out more than its price.
+func (k Keeper) settleSale(ctx sdk.Context, denomID, onftID string, price sdk.Coin, seller sdk.AccAddress) error {
+	denom, err := k.GetDenom(ctx, denomID)
+	if err != nil {
+		return err
+	}
+	onft, err := k.GetONFT(ctx, denomID, onftID)
+	if err != nil {
+		return err
+	}
+	params := k.GetParams(ctx)
+	moduleAddr := k.GetModuleAddress()
+	remaining := price.Amount
+
+	platformFee := sdk.NewCoin(price.Denom, sdk.NewDecFromInt(price.Amount).Mul(params.GetPlatformFeePercentage()).TruncateInt())
+	if platformFee.IsPositive() {
+		if len(params.PlatformFeeReceiver) > 0 {
+			receiver, err := sdk.AccAddressFromBech32(params.PlatformFeeReceiver)
+			if err != nil {
+				return err
+			}
+			err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, receiver, sdk.NewCoins(platformFee))
+			if err != nil {
+				return err
+			}
+		} else if err := k.distributionKeeper.FundCommunityPool(ctx, sdk.NewCoins(platformFee), moduleAddr); err != nil {
+			return err
+		}
+		remaining = remaining.Sub(platformFee.Amount)
+	}
+
+	royalty := sdk.NewCoin(price.Denom, sdk.ZeroInt())
+	if royaltyShare := onft.GetRoyaltyShare(); !royaltyShare.IsNil() {
+		royalty.Amount = sdk.MinInt(sdk.NewDecFromInt(price.Amount).Mul(royaltyShare).TruncateInt(), remaining)
+	}
+	if royalty.IsPositive() {
+		receivers := denom.RoyaltyReceivers
+		if len(receivers) == 0 {
+			receivers = []types.WeightedAddress{{Address: denom.Creator, Weight: sdk.OneDec()}}
+		}
+		left := royalty.Amount
+		for i, receiver := range receivers {
+			share := left
+			if i < len(receivers)-1 {
+				share = sdk.NewDecFromInt(royalty.Amount).Mul(receiver.Weight).TruncateInt()
+			}
+			left = left.Sub(share)
+			if !share.IsPositive() {
+				continue
+			}
+			receiverAddr, err := sdk.AccAddressFromBech32(receiver.Address)
+			if err != nil {
+				return err
+			}
+			err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, receiverAddr, sdk.NewCoins(sdk.NewCoin(price.Denom, share)))
+			if err != nil {
+				return err
+			}
+		}
+		remaining = remaining.Sub(royalty.Amount)
+	}
+
+	if remaining.IsPositive() {
+		err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, seller, sdk.NewCoins(sdk.NewCoin(price.Denom, remaining)))
+		if err != nil {
+			return err
+		}
+	}
+	k.emitRoyaltyPaidEvent(ctx, denomID, onftID, royalty.String(), platformFee.String())
+	return nil
+}
+
+func (k Keeper) GetNextListingID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.NextListingIDKey)
+	if len(bz) == 0 {
+		return 1
+	}
+	return types.MustUnMarshalSupply(k.cdc, bz)
+}
+
+func (k Keeper) SetNextListingID(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.NextListingIDKey, types.MustMarshalSupply(k.cdc, id))
+}
+
+func (k Keeper) GetListing(ctx sdk.Context, id uint64) (listing types.Listing, err error) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyListing(id))
+	if bz == nil {
+		return listing, errorsmod.Wrapf(types.ErrUnknownListing, "listing %d not found", id)
+	}
+	k.cdc.MustUnmarshal(bz, &listing)
+	return listing, nil
+}
+
+func (k Keeper) GetListings(ctx sdk.Context) (listings []types.Listing) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyListing(0))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var listing types.Listing
+		k.cdc.MustUnmarshal(iterator.Value(), &listing)
+		listings = append(listings, listing)
+	}
+	return listings
+}
+
+// SetListing stores a listing together with its denom, seller, price and
+// oNFT indexes.
+func (k Keeper) SetListing(ctx sdk.Context, listing types.Listing) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&listing)
+	store.Set(types.KeyListing(listing.Id), bz)
+
+	idBz := sdk.Uint64ToBigEndian(listing.Id)
+	seller, _ := sdk.AccAddressFromBech32(listing.Seller)
+	store.Set(types.KeyListingByDenom(listing.DenomId, listing.Id), idBz)
+	store.Set(types.KeyListingBySeller(seller, listing.Id), idBz)
+	store.Set(types.KeyListingByPrice(listing.Price, listing.Id), idBz)
+	store.Set(types.KeyListingByONFT(listing.DenomId, listing.OnftId), idBz)
+}
+
+func (k Keeper) deleteListing(ctx sdk.Context, listing types.Listing) {
+	store := ctx.KVStore(k.storeKey)
+	seller, _ := sdk.AccAddressFromBech32(listing.Seller)
+	store.Delete(types.KeyListing(listing.Id))
+	store.Delete(types.KeyListingByDenom(listing.DenomId, listing.Id))
+	store.Delete(types.KeyListingBySeller(seller, listing.Id))
+	store.Delete(types.KeyListingByPrice(listing.Price, listing.Id))
+	store.Delete(types.KeyListingByONFT(listing.DenomId, listing.OnftId))
+}
+
+func (k Keeper) getListingByONFT(ctx sdk.Context, denomID, onftID string) (types.Listing, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyListingByONFT(denomID, onftID))
+	if bz == nil {
+		return types.Listing{}, false
+	}
+	listing, err := k.GetListing(ctx, sdk.BigEndianToUint64(bz))
+	if err != nil {
+		return types.Listing{}, false
+	}
+	return listing, true
+}
+
+func (k Keeper) GetNextOfferID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.NextOfferIDKey)
+	if len(bz) == 0 {
+		return 1
+	}
+	return types.MustUnMarshalSupply(k.cdc, bz)
+}
+
+func (k Keeper) SetNextOfferID(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.NextOfferIDKey, types.MustMarshalSupply(k.cdc, id))
+}
+
+func (k Keeper) GetOffer(ctx sdk.Context, id uint64) (offer types.Offer, err error) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyOffer(id))
+	if bz == nil {
+		return offer, errorsmod.Wrapf(types.ErrUnknownOffer, "offer %d not found", id)
+	}
+	k.cdc.MustUnmarshal(bz, &offer)
+	return offer, nil
+}
+
+func (k Keeper) GetOffers(ctx sdk.Context) (offers []types.Offer) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyOffer(0))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var offer types.Offer
+		k.cdc.MustUnmarshal(iterator.Value(), &offer)
+		offers = append(offers, offer)
+	}
+	return offers
+}
+
+func (k Keeper) SetOffer(ctx sdk.Context, offer types.Offer) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&offer)
+	store.Set(types.KeyOffer(offer.Id), bz)
+}
+
+func (k Keeper) deleteOffer(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.KeyOffer(id))
+}
@@ -0,0 +1,151 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/OmniFlix/onft/types"
+)
+
+// setPlatformFee sets the platform fee percentage and returns the fee receiver
+func (s *KeeperTestSuite) setPlatformFee(percentage sdk.Dec) sdk.AccAddress {
+	receiver := testAddr("platform")
+	params := s.keeper.GetParams(s.ctx)
+	params.PlatformFeePercentage = percentage
+	params.PlatformFeeReceiver = receiver.String()
+	s.Require().NoError(s.keeper.SetParams(s.ctx, params))
+	return receiver
+}
+
+func (s *KeeperTestSuite) TestBuyONFT() {
+	platform := s.setPlatformFee(sdk.NewDecWithPrec(5, 2))
+	s.createDenom(denomID, s.creator)
+	s.mint(denomID, onftID, s.creator, s.alice)
+	s.fund(s.bob, sdk.NewInt64Coin(feeDenom, 1000))
+
+	listingID, err := s.keeper.ListONFT(s.ctx, denomID, onftID, sdk.NewInt64Coin(feeDenom, 1000), time.Time{}, s.alice)
+	s.Require().NoError(err)
+	s.Require().Equal(s.keeper.GetModuleAddress(), s.owner(denomID, onftID))
+
+	s.Require().ErrorIs(s.keeper.BuyONFT(s.ctx, listingID, s.alice), types.ErrInvalidListing)
+	s.Require().NoError(s.keeper.BuyONFT(s.ctx, listingID, s.bob))
+	s.Require().Equal(s.bob, s.owner(denomID, onftID))
+	s.Require().Equal(int64(0), s.balance(s.bob, feeDenom))
+	s.Require().Equal(int64(50), s.balance(platform, feeDenom))
+	s.Require().Equal(int64(100), s.balance(s.creator, feeDenom))
+	s.Require().Equal(int64(850), s.balance(s.alice, feeDenom))
+	s.Require().Equal(int64(0), s.moduleBalance(feeDenom))
+	_, err = s.keeper.GetListing(s.ctx, listingID)
+	s.Require().ErrorIs(err, types.ErrUnknownListing)
+}
+
+func (s *KeeperTestSuite) TestDelistONFT() {
+	s.createDenom(denomID, s.creator)
+	s.mint(denomID, onftID, s.creator, s.alice)
+
+	expiry := s.ctx.BlockTime().Add(time.Hour)
+	listingID, err := s.keeper.ListONFT(s.ctx, denomID, onftID, sdk.NewInt64Coin(feeDenom, 1000), expiry, s.alice)
+	s.Require().NoError(err)
+
+	// only the seller can delist before the listing expires
+	s.Require().ErrorIs(s.keeper.DelistONFT(s.ctx, listingID, s.bob), types.ErrUnauthorized)
+	s.nextBlock(time.Hour)
+	s.Require().ErrorIs(s.keeper.BuyONFT(s.ctx, listingID, s.bob), types.ErrListingExpired)
+	s.Require().NoError(s.keeper.DelistONFT(s.ctx, listingID, s.bob))
+	s.Require().Equal(s.alice, s.owner(denomID, onftID))
+}
+
+func (s *KeeperTestSuite) TestAcceptOffer() {
+	s.createDenom(denomID, s.creator)
+	s.mint(denomID, onftID, s.creator, s.alice)
+	s.fund(s.bob, sdk.NewInt64Coin(feeDenom, 1000))
+
+	offerID, err := s.keeper.MakeOffer(s.ctx, denomID, onftID, sdk.NewInt64Coin(feeDenom, 1000), time.Time{}, s.bob)
+	s.Require().NoError(err)
+	s.Require().Equal(int64(0), s.balance(s.bob, feeDenom))
+	s.Require().Equal(int64(1000), s.moduleBalance(feeDenom))
+
+	// accepting the offer delists the listed oNFT
+	_, err = s.keeper.ListONFT(s.ctx, denomID, onftID, sdk.NewInt64Coin(feeDenom, 2000), time.Time{}, s.alice)
+	s.Require().NoError(err)
+	s.Require().ErrorIs(s.keeper.AcceptOffer(s.ctx, offerID, onftID, s.creator), types.ErrUnauthorized)
+	s.Require().NoError(s.keeper.AcceptOffer(s.ctx, offerID, onftID, s.alice))
+	s.Require().Equal(s.bob, s.owner(denomID, onftID))
+	s.Require().Equal(int64(100), s.balance(s.creator, feeDenom))
+	s.Require().Equal(int64(900), s.balance(s.alice, feeDenom))
+	s.Require().Equal(int64(0), s.moduleBalance(feeDenom))
+	s.Require().Empty(s.keeper.GetListings(s.ctx))
+}
+
+func (s *KeeperTestSuite) TestAcceptCollectionOffer() {
+	s.createDenom(denomID, s.creator)
+	s.mint(denomID, onftID, s.creator, s.alice)
+	s.fund(s.bob, sdk.NewInt64Coin(feeDenom, 1000))
+
+	offerID, err := s.keeper.MakeOffer(s.ctx, denomID, "", sdk.NewInt64Coin(feeDenom, 1000), time.Time{}, s.bob)
+	s.Require().NoError(err)
+	s.Require().ErrorIs(s.keeper.AcceptOffer(s.ctx, offerID, "", s.alice), types.ErrInvalidOffer)
+	s.Require().NoError(s.keeper.AcceptOffer(s.ctx, offerID, onftID, s.alice))
+	s.Require().Equal(s.bob, s.owner(denomID, onftID))
+	s.Require().Equal(int64(0), s.moduleBalance(feeDenom))
+}
+
+func (s *KeeperTestSuite) TestCancelOffer() {
+	s.createDenom(denomID, s.creator)
+	s.fund(s.bob, sdk.NewInt64Coin(feeDenom, 1000))
+
+	offerID, err := s.keeper.MakeOffer(s.ctx, denomID, "", sdk.NewInt64Coin(feeDenom, 1000), time.Time{}, s.bob)
+	s.Require().NoError(err)
+	s.Require().ErrorIs(s.keeper.CancelOffer(s.ctx, offerID, s.alice), types.ErrUnauthorized)
+	s.Require().NoError(s.keeper.CancelOffer(s.ctx, offerID, s.bob))
+	s.Require().Equal(int64(1000), s.balance(s.bob, feeDenom))
+	s.Require().Equal(int64(0), s.moduleBalance(feeDenom))
+}
+
+func (s *KeeperTestSuite) TestSettleSaleRoyaltyReceivers() {
+	s.createDenom(denomID, s.creator)
+	s.mint(denomID, onftID, s.creator, s.alice)
+	s.fund(s.bob, sdk.NewInt64Coin(feeDenom, 1000))
+	carol := testAddr("carol")
+
+	receivers := []types.WeightedAddress{
+		{Address: s.creator.String(), Weight: sdk.NewDecWithPrec(3, 1)},
+		{Address: carol.String(), Weight: sdk.NewDecWithPrec(7, 1)},
+	}
+	s.Require().ErrorIs(s.keeper.SetRoyaltyReceivers(s.ctx, denomID, receivers, s.alice), types.ErrUnauthorized)
+	s.Require().NoError(s.keeper.SetRoyaltyReceivers(s.ctx, denomID, receivers, s.creator))
+
+	listingID, err := s.keeper.ListONFT(s.ctx, denomID, onftID, sdk.NewInt64Coin(feeDenom, 1000), time.Time{}, s.alice)
+	s.Require().NoError(err)
+	s.Require().NoError(s.keeper.BuyONFT(s.ctx, listingID, s.bob))
+	s.Require().Equal(int64(30), s.balance(s.creator, feeDenom))
+	s.Require().Equal(int64(70), s.balance(carol, feeDenom))
+	s.Require().Equal(int64(900), s.balance(s.alice, feeDenom))
+}
+
+func (s *KeeperTestSuite) TestSettleSaleCapsRoyalty() {
+	platform := s.setPlatformFee(sdk.NewDecWithPrec(5, 1))
+	s.createDenom(denomID, s.creator)
+	s.Require().NoError(s.keeper.MintONFT(
+		s.ctx, denomID, onftID,
+		types.Metadata{Name: "name", MediaURI: "https://onft.test/media"}, "{}",
+		true, true, false, sdk.NewDecWithPrec(6, 1),
+		s.creator, s.alice,
+	))
+	// escrow of another buyer held by the module account
+	carol := testAddr("carol")
+	s.fund(carol, sdk.NewInt64Coin(feeDenom, 500))
+	_, err := s.keeper.MakeOffer(s.ctx, denomID, "", sdk.NewInt64Coin(feeDenom, 500), time.Time{}, carol)
+	s.Require().NoError(err)
+	s.fund(s.bob, sdk.NewInt64Coin(feeDenom, 1000))
+
+	listingID, err := s.keeper.ListONFT(s.ctx, denomID, onftID, sdk.NewInt64Coin(feeDenom, 1000), time.Time{}, s.alice)
+	s.Require().NoError(err)
+	escrowed := s.moduleBalance(feeDenom)
+	s.Require().NoError(s.keeper.BuyONFT(s.ctx, listingID, s.bob))
+	s.Require().Equal(escrowed, s.moduleBalance(feeDenom))
+	s.Require().Equal(int64(500), s.balance(platform, feeDenom))
+	s.Require().Equal(int64(500), s.balance(s.creator, feeDenom))
+	s.Require().Equal(int64(0), s.balance(s.alice, feeDenom))
+}
@@ -3,6 +3,7 @@ package keeper
 import (
 	"github.com/OmniFlix/onft/exported"
 	v2 "github.com/OmniFlix/onft/migrations/v2"
+	v3 "github.com/OmniFlix/onft/migrations/v3"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -26,3 +27,10 @@ func NewMigrator(k Keeper, ss exported.Subspace) Migrator {
 func (m Migrator) Migrate1to2(ctx sdk.Context) error {
 	return v2.Migrate(ctx, ctx.KVStore(m.keeper.storeKey), m.legacySubspace, m.keeper.cdc)
 }
+
+// Migrate2to3 migrates the onft module state from the consensus version 2 to
+// version 3. Specifically, it moves the single denom creation fee into the
+// list of accepted denom creation fees.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	return v3.Migrate(ctx, ctx.KVStore(m.keeper.storeKey), m.keeper.cdc)
+}
@@ -0,0 +1,141 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/OmniFlix/onft/types"
+)
+
+// FreezeDenom freezes or unfreezes minting and transfers of the oNFTs of a
+// denom. It is called for the module authority.
+func (k Keeper) FreezeDenom(ctx sdk.Context, denomID string, frozen bool, reason string) error {
+	status, err := k.moderationStatusOf(ctx, denomID)
+	if err != nil {
+		return err
+	}
+	status.Frozen = frozen
+	status.FreezeReason = ""
+	if frozen {
+		status.FreezeReason = reason
+	}
+	k.SetModerationStatus(ctx, status)
+	k.emitModerationEvent(ctx, types.EventTypeFreezeDenom, denomID, types.AttributeKeyFrozen, frozen, reason)
+	return nil
+}
+
+// SetDenomNSFW marks a denom and all its oNFTs NSFW, and forces NSFW on oNFTs
+// minted later. Unsetting it leaves the flag of existing oNFTs as it is.
+func (k Keeper) SetDenomNSFW(ctx sdk.Context, denomID string, nsfw bool, reason string) error {
+	status, err := k.moderationStatusOf(ctx, denomID)
+	if err != nil {
+		return err
+	}
+	status.Nsfw = nsfw
+	status.NsfwReason = ""
+	if nsfw {
+		status.NsfwReason = reason
+		for _, nft := range k.GetONFTs(ctx, denomID) {
+			onft := nft.(types.ONFT)
+			if !onft.Nsfw {
+				onft.Nsfw = true
+				k.setONFT(ctx, denomID, onft)
+			}
+		}
+	}
+	k.SetModerationStatus(ctx, status)
+	k.emitModerationEvent(ctx, types.EventTypeSetDenomNSFW, denomID, types.AttributeKeyNSFW, nsfw, reason)
+	return nil
+}
+
+// SetDenomVisibility hides a denom from denom listings or shows it again
+func (k Keeper) SetDenomVisibility(ctx sdk.Context, denomID string, hidden bool, reason string) error {
+	status, err := k.moderationStatusOf(ctx, denomID)
+	if err != nil {
+		return err
+	}
+	status.Hidden = hidden
+	status.HiddenReason = ""
+	if hidden {
+		status.HiddenReason = reason
+	}
+	k.SetModerationStatus(ctx, status)
+	k.emitModerationEvent(ctx, types.EventTypeSetDenomVisibility, denomID, types.AttributeKeyHidden, hidden, reason)
+	return nil
+}
+
+// moderationStatusOf returns the current moderation of an existing denom,
+// updated at the current block time
+func (k Keeper) moderationStatusOf(ctx sdk.Context, denomID string) (types.ModerationStatus, error) {
+	if !k.HasDenomID(ctx, denomID) {
+		return types.ModerationStatus{}, errorsmod.Wrapf(types.ErrInvalidDenom, "denomID %s not exists", denomID)
+	}
+	status, found := k.GetModerationStatus(ctx, denomID)
+	if !found {
+		status.DenomId = denomID
+	}
+	status.UpdatedAt = ctx.BlockTime()
+	return status, nil
+}
+
+// IsDenomFrozen returns true if governance froze the denom
+func (k Keeper) IsDenomFrozen(ctx sdk.Context, denomID string) bool {
+	status, _ := k.GetModerationStatus(ctx, denomID)
+	return status.Frozen
+}
+
+// IsDenomNSFW returns true if governance marked the denom NSFW
+func (k Keeper) IsDenomNSFW(ctx sdk.Context, denomID string) bool {
+	status, _ := k.GetModerationStatus(ctx, denomID)
+	return status.Nsfw
+}
+
+// IsDenomHidden returns true if governance hid the denom from listings
+func (k Keeper) IsDenomHidden(ctx sdk.Context, denomID string) bool {
+	status, _ := k.GetModerationStatus(ctx, denomID)
+	return status.Hidden
+}
+
+func (k Keeper) GetModerationStatus(ctx sdk.Context, denomID string) (status types.ModerationStatus, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyModerationStatus(denomID))
+	if bz == nil {
+		return status, false
+	}
+	k.cdc.MustUnmarshal(bz, &status)
+	return status, true
+}
+
+func (k Keeper) GetModerationStatuses(ctx sdk.Context) (statuses []types.ModerationStatus) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyModerationStatus(""))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var status types.ModerationStatus
+		k.cdc.MustUnmarshal(iterator.Value(), &status)
+		statuses = append(statuses, status)
+	}
+	return statuses
+}
+
+// SetModerationStatus stores the moderation of a denom, and deletes it once
+// no moderation applies
+func (k Keeper) SetModerationStatus(ctx sdk.Context, status types.ModerationStatus) {
+	store := ctx.KVStore(k.storeKey)
+	if !status.IsModerated() {
+		store.Delete(types.KeyModerationStatus(status.DenomId))
+		return
+	}
+	store.Set(types.KeyModerationStatus(status.DenomId), k.cdc.MustMarshal(&status))
+}
+
+// moderationStatusPtr returns the moderation of a denom for query responses,
+// nil when the denom is not moderated
+func (k Keeper) moderationStatusPtr(ctx sdk.Context, denomID string) *types.ModerationStatus {
+	status, found := k.GetModerationStatus(ctx, denomID)
+	if !found {
+		return nil
+	}
+	return &status
+}
@@ -0,0 +1,72 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/OmniFlix/onft/types"
+)
+
+func (s *KeeperTestSuite) TestFreezeDenom() {
+	s.createDenom(denomID, s.creator)
+	s.mint(denomID, onftID, s.creator, s.alice)
+
+	_, err := s.msgServer.FreezeDenom(s.ctx, types.NewMsgFreezeDenom(s.creator.String(), denomID, true, "spam"))
+	s.Require().ErrorIs(err, govtypes.ErrInvalidSigner)
+	_, err = s.msgServer.FreezeDenom(s.ctx, types.NewMsgFreezeDenom(s.keeper.GetAuthority(), denomID, true, "spam"))
+	s.Require().NoError(err)
+	s.Require().True(s.keeper.IsDenomFrozen(s.ctx, denomID))
+
+	s.Require().ErrorIs(s.keeper.TransferOwnership(s.ctx, denomID, onftID, s.alice, s.bob), types.ErrDenomFrozen)
+	s.Require().ErrorIs(s.keeper.MintONFT(
+		s.ctx, denomID, onftID2, types.Metadata{Name: "name"}, "{}",
+		true, true, false, sdk.ZeroDec(), s.creator, s.alice,
+	), types.ErrDenomFrozen)
+
+	s.Require().NoError(s.keeper.FreezeDenom(s.ctx, denomID, false, ""))
+	s.Require().NoError(s.keeper.TransferOwnership(s.ctx, denomID, onftID, s.alice, s.bob))
+}
+
+func (s *KeeperTestSuite) TestFrozenNestedONFTBlocksParentTransfer() {
+	s.nest()
+	s.Require().NoError(s.keeper.FreezeDenom(s.ctx, denomID2, true, "spam"))
+
+	s.Require().ErrorIs(s.keeper.TransferOwnership(s.ctx, denomID, onftID, s.alice, s.bob), types.ErrDenomFrozen)
+	s.Require().Equal(s.alice, s.owner(denomID, onftID))
+	s.Require().Equal(s.alice, s.owner(denomID2, onftID2))
+
+	// a grandchild of a frozen denom blocks the transfer of the root
+	s.Require().NoError(s.keeper.FreezeDenom(s.ctx, denomID2, false, ""))
+	s.createDenom("onftdenomtest3", s.creator)
+	s.mint("onftdenomtest3", "onftleaf", s.creator, s.alice)
+	s.Require().NoError(s.keeper.NestONFT(s.ctx, types.ONFTRef{DenomId: "onftdenomtest3", OnftId: "onftleaf"}, childRef, s.alice))
+	s.Require().NoError(s.keeper.FreezeDenom(s.ctx, "onftdenomtest3", true, "spam"))
+	s.Require().ErrorIs(s.keeper.TransferOwnership(s.ctx, denomID, onftID, s.alice, s.bob), types.ErrDenomFrozen)
+
+	s.Require().NoError(s.keeper.FreezeDenom(s.ctx, "onftdenomtest3", false, ""))
+	s.Require().NoError(s.keeper.TransferOwnership(s.ctx, denomID, onftID, s.alice, s.bob))
+	s.Require().Equal(s.bob, s.owner("onftdenomtest3", "onftleaf"))
+}
+
+func (s *KeeperTestSuite) TestSetDenomNSFWAndVisibility() {
+	s.createDenom(denomID, s.creator)
+	s.mint(denomID, onftID, s.creator, s.alice)
+
+	s.Require().NoError(s.keeper.SetDenomNSFW(s.ctx, denomID, true, "adult"))
+	onft, err := s.keeper.GetONFT(s.ctx, denomID, onftID)
+	s.Require().NoError(err)
+	s.Require().True(onft.IsNSFW())
+	s.mint(denomID, onftID2, s.creator, s.alice)
+	onft, err = s.keeper.GetONFT(s.ctx, denomID, onftID2)
+	s.Require().NoError(err)
+	s.Require().True(onft.IsNSFW())
+
+	s.Require().NoError(s.keeper.SetDenomVisibility(s.ctx, denomID, true, "scam"))
+	s.Require().True(s.keeper.IsDenomHidden(s.ctx, denomID))
+	status, found := s.keeper.GetModerationStatus(s.ctx, denomID)
+	s.Require().True(found)
+	s.Require().Equal("scam", status.HiddenReason)
+	s.Require().Equal("adult", status.NsfwReason)
+
+	s.Require().ErrorIs(s.keeper.FreezeDenom(s.ctx, "missing", true, ""), types.ErrInvalidDenom)
+}
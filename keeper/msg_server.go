@@ -8,6 +8,7 @@ import (
 	errorsmod "cosmossdk.io/errors"
 	"github.com/OmniFlix/onft/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 type msgServer struct {
@@ -27,6 +28,20 @@ func (m msgServer) UpdateParams(goCtx context.Context, req *types.MsgUpdateParam
 		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", m.authority, req.Authority)
 	}
 
+	// fees sent to a blocked address would fail every mint, transfer and sale
+	for _, receiver := range []string{req.Params.GetFeeDistribution().FeeCollectorAddress, req.Params.PlatformFeeReceiver} {
+		if len(receiver) == 0 {
+			continue
+		}
+		addr, err := sdk.AccAddressFromBech32(receiver)
+		if err != nil {
+			return nil, err
+		}
+		if m.bankKeeper.BlockedAddr(addr) {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive fees", receiver)
+		}
+	}
+
 	ctx := sdk.UnwrapSDKContext(goCtx)
 	if err := m.SetParams(ctx, req.Params); err != nil {
 		return nil, err
@@ -42,12 +57,11 @@ func (m msgServer) CreateDenom(goCtx context.Context, msg *types.MsgCreateDenom)
 	}
 
 	ctx := sdk.UnwrapSDKContext(goCtx)
-	denomCreationFee := m.Keeper.GetDenomCreationFee(ctx)
-	if !msg.CreationFee.Equal(denomCreationFee) {
-		if msg.CreationFee.Denom != denomCreationFee.Denom {
-			return nil, errorsmod.Wrapf(types.ErrInvalidFeeDenom, "invalid creation fee denom %s",
-				msg.CreationFee.Denom)
-		}
+	denomCreationFees := m.Keeper.GetDenomCreationFees(ctx)
+	if found, denomCreationFee := denomCreationFees.Find(msg.CreationFee.Denom); !found {
+		return nil, errorsmod.Wrapf(types.ErrInvalidFeeDenom, "invalid creation fee denom %s, accepted fees are %s",
+			msg.CreationFee.Denom, denomCreationFees)
+	} else if !msg.CreationFee.Equal(denomCreationFee) {
 		if msg.CreationFee.Amount.LT(denomCreationFee.Amount) {
 			return nil, errorsmod.Wrapf(
 				types.ErrNotEnoughFeeAmount,
@@ -75,6 +89,43 @@ func (m msgServer) CreateDenom(goCtx context.Context, msg *types.MsgCreateDenom)
 	); err != nil {
 		return nil, err
 	}
+	if len(msg.RoyaltyReceivers) > 0 {
+		if err := m.Keeper.SetRoyaltyReceivers(ctx, msg.Id, msg.RoyaltyReceivers, sender); err != nil {
+			return nil, err
+		}
+	}
+	if len(msg.MetadataRoot) > 0 {
+		if err := m.Keeper.CommitDenomMetadata(ctx, msg.Id, msg.MetadataRoot, sender); err != nil {
+			return nil, err
+		}
+	}
+	if msg.Credential {
+		if err := m.Keeper.EnableCredentialMode(ctx, msg.Id, sender); err != nil {
+			return nil, err
+		}
+	}
+	if len(msg.Redeemer) > 0 {
+		redeemer, err := sdk.AccAddressFromBech32(msg.Redeemer)
+		if err != nil {
+			return nil, err
+		}
+		if err := m.Keeper.SetDenomRedeemer(ctx, msg.Id, redeemer, sender); err != nil {
+			return nil, err
+		}
+	}
+	if msg.Subscription != nil {
+		if err := m.Keeper.SetDenomSubscription(ctx, msg.Id, *msg.Subscription, sender); err != nil {
+			return nil, err
+		}
+	}
+	if msg.ClawbackEnabled {
+		if err := m.Keeper.EnableClawback(ctx, msg.Id, sender); err != nil {
+			return nil, err
+		}
+	}
+	if err := m.Keeper.emitTypedCreateDenomEvent(ctx, msg.Id); err != nil {
+		return nil, err
+	}
 
 	return &types.MsgCreateDenomResponse{}, nil
 }
@@ -91,6 +142,28 @@ func (m msgServer) UpdateDenom(goCtx context.Context, msg *types.MsgUpdateDenom)
 	if err != nil {
 		return nil, err
 	}
+	if len(msg.RoyaltyReceivers) > 0 {
+		if err := m.Keeper.SetRoyaltyReceivers(ctx, msg.Id, msg.RoyaltyReceivers, sender); err != nil {
+			return nil, err
+		}
+	}
+	if len(msg.Redeemer) > 0 {
+		redeemer, err := sdk.AccAddressFromBech32(msg.Redeemer)
+		if err != nil {
+			return nil, err
+		}
+		if err := m.Keeper.SetDenomRedeemer(ctx, msg.Id, redeemer, sender); err != nil {
+			return nil, err
+		}
+	}
+	if msg.Subscription != nil {
+		if err := m.Keeper.SetDenomSubscription(ctx, msg.Id, *msg.Subscription, sender); err != nil {
+			return nil, err
+		}
+	}
+	if err := m.Keeper.emitTypedUpdateDenomEvent(ctx, msg.Id, msg.Sender); err != nil {
+		return nil, err
+	}
 
 	return &types.MsgUpdateDenomResponse{}, nil
 }
@@ -127,18 +200,20 @@ func (m msgServer) MintONFT(goCtx context.Context, msg *types.MsgMintONFT) (*typ
 	}
 
 	ctx := sdk.UnwrapSDKContext(goCtx)
-	if err := m.Keeper.MintONFT(ctx,
-		msg.DenomId,
+	onft := types.NewONFT(
 		msg.Id,
 		msg.Metadata,
 		msg.Data,
 		msg.Transferable,
 		msg.Extensible,
+		recipient,
+		ctx.BlockHeader().Time,
 		msg.Nsfw,
 		msg.RoyaltyShare,
-		sender,
-		recipient,
-	); err != nil {
+	)
+	onft.MaxEditions = msg.MaxEditions
+	onft.MaxUses = msg.MaxUses
+	if err := m.Keeper.mintONFT(ctx, msg.DenomId, onft, sender, sender); err != nil {
 		return nil, err
 	}
 
@@ -184,3 +259,790 @@ func (m msgServer) BurnONFT(goCtx context.Context,
 
 	return &types.MsgBurnONFTResponse{}, nil
 }
+
+func (m msgServer) PrintEdition(goCtx context.Context,
+	msg *types.MsgPrintEdition,
+) (*types.MsgPrintEditionResponse, error) {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	recipient, err := sdk.AccAddressFromBech32(msg.Recipient)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	editionNumber, err := m.Keeper.PrintEdition(ctx, msg.DenomId, msg.MasterId, msg.Id, sender, recipient)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgPrintEditionResponse{EditionNumber: editionNumber}, nil
+}
+
+func (m msgServer) CreateClaim(goCtx context.Context,
+	msg *types.MsgCreateClaim,
+) (*types.MsgCreateClaimResponse, error) {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	id, err := m.Keeper.CreateClaim(ctx, msg.DenomId, msg.OnftId, msg.Template, msg.Hash, msg.Expiry, msg.MaxClaims, sender)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCreateClaimResponse{Id: id}, nil
+}
+
+func (m msgServer) CommitClaim(goCtx context.Context,
+	msg *types.MsgCommitClaim,
+) (*types.MsgCommitClaimResponse, error) {
+	claimant, err := sdk.AccAddressFromBech32(msg.Claimant)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := m.Keeper.CommitClaim(ctx, msg.ClaimId, msg.Commitment, claimant); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCommitClaimResponse{}, nil
+}
+
+func (m msgServer) Claim(goCtx context.Context,
+	msg *types.MsgClaim,
+) (*types.MsgClaimResponse, error) {
+	claimant, err := sdk.AccAddressFromBech32(msg.Claimant)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	denomID, onftID, err := m.Keeper.ClaimONFT(ctx, msg.ClaimId, msg.Secret, claimant)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgClaimResponse{DenomId: denomID, OnftId: onftID}, nil
+}
+
+func (m msgServer) RefundClaim(goCtx context.Context,
+	msg *types.MsgRefundClaim,
+) (*types.MsgRefundClaimResponse, error) {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := m.Keeper.RefundClaim(ctx, msg.ClaimId, sender); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRefundClaimResponse{}, nil
+}
+
+func (m msgServer) CreateMintAirdrop(goCtx context.Context,
+	msg *types.MsgCreateMintAirdrop,
+) (*types.MsgCreateMintAirdropResponse, error) {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	id, err := m.Keeper.CreateMintAirdrop(ctx, msg.DenomId, msg.MerkleRoot, msg.Template, msg.Expiry, sender)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCreateMintAirdropResponse{Id: id}, nil
+}
+
+func (m msgServer) ClaimAirdrop(goCtx context.Context,
+	msg *types.MsgClaimAirdrop,
+) (*types.MsgClaimAirdropResponse, error) {
+	claimant, err := sdk.AccAddressFromBech32(msg.Claimant)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	denomID, err := m.Keeper.ClaimAirdrop(ctx, msg.AirdropId, msg.OnftId, msg.Metadata, msg.Proof, claimant)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgClaimAirdropResponse{DenomId: denomID, OnftId: msg.OnftId}, nil
+}
+
+func (m msgServer) CreateSwap(goCtx context.Context,
+	msg *types.MsgCreateSwap,
+) (*types.MsgCreateSwapResponse, error) {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	id, err := m.Keeper.CreateSwap(ctx,
+		msg.OfferedOnfts, msg.OfferedCoins,
+		msg.RequestedOnfts, msg.RequestedCoins,
+		msg.Counterparty, msg.Expiry,
+		sender,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCreateSwapResponse{Id: id}, nil
+}
+
+func (m msgServer) AcceptSwap(goCtx context.Context,
+	msg *types.MsgAcceptSwap,
+) (*types.MsgAcceptSwapResponse, error) {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := m.Keeper.AcceptSwap(ctx, msg.Id, sender); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgAcceptSwapResponse{}, nil
+}
+
+func (m msgServer) CancelSwap(goCtx context.Context,
+	msg *types.MsgCancelSwap,
+) (*types.MsgCancelSwapResponse, error) {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := m.Keeper.CancelSwap(ctx, msg.Id, sender); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCancelSwapResponse{}, nil
+}
+
+func (m msgServer) ListONFT(goCtx context.Context,
+	msg *types.MsgListONFT,
+) (*types.MsgListONFTResponse, error) {
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	id, err := m.Keeper.ListONFT(ctx, msg.DenomId, msg.OnftId, msg.Price, msg.Expiry, owner)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgListONFTResponse{Id: id}, nil
+}
+
+func (m msgServer) BuyONFT(goCtx context.Context,
+	msg *types.MsgBuyONFT,
+) (*types.MsgBuyONFTResponse, error) {
+	buyer, err := sdk.AccAddressFromBech32(msg.Buyer)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := m.Keeper.BuyONFT(ctx, msg.ListingId, buyer); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgBuyONFTResponse{}, nil
+}
+
+func (m msgServer) DelistONFT(goCtx context.Context,
+	msg *types.MsgDelistONFT,
+) (*types.MsgDelistONFTResponse, error) {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := m.Keeper.DelistONFT(ctx, msg.ListingId, sender); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgDelistONFTResponse{}, nil
+}
+
+func (m msgServer) MakeOffer(goCtx context.Context,
+	msg *types.MsgMakeOffer,
+) (*types.MsgMakeOfferResponse, error) {
+	buyer, err := sdk.AccAddressFromBech32(msg.Buyer)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	id, err := m.Keeper.MakeOffer(ctx, msg.DenomId, msg.OnftId, msg.Amount, msg.Expiry, buyer)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgMakeOfferResponse{Id: id}, nil
+}
+
+func (m msgServer) AcceptOffer(goCtx context.Context,
+	msg *types.MsgAcceptOffer,
+) (*types.MsgAcceptOfferResponse, error) {
+	seller, err := sdk.AccAddressFromBech32(msg.Seller)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := m.Keeper.AcceptOffer(ctx, msg.OfferId, msg.OnftId, seller); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgAcceptOfferResponse{}, nil
+}
+
+func (m msgServer) CancelOffer(goCtx context.Context,
+	msg *types.MsgCancelOffer,
+) (*types.MsgCancelOfferResponse, error) {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := m.Keeper.CancelOffer(ctx, msg.OfferId, sender); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCancelOfferResponse{}, nil
+}
+
+func (m msgServer) CreateAuction(goCtx context.Context,
+	msg *types.MsgCreateAuction,
+) (*types.MsgCreateAuctionResponse, error) {
+	seller, err := sdk.AccAddressFromBech32(msg.Seller)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	id, err := m.Keeper.CreateAuction(ctx, msg.ToAuction(), seller)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCreateAuctionResponse{Id: id}, nil
+}
+
+func (m msgServer) PlaceBid(goCtx context.Context,
+	msg *types.MsgPlaceBid,
+) (*types.MsgPlaceBidResponse, error) {
+	bidder, err := sdk.AccAddressFromBech32(msg.Bidder)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := m.Keeper.PlaceBid(ctx, msg.AuctionId, msg.Amount, bidder); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgPlaceBidResponse{}, nil
+}
+
+func (m msgServer) CancelAuction(goCtx context.Context,
+	msg *types.MsgCancelAuction,
+) (*types.MsgCancelAuctionResponse, error) {
+	seller, err := sdk.AccAddressFromBech32(msg.Seller)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := m.Keeper.CancelAuction(ctx, msg.AuctionId, seller); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCancelAuctionResponse{}, nil
+}
+
+func (m msgServer) RequestLoan(goCtx context.Context,
+	msg *types.MsgRequestLoan,
+) (*types.MsgRequestLoanResponse, error) {
+	borrower, err := sdk.AccAddressFromBech32(msg.Borrower)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	id, err := m.Keeper.RequestLoan(ctx, msg.DenomId, msg.OnftId, msg.Principal, msg.Interest, msg.Duration, borrower)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRequestLoanResponse{Id: id}, nil
+}
+
+func (m msgServer) FundLoan(goCtx context.Context,
+	msg *types.MsgFundLoan,
+) (*types.MsgFundLoanResponse, error) {
+	lender, err := sdk.AccAddressFromBech32(msg.Lender)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := m.Keeper.FundLoan(ctx, msg.LoanId, lender); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgFundLoanResponse{}, nil
+}
+
+func (m msgServer) RepayLoan(goCtx context.Context,
+	msg *types.MsgRepayLoan,
+) (*types.MsgRepayLoanResponse, error) {
+	borrower, err := sdk.AccAddressFromBech32(msg.Borrower)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := m.Keeper.RepayLoan(ctx, msg.LoanId, borrower); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRepayLoanResponse{}, nil
+}
+
+func (m msgServer) ClaimDefault(goCtx context.Context,
+	msg *types.MsgClaimDefault,
+) (*types.MsgClaimDefaultResponse, error) {
+	lender, err := sdk.AccAddressFromBech32(msg.Lender)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := m.Keeper.ClaimDefault(ctx, msg.LoanId, lender); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgClaimDefaultResponse{}, nil
+}
+
+func (m msgServer) CancelLoan(goCtx context.Context,
+	msg *types.MsgCancelLoan,
+) (*types.MsgCancelLoanResponse, error) {
+	borrower, err := sdk.AccAddressFromBech32(msg.Borrower)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := m.Keeper.CancelLoan(ctx, msg.LoanId, borrower); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCancelLoanResponse{}, nil
+}
+
+func (m msgServer) Fractionalize(goCtx context.Context,
+	msg *types.MsgFractionalize,
+) (*types.MsgFractionalizeResponse, error) {
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	sharesDenom, err := m.Keeper.Fractionalize(ctx,
+		msg.DenomId, msg.OnftId,
+		msg.Shares, msg.BuyoutThreshold, msg.BuyoutPrice,
+		owner,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgFractionalizeResponse{SharesDenom: sharesDenom}, nil
+}
+
+func (m msgServer) Redeem(goCtx context.Context,
+	msg *types.MsgRedeem,
+) (*types.MsgRedeemResponse, error) {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := m.Keeper.Redeem(ctx, msg.DenomId, msg.OnftId, sender); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRedeemResponse{}, nil
+}
+
+func (m msgServer) Buyout(goCtx context.Context,
+	msg *types.MsgBuyout,
+) (*types.MsgBuyoutResponse, error) {
+	buyer, err := sdk.AccAddressFromBech32(msg.Buyer)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := m.Keeper.Buyout(ctx, msg.DenomId, msg.OnftId, buyer); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgBuyoutResponse{}, nil
+}
+
+func (m msgServer) NestONFT(goCtx context.Context,
+	msg *types.MsgNestONFT,
+) (*types.MsgNestONFTResponse, error) {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := m.Keeper.NestONFT(ctx,
+		types.NewONFTRef(msg.DenomId, msg.OnftId),
+		types.NewONFTRef(msg.ParentDenomId, msg.ParentOnftId),
+		sender,
+	); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgNestONFTResponse{}, nil
+}
+
+func (m msgServer) UnnestONFT(goCtx context.Context,
+	msg *types.MsgUnnestONFT,
+) (*types.MsgUnnestONFTResponse, error) {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := m.Keeper.UnnestONFT(ctx, types.NewONFTRef(msg.DenomId, msg.OnftId), sender); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUnnestONFTResponse{}, nil
+}
+
+func (m msgServer) CreateTokenAccount(goCtx context.Context,
+	msg *types.MsgCreateTokenAccount,
+) (*types.MsgCreateTokenAccountResponse, error) {
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	account, err := m.Keeper.CreateTokenAccount(ctx, msg.DenomId, msg.OnftId, owner)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCreateTokenAccountResponse{Address: account.String()}, nil
+}
+
+func (m msgServer) ExecuteFromTokenAccount(goCtx context.Context,
+	msg *types.MsgExecuteFromTokenAccount,
+) (*types.MsgExecuteFromTokenAccountResponse, error) {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+	msgs, err := msg.GetMessages()
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := m.Keeper.ExecuteFromTokenAccount(ctx, msg.DenomId, msg.OnftId, sender, msgs); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgExecuteFromTokenAccountResponse{}, nil
+}
+
+func (m msgServer) CreateRecipe(goCtx context.Context,
+	msg *types.MsgCreateRecipe,
+) (*types.MsgCreateRecipeResponse, error) {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	recipeID, err := m.Keeper.CreateRecipe(ctx, msg.OutputDenomId, msg.OutputTemplate, msg.Inputs, msg.Cost, creator)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCreateRecipeResponse{Id: recipeID}, nil
+}
+
+func (m msgServer) ExecuteRecipe(goCtx context.Context,
+	msg *types.MsgExecuteRecipe,
+) (*types.MsgExecuteRecipeResponse, error) {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	onftID, err := m.Keeper.ExecuteRecipe(ctx, msg.RecipeId, msg.InputOnfts, sender)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgExecuteRecipeResponse{OnftId: onftID}, nil
+}
+
+func (m msgServer) DeleteRecipe(goCtx context.Context,
+	msg *types.MsgDeleteRecipe,
+) (*types.MsgDeleteRecipeResponse, error) {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := m.Keeper.DeleteRecipe(ctx, msg.RecipeId, creator); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgDeleteRecipeResponse{}, nil
+}
+
+func (m msgServer) RevealONFT(goCtx context.Context,
+	msg *types.MsgRevealONFT,
+) (*types.MsgRevealONFTResponse, error) {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := m.Keeper.RevealONFT(ctx, msg.DenomId, msg.ONFT, sender); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRevealONFTResponse{}, nil
+}
+
+func (m msgServer) RevealDenom(goCtx context.Context,
+	msg *types.MsgRevealDenom,
+) (*types.MsgRevealDenomResponse, error) {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := m.Keeper.RevealDenom(ctx, msg.DenomId, msg.ONFTs, sender); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRevealDenomResponse{}, nil
+}
+
+func (m msgServer) RevokeONFT(goCtx context.Context,
+	msg *types.MsgRevokeONFT,
+) (*types.MsgRevokeONFTResponse, error) {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := m.Keeper.RevokeONFT(ctx, msg.DenomId, msg.Id, msg.Reason, sender); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRevokeONFTResponse{}, nil
+}
+
+func (m msgServer) RedeemONFT(goCtx context.Context,
+	msg *types.MsgRedeemONFT,
+) (*types.MsgRedeemONFTResponse, error) {
+	redeemer, err := sdk.AccAddressFromBech32(msg.Redeemer)
+	if err != nil {
+		return nil, err
+	}
+	var owner sdk.AccAddress
+	if len(msg.Owner) > 0 {
+		owner, err = sdk.AccAddressFromBech32(msg.Owner)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	uses, err := m.Keeper.RedeemONFT(ctx, msg.DenomId, msg.Id, redeemer, owner)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRedeemONFTResponse{Uses: uses}, nil
+}
+
+func (m msgServer) RenewONFT(goCtx context.Context,
+	msg *types.MsgRenewONFT,
+) (*types.MsgRenewONFTResponse, error) {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	expiresAt, err := m.Keeper.RenewONFT(ctx, msg.DenomId, msg.Id, msg.Periods, sender)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRenewONFTResponse{ExpiresAt: expiresAt}, nil
+}
+
+func (m msgServer) FreezeDenom(goCtx context.Context,
+	msg *types.MsgFreezeDenom,
+) (*types.MsgFreezeDenomResponse, error) {
+	if m.authority != msg.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", m.authority, msg.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := m.Keeper.FreezeDenom(ctx, msg.DenomId, msg.Frozen, msg.Reason); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgFreezeDenomResponse{}, nil
+}
+
+func (m msgServer) SetDenomNSFW(goCtx context.Context,
+	msg *types.MsgSetDenomNSFW,
+) (*types.MsgSetDenomNSFWResponse, error) {
+	if m.authority != msg.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", m.authority, msg.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := m.Keeper.SetDenomNSFW(ctx, msg.DenomId, msg.Nsfw, msg.Reason); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetDenomNSFWResponse{}, nil
+}
+
+func (m msgServer) SetDenomVisibility(goCtx context.Context,
+	msg *types.MsgSetDenomVisibility,
+) (*types.MsgSetDenomVisibilityResponse, error) {
+	if m.authority != msg.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", m.authority, msg.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := m.Keeper.SetDenomVisibility(ctx, msg.DenomId, msg.Hidden, msg.Reason); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetDenomVisibilityResponse{}, nil
+}
+
+func (m msgServer) PauseDenom(goCtx context.Context,
+	msg *types.MsgPauseDenom,
+) (*types.MsgPauseDenomResponse, error) {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := m.Keeper.PauseDenom(ctx, msg.DenomId, msg.Pause, sender); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgPauseDenomResponse{}, nil
+}
+
+func (m msgServer) UnpauseDenom(goCtx context.Context,
+	msg *types.MsgUnpauseDenom,
+) (*types.MsgUnpauseDenomResponse, error) {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := m.Keeper.UnpauseDenom(ctx, msg.DenomId, msg.Unpause, sender); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUnpauseDenomResponse{}, nil
+}
+
+func (m msgServer) ClawbackONFT(goCtx context.Context,
+	msg *types.MsgClawbackONFT,
+) (*types.MsgClawbackONFTResponse, error) {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+	var recipient sdk.AccAddress
+	if len(msg.Recipient) > 0 {
+		if recipient, err = sdk.AccAddressFromBech32(msg.Recipient); err != nil {
+			return nil, err
+		}
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := m.Keeper.ClawbackONFT(ctx, msg.DenomId, msg.Id, recipient, sender); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgClawbackONFTResponse{}, nil
+}
+
+func (m msgServer) SetFeeExemption(goCtx context.Context,
+	msg *types.MsgSetFeeExemption,
+) (*types.MsgSetFeeExemptionResponse, error) {
+	if m.authority != msg.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", m.authority, msg.Authority)
+	}
+	address, err := sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := m.Keeper.SetFeeExemption(ctx, address, msg.Discount, msg.ExpiresAt); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetFeeExemptionResponse{}, nil
+}
@@ -0,0 +1,183 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/OmniFlix/onft/types"
+)
+
+// NestONFT moves an oNFT of the sender under a parent oNFT in a tree rooted at
+// the sender. The child keeps the sender as owner and moves with its parent.
+func (k Keeper) NestONFT(ctx sdk.Context, child, parent types.ONFTRef, sender sdk.AccAddress) error {
+	maxDepth := k.GetParams(ctx).MaxNestingDepth
+	if maxDepth == 0 {
+		return errorsmod.Wrap(types.ErrInvalidNesting, "nesting is disabled")
+	}
+	childONFT, err := k.Authorize(ctx, child.DenomId, child.OnftId, sender)
+	if err != nil {
+		return err
+	}
+	if k.IsNested(ctx, child.DenomId, child.OnftId) {
+		return errorsmod.Wrapf(types.ErrONFTNested, "onft %s must be unnested first", child)
+	}
+	if !childONFT.IsTransferable() {
+		return errorsmod.Wrap(types.ErrNotTransferable, child.String())
+	}
+	// a nested child moves with its parent
+	if k.IsDenomFrozen(ctx, child.DenomId) {
+		return errorsmod.Wrapf(types.ErrDenomFrozen, "can not nest onft %s of frozen denom", child)
+	}
+	if k.GetDenomPause(ctx, child.DenomId).Transfer {
+		return errorsmod.Wrapf(types.ErrDenomPaused, "can not nest onft %s while transfers are paused on its denom", child)
+	}
+	if _, err := k.Authorize(ctx, parent.DenomId, parent.OnftId, sender); err != nil {
+		return err
+	}
+
+	// walk up from the parent to the root, the child must not be an ancestor
+	var depth uint32
+	for ancestor, ok := parent, true; ok; ancestor, ok = k.GetNestingParent(ctx, ancestor.DenomId, ancestor.OnftId) {
+		if ancestor == child {
+			return errorsmod.Wrapf(types.ErrInvalidNesting, "nesting %s under %s creates a cycle", child, parent)
+		}
+		depth++
+	}
+	if depth+k.nestedHeight(ctx, child) > maxDepth {
+		return errorsmod.Wrapf(types.ErrInvalidNesting, "nesting %s under %s exceeds max nesting depth %d", child, parent, maxDepth)
+	}
+
+	k.SetNesting(ctx, types.NewNesting(child, parent))
+	k.emitNestONFTEvent(ctx, child.DenomId, child.OnftId, parent.String(), sender.String())
+	return nil
+}
+
+// UnnestONFT moves a nested oNFT out of its parent, the root owner keeps it
+func (k Keeper) UnnestONFT(ctx sdk.Context, child types.ONFTRef, sender sdk.AccAddress) error {
+	parent, ok := k.GetNestingParent(ctx, child.DenomId, child.OnftId)
+	if !ok {
+		return errorsmod.Wrapf(types.ErrInvalidNesting, "onft %s is not nested", child)
+	}
+	if _, err := k.Authorize(ctx, child.DenomId, child.OnftId, sender); err != nil {
+		return err
+	}
+
+	k.deleteNesting(ctx, types.NewNesting(child, parent))
+	k.emitUnnestONFTEvent(ctx, child.DenomId, child.OnftId, parent.String(), sender.String())
+	return nil
+}
+
+// validateNestedTransfer checks that every oNFT of the subtree nested under an
+// oNFT can move with it
+func (k Keeper) validateNestedTransfer(ctx sdk.Context, denomID, onftID string) error {
+	for _, child := range k.GetNestedChildren(ctx, denomID, onftID) {
+		if k.IsDenomFrozen(ctx, child.DenomId) {
+			return errorsmod.Wrapf(types.ErrDenomFrozen, "can not transfer nested onft %s of frozen denom", child)
+		}
+		if k.GetDenomPause(ctx, child.DenomId).Transfer {
+			return errorsmod.Wrapf(types.ErrDenomPaused, "can not transfer nested onft %s while transfers are paused on its denom", child)
+		}
+		if err := k.validateNestedTransfer(ctx, child.DenomId, child.OnftId); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// transferNestedONFTs moves the subtree nested under an oNFT to its new owner
+func (k Keeper) transferNestedONFTs(ctx sdk.Context, denomID, onftID string, srcOwner, dstOwner sdk.AccAddress) error {
+	for _, child := range k.GetNestedChildren(ctx, denomID, onftID) {
+		nft, err := k.GetONFT(ctx, child.DenomId, child.OnftId)
+		if err != nil {
+			return err
+		}
+		onft := nft.(types.ONFT)
+		onft.Owner = dstOwner.String()
+		k.setONFT(ctx, child.DenomId, onft)
+		k.swapOwner(ctx, child.DenomId, child.OnftId, srcOwner, dstOwner)
+		if err := k.emitTransferONFTEvent(ctx, child.DenomId, onft, srcOwner.String(), onft.Owner); err != nil {
+			return err
+		}
+		if err := k.transferNestedONFTs(ctx, child.DenomId, child.OnftId, srcOwner, dstOwner); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// nestedHeight returns the number of levels nested under an oNFT
+func (k Keeper) nestedHeight(ctx sdk.Context, ref types.ONFTRef) uint32 {
+	var height uint32
+	for _, child := range k.GetNestedChildren(ctx, ref.DenomId, ref.OnftId) {
+		if h := k.nestedHeight(ctx, child) + 1; h > height {
+			height = h
+		}
+	}
+	return height
+}
+
+// IsNested returns true if the oNFT is nested under a parent oNFT
+func (k Keeper) IsNested(ctx sdk.Context, denomID, onftID string) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.KeyNestedParent(denomID, onftID))
+}
+
+// HasNestedChildren returns true if oNFTs are nested under the oNFT
+func (k Keeper) HasNestedChildren(ctx sdk.Context, denomID, onftID string) bool {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyNestedChild(denomID, onftID, "", ""))
+	defer iterator.Close()
+	return iterator.Valid()
+}
+
+func (k Keeper) GetNestingParent(ctx sdk.Context, denomID, onftID string) (parent types.ONFTRef, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyNestedParent(denomID, onftID))
+	if bz == nil {
+		return parent, false
+	}
+	k.cdc.MustUnmarshal(bz, &parent)
+	return parent, true
+}
+
+func (k Keeper) GetNestedChildren(ctx sdk.Context, denomID, onftID string) (children []types.ONFTRef) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyNestedChild(denomID, onftID, "", ""))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var child types.ONFTRef
+		k.cdc.MustUnmarshal(iterator.Value(), &child)
+		children = append(children, child)
+	}
+	return children
+}
+
+func (k Keeper) GetNestings(ctx sdk.Context) (nestings []types.Nesting) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyNestedParent("", ""))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var parent types.ONFTRef
+		k.cdc.MustUnmarshal(iterator.Value(), &parent)
+		denomID, onftID, _ := types.SplitKeyDenom(iterator.Key()[len(types.KeyNestedParent("", "")):])
+		nestings = append(nestings, types.NewNesting(types.NewONFTRef(denomID, onftID), parent))
+	}
+	return nestings
+}
+
+func (k Keeper) SetNesting(ctx sdk.Context, nesting types.Nesting) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.KeyNestedParent(nesting.Child.DenomId, nesting.Child.OnftId), k.cdc.MustMarshal(&nesting.Parent))
+	store.Set(
+		types.KeyNestedChild(nesting.Parent.DenomId, nesting.Parent.OnftId, nesting.Child.DenomId, nesting.Child.OnftId),
+		k.cdc.MustMarshal(&nesting.Child),
+	)
+}
+
+func (k Keeper) deleteNesting(ctx sdk.Context, nesting types.Nesting) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.KeyNestedParent(nesting.Child.DenomId, nesting.Child.OnftId))
+	store.Delete(types.KeyNestedChild(nesting.Parent.DenomId, nesting.Parent.OnftId, nesting.Child.DenomId, nesting.Child.OnftId))
+}
@@ -0,0 +1,81 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/OmniFlix/onft/types"
+)
+
+var (
+	parentRef = types.ONFTRef{DenomId: denomID, OnftId: onftID}
+	childRef  = types.ONFTRef{DenomId: denomID2, OnftId: onftID2}
+)
+
+// nest mints a parent and a child oNFT to alice and nests the child under the parent
+func (s *KeeperTestSuite) nest() {
+	s.createDenom(denomID, s.creator)
+	s.createDenom(denomID2, s.creator)
+	s.mint(denomID, onftID, s.creator, s.alice)
+	s.mint(denomID2, onftID2, s.creator, s.alice)
+	s.Require().NoError(s.keeper.NestONFT(s.ctx, childRef, parentRef, s.alice))
+	s.Require().True(s.keeper.IsNested(s.ctx, denomID2, onftID2))
+}
+
+func (s *KeeperTestSuite) TestNestedONFTMovesWithParent() {
+	s.nest()
+
+	s.Require().ErrorIs(s.keeper.TransferOwnership(s.ctx, denomID2, onftID2, s.alice, s.bob), types.ErrONFTNested)
+	s.Require().ErrorIs(s.keeper.BurnONFT(s.ctx, denomID2, onftID2, s.alice), types.ErrONFTNested)
+	s.Require().ErrorIs(s.keeper.BurnONFT(s.ctx, denomID, onftID, s.alice), types.ErrONFTNested)
+
+	s.Require().NoError(s.keeper.TransferOwnership(s.ctx, denomID, onftID, s.alice, s.bob))
+	s.Require().Equal(s.bob, s.owner(denomID, onftID))
+	s.Require().Equal(s.bob, s.owner(denomID2, onftID2))
+	s.Require().Equal(uint64(1), s.keeper.GetTotalSupplyOfOwner(s.ctx, denomID2, s.bob))
+	s.Require().Equal(uint64(0), s.keeper.GetTotalSupplyOfOwner(s.ctx, denomID2, s.alice))
+
+	s.Require().ErrorIs(s.keeper.UnnestONFT(s.ctx, childRef, s.alice), types.ErrUnauthorized)
+	s.Require().NoError(s.keeper.UnnestONFT(s.ctx, childRef, s.bob))
+	s.Require().False(s.keeper.IsNested(s.ctx, denomID2, onftID2))
+	s.Require().Equal(s.bob, s.owner(denomID2, onftID2))
+}
+
+func (s *KeeperTestSuite) TestNestedONFTMovesWithEscrowedParent() {
+	s.nest()
+	s.fund(s.bob, sdk.NewInt64Coin(feeDenom, 1000))
+
+	listingID, err := s.keeper.ListONFT(s.ctx, denomID, onftID, sdk.NewInt64Coin(feeDenom, 1000), time.Time{}, s.alice)
+	s.Require().NoError(err)
+	s.Require().Equal(s.keeper.GetModuleAddress(), s.owner(denomID2, onftID2))
+	s.Require().NoError(s.keeper.BuyONFT(s.ctx, listingID, s.bob))
+	s.Require().Equal(s.bob, s.owner(denomID2, onftID2))
+}
+
+func (s *KeeperTestSuite) TestNestONFTCycleAndDepth() {
+	s.nest()
+	s.Require().ErrorIs(s.keeper.NestONFT(s.ctx, parentRef, childRef, s.alice), types.ErrInvalidNesting)
+	s.Require().ErrorIs(s.keeper.NestONFT(s.ctx, childRef, parentRef, s.alice), types.ErrONFTNested)
+
+	params := s.keeper.GetParams(s.ctx)
+	params.MaxNestingDepth = 1
+	s.Require().NoError(s.keeper.SetParams(s.ctx, params))
+	s.mint(denomID, "onftleaf", s.creator, s.alice)
+	leaf := types.ONFTRef{DenomId: denomID, OnftId: "onftleaf"}
+	s.Require().ErrorIs(s.keeper.NestONFT(s.ctx, leaf, childRef, s.alice), types.ErrInvalidNesting)
+
+	params.MaxNestingDepth = 0
+	s.Require().NoError(s.keeper.SetParams(s.ctx, params))
+	s.Require().ErrorIs(s.keeper.NestONFT(s.ctx, leaf, parentRef, s.alice), types.ErrInvalidNesting)
+}
+
+func (s *KeeperTestSuite) TestNestONFTRequiresOwnership() {
+	s.createDenom(denomID, s.creator)
+	s.createDenom(denomID2, s.creator)
+	s.mint(denomID, onftID, s.creator, s.bob)
+	s.mint(denomID2, onftID2, s.creator, s.alice)
+
+	s.Require().ErrorIs(s.keeper.NestONFT(s.ctx, childRef, parentRef, s.alice), types.ErrUnauthorized)
+	s.Require().False(s.keeper.IsNested(s.ctx, denomID2, onftID2))
+}
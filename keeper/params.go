@@ -28,8 +28,9 @@ func (k Keeper) SetParams(ctx sdk.Context, params types.Params) error {
 	return nil
 }
 
-// GetDenomCreationFee returns the current denom creation fee coins list and amounts.
-func (k Keeper) GetDenomCreationFee(ctx sdk.Context) sdk.Coin {
+// GetDenomCreationFees returns the accepted denom creation fees, any one of
+// them pays for a denom.
+func (k Keeper) GetDenomCreationFees(ctx sdk.Context) sdk.Coins {
 	params := k.GetParams(ctx)
-	return params.DenomCreationFee
+	return params.GetDenomCreationFees()
 }
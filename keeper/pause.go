@@ -0,0 +1,41 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/OmniFlix/onft/types"
+)
+
+// PauseDenom pauses the selected actions on a denom of the creator. Actions
+// paused before stay paused.
+func (k Keeper) PauseDenom(ctx sdk.Context, denomID string, pause types.DenomPause, sender sdk.AccAddress) error {
+	denom, err := k.AuthorizeDenomCreator(ctx, denomID, sender)
+	if err != nil {
+		return err
+	}
+	denom.Paused = denom.Paused.Add(pause)
+	k.SetDenom(ctx, denom)
+	k.emitPauseEvent(ctx, types.EventTypePauseDenom, denomID, sender.String(), pause.Actions(), denom.Paused.Actions())
+	return nil
+}
+
+// UnpauseDenom resumes the selected actions on a denom of the creator
+func (k Keeper) UnpauseDenom(ctx sdk.Context, denomID string, unpause types.DenomPause, sender sdk.AccAddress) error {
+	denom, err := k.AuthorizeDenomCreator(ctx, denomID, sender)
+	if err != nil {
+		return err
+	}
+	denom.Paused = denom.Paused.Remove(unpause)
+	k.SetDenom(ctx, denom)
+	k.emitPauseEvent(ctx, types.EventTypeUnpauseDenom, denomID, sender.String(), unpause.Actions(), denom.Paused.Actions())
+	return nil
+}
+
+// GetDenomPause returns the actions paused on the denom
+func (k Keeper) GetDenomPause(ctx sdk.Context, denomID string) types.DenomPause {
+	denom, err := k.GetDenom(ctx, denomID)
+	if err != nil {
+		return types.DenomPause{}
+	}
+	return denom.Paused
+}
@@ -0,0 +1,45 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/OmniFlix/onft/types"
+)
+
+func (s *KeeperTestSuite) TestPauseDenom() {
+	s.createDenom(denomID, s.creator)
+	s.mint(denomID, onftID, s.creator, s.alice)
+
+	s.Require().ErrorIs(s.keeper.PauseDenom(s.ctx, denomID, types.DenomPause{Mint: true}, s.alice), types.ErrUnauthorized)
+	s.Require().NoError(s.keeper.PauseDenom(s.ctx, denomID, types.DenomPause{Mint: true}, s.creator))
+	s.Require().NoError(s.keeper.PauseDenom(s.ctx, denomID, types.DenomPause{Transfer: true, Edit: true}, s.creator))
+	s.Require().Equal(types.DenomPause{Mint: true, Transfer: true, Edit: true}, s.keeper.GetDenomPause(s.ctx, denomID))
+
+	s.Require().ErrorIs(s.keeper.MintONFT(
+		s.ctx, denomID, onftID2, types.Metadata{Name: "name"}, "{}",
+		true, true, false, sdk.ZeroDec(), s.creator, s.alice,
+	), types.ErrDenomPaused)
+	s.Require().ErrorIs(s.keeper.TransferOwnership(s.ctx, denomID, onftID, s.alice, s.bob), types.ErrDenomPaused)
+	s.Require().ErrorIs(s.keeper.EditONFT(s.ctx, denomID, onftID, s.alice), types.ErrDenomPaused)
+
+	s.Require().NoError(s.keeper.UnpauseDenom(s.ctx, denomID, types.DenomPause{Transfer: true}, s.creator))
+	s.Require().Equal(types.DenomPause{Mint: true, Edit: true}, s.keeper.GetDenomPause(s.ctx, denomID))
+	s.Require().NoError(s.keeper.TransferOwnership(s.ctx, denomID, onftID, s.alice, s.bob))
+}
+
+func (s *KeeperTestSuite) TestPausedNestedONFTBlocksParentTransfer() {
+	s.nest()
+	s.Require().NoError(s.keeper.PauseDenom(s.ctx, denomID2, types.DenomPause{Transfer: true}, s.creator))
+
+	s.Require().ErrorIs(s.keeper.TransferOwnership(s.ctx, denomID, onftID, s.alice, s.bob), types.ErrDenomPaused)
+	s.Require().Equal(s.alice, s.owner(denomID2, onftID2))
+
+	// a paused child can not be nested either
+	s.mint(denomID2, "onftchild", s.creator, s.alice)
+	child := types.ONFTRef{DenomId: denomID2, OnftId: "onftchild"}
+	s.Require().ErrorIs(s.keeper.NestONFT(s.ctx, child, parentRef, s.alice), types.ErrDenomPaused)
+
+	s.Require().NoError(s.keeper.UnpauseDenom(s.ctx, denomID2, types.DenomPause{Transfer: true}, s.creator))
+	s.Require().NoError(s.keeper.TransferOwnership(s.ctx, denomID, onftID, s.alice, s.bob))
+	s.Require().Equal(s.bob, s.owner(denomID2, onftID2))
+}
@@ -0,0 +1,168 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/OmniFlix/onft/types"
+)
+
+// CreateRecipe registers a recipe minting oNFTs of the output denom. Only the
+// creator of the output denom can register recipes for it.
+func (k Keeper) CreateRecipe(
+	ctx sdk.Context,
+	outputDenomID string,
+	outputTemplate types.ONFTTemplate,
+	inputs []types.RecipeInput,
+	cost sdk.Coins,
+	creator sdk.AccAddress,
+) (uint64, error) {
+	if !k.HasDenomID(ctx, outputDenomID) {
+		return 0, errorsmod.Wrapf(types.ErrInvalidDenom, "denomID %s not exists", outputDenomID)
+	}
+	if !k.HasPermissionToMint(ctx, outputDenomID, creator) {
+		return 0, errorsmod.Wrapf(types.ErrUnauthorized, "only creator of denom has permission to mint")
+	}
+	for _, input := range inputs {
+		if !k.HasDenomID(ctx, input.DenomId) {
+			return 0, errorsmod.Wrapf(types.ErrInvalidDenom, "denomID %s not exists", input.DenomId)
+		}
+	}
+
+	recipeID := k.GetNextRecipeID(ctx)
+	k.SetNextRecipeID(ctx, recipeID+1)
+	k.SetRecipe(ctx, types.Recipe{
+		Id:             recipeID,
+		Creator:        creator.String(),
+		OutputDenomId:  outputDenomID,
+		OutputTemplate: outputTemplate,
+		Inputs:         inputs,
+		Cost:           cost,
+	})
+	k.emitCreateRecipeEvent(ctx, recipeID, outputDenomID, creator.String())
+	return recipeID, nil
+}
+
+// ExecuteRecipe burns the input oNFTs of the sender, pays the recipe cost to
+// the recipe creator and mints the output oNFT to the sender. Input oNFTs
+// are matched in the order of the recipe inputs.
+func (k Keeper) ExecuteRecipe(
+	ctx sdk.Context,
+	recipeID uint64,
+	inputONFTs []types.ONFTRef,
+	sender sdk.AccAddress,
+) (string, error) {
+	recipe, err := k.GetRecipe(ctx, recipeID)
+	if err != nil {
+		return "", err
+	}
+	if len(inputONFTs) != recipe.InputONFTCount() {
+		return "", errorsmod.Wrapf(types.ErrInvalidRecipe,
+			"recipe %d requires %d input onfts, got %d", recipeID, recipe.InputONFTCount(), len(inputONFTs))
+	}
+	n := 0
+	for _, input := range recipe.Inputs {
+		for i := uint32(0); i < input.Count; i++ {
+			ref := inputONFTs[n]
+			onft, err := k.Authorize(ctx, ref.DenomId, ref.OnftId, sender)
+			if err != nil {
+				return "", err
+			}
+			if !input.Matches(ref.DenomId, onft) {
+				return "", errorsmod.Wrapf(types.ErrInvalidRecipe, "onft %s does not match input %s of recipe %d", ref, input.DenomId, recipeID)
+			}
+			n++
+		}
+	}
+
+	creator, err := sdk.AccAddressFromBech32(recipe.Creator)
+	if err != nil {
+		return "", err
+	}
+	if !recipe.Cost.IsZero() {
+		if err := k.bankKeeper.SendCoins(ctx, sender, creator, recipe.Cost); err != nil {
+			return "", err
+		}
+	}
+	for _, ref := range inputONFTs {
+		if err := k.BurnONFT(ctx, ref.DenomId, ref.OnftId, sender); err != nil {
+			return "", err
+		}
+	}
+
+	recipe.Executions++
+	onftID := types.RecipeONFTID(recipeID, recipe.Executions)
+	onft := recipe.OutputTemplate.NewONFT(onftID, sender, ctx.BlockTime())
+	if err := k.mintONFT(ctx, recipe.OutputDenomId, onft, creator, sender); err != nil {
+		return "", err
+	}
+
+	k.SetRecipe(ctx, recipe)
+	k.emitExecuteRecipeEvent(ctx, recipeID, recipe.OutputDenomId, onftID, sender.String())
+	return onftID, nil
+}
+
+// DeleteRecipe deletes a recipe of the creator
+func (k Keeper) DeleteRecipe(ctx sdk.Context, recipeID uint64, creator sdk.AccAddress) error {
+	recipe, err := k.GetRecipe(ctx, recipeID)
+	if err != nil {
+		return err
+	}
+	if creator.String() != recipe.Creator {
+		return errorsmod.Wrap(types.ErrUnauthorized, creator.String())
+	}
+
+	k.deleteRecipe(ctx, recipe)
+	k.emitDeleteRecipeEvent(ctx, recipeID, recipe.Creator)
+	return nil
+}
+
+func (k Keeper) GetNextRecipeID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.NextRecipeIDKey)
+	if len(bz) == 0 {
+		return 1
+	}
+	return types.MustUnMarshalSupply(k.cdc, bz)
+}
+
+func (k Keeper) SetNextRecipeID(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.NextRecipeIDKey, types.MustMarshalSupply(k.cdc, id))
+}
+
+func (k Keeper) GetRecipe(ctx sdk.Context, id uint64) (recipe types.Recipe, err error) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyRecipe(id))
+	if bz == nil {
+		return recipe, errorsmod.Wrapf(types.ErrUnknownRecipe, "recipe %d not found", id)
+	}
+	k.cdc.MustUnmarshal(bz, &recipe)
+	return recipe, nil
+}
+
+func (k Keeper) GetRecipes(ctx sdk.Context) (recipes []types.Recipe) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyRecipe(0))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var recipe types.Recipe
+		k.cdc.MustUnmarshal(iterator.Value(), &recipe)
+		recipes = append(recipes, recipe)
+	}
+	return recipes
+}
+
+func (k Keeper) SetRecipe(ctx sdk.Context, recipe types.Recipe) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&recipe)
+	store.Set(types.KeyRecipe(recipe.Id), bz)
+	store.Set(types.KeyRecipeByDenom(recipe.OutputDenomId, recipe.Id), sdk.Uint64ToBigEndian(recipe.Id))
+}
+
+func (k Keeper) deleteRecipe(ctx sdk.Context, recipe types.Recipe) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.KeyRecipe(recipe.Id))
+	store.Delete(types.KeyRecipeByDenom(recipe.OutputDenomId, recipe.Id))
+}
@@ -0,0 +1,70 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/OmniFlix/onft/types"
+)
+
+func (s *KeeperTestSuite) createRecipe(inputs []types.RecipeInput, cost sdk.Coins) uint64 {
+	s.createDenom(denomID, s.creator)
+	s.createDenom(denomID2, s.creator)
+	template := types.NewONFTTemplate(
+		types.Metadata{Name: "crafted", MediaURI: "https://onft.test/media"}, "{}",
+		true, true, false, sdk.NewDecWithPrec(1, 1),
+	)
+	recipeID, err := s.keeper.CreateRecipe(s.ctx, denomID, template, inputs, cost, s.creator)
+	s.Require().NoError(err)
+	return recipeID
+}
+
+func (s *KeeperTestSuite) TestExecuteRecipe() {
+	recipeID := s.createRecipe(
+		[]types.RecipeInput{{DenomId: denomID2, Count: 2}},
+		sdk.NewCoins(sdk.NewInt64Coin(feeDenom, 10)),
+	)
+	s.mint(denomID2, "onftinput1", s.creator, s.alice)
+	s.mint(denomID2, "onftinput2", s.creator, s.alice)
+	s.mint(denomID2, "onftinput3", s.creator, s.bob)
+	s.fund(s.alice, sdk.NewInt64Coin(feeDenom, 10))
+
+	inputs := []types.ONFTRef{{DenomId: denomID2, OnftId: "onftinput1"}}
+	_, err := s.keeper.ExecuteRecipe(s.ctx, recipeID, inputs, s.alice)
+	s.Require().ErrorIs(err, types.ErrInvalidRecipe)
+	inputs = append(inputs, types.ONFTRef{DenomId: denomID2, OnftId: "onftinput3"})
+	_, err = s.keeper.ExecuteRecipe(s.ctx, recipeID, inputs, s.alice)
+	s.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	inputs[1].OnftId = "onftinput2"
+	onftID, err := s.keeper.ExecuteRecipe(s.ctx, recipeID, inputs, s.alice)
+	s.Require().NoError(err)
+	s.Require().Equal(types.RecipeONFTID(recipeID, 1), onftID)
+	s.Require().Equal(s.alice, s.owner(denomID, onftID))
+	s.Require().False(s.keeper.HasONFT(s.ctx, denomID2, "onftinput1"))
+	s.Require().False(s.keeper.HasONFT(s.ctx, denomID2, "onftinput2"))
+	s.Require().Equal(int64(0), s.balance(s.alice, feeDenom))
+	s.Require().Equal(int64(10), s.balance(s.creator, feeDenom))
+}
+
+func (s *KeeperTestSuite) TestExecuteRecipeTraitFilter() {
+	recipeID := s.createRecipe([]types.RecipeInput{{
+		DenomId: denomID2,
+		Count:   1,
+		Traits:  []types.TraitFilter{{Key: "rarity", Value: "rare"}},
+	}}, nil)
+	s.mint(denomID2, onftID2, s.creator, s.alice)
+
+	inputs := []types.ONFTRef{{DenomId: denomID2, OnftId: onftID2}}
+	_, err := s.keeper.ExecuteRecipe(s.ctx, recipeID, inputs, s.alice)
+	s.Require().ErrorIs(err, types.ErrInvalidRecipe)
+	s.Require().True(s.keeper.HasONFT(s.ctx, denomID2, onftID2))
+}
+
+func (s *KeeperTestSuite) TestDeleteRecipe() {
+	recipeID := s.createRecipe([]types.RecipeInput{{DenomId: denomID2, Count: 1}}, nil)
+
+	s.Require().ErrorIs(s.keeper.DeleteRecipe(s.ctx, recipeID, s.alice), types.ErrUnauthorized)
+	s.Require().NoError(s.keeper.DeleteRecipe(s.ctx, recipeID, s.creator))
+	_, err := s.keeper.ExecuteRecipe(s.ctx, recipeID, nil, s.alice)
+	s.Require().ErrorIs(err, types.ErrUnknownRecipe)
+}
@@ -0,0 +1,196 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/OmniFlix/onft/types"
+)
+
+// CommitDenomMetadata puts a denom without oNFTs in unrevealed mode. oNFTs
+// minted until the denom is revealed hold placeholder metadata, and their
+// final metadata must match the merkle root.
+func (k Keeper) CommitDenomMetadata(ctx sdk.Context, denomID, metadataRoot string, sender sdk.AccAddress) error {
+	denom, err := k.GetDenom(ctx, denomID)
+	if err != nil {
+		return err
+	}
+	if sender.String() != denom.Creator {
+		return errorsmod.Wrapf(types.ErrUnauthorized, "%s is not allowed to commit metadata of denom %s", sender, denomID)
+	}
+	if k.HasMetadataCommitment(ctx, denomID) {
+		return errorsmod.Wrapf(types.ErrInvalidReveal, "denom %s already has a metadata commitment", denomID)
+	}
+	if k.GetTotalSupply(ctx, denomID) > 0 {
+		return errorsmod.Wrapf(types.ErrInvalidReveal, "denom %s already has minted onfts", denomID)
+	}
+
+	k.SetMetadataCommitment(ctx, types.MetadataCommitment{
+		DenomId:      denomID,
+		MetadataRoot: metadataRoot,
+	})
+	return nil
+}
+
+// RevealONFT replaces the placeholder metadata and data of an unrevealed oNFT
+// with the final ones, proven against the metadata root of the denom.
+func (k Keeper) RevealONFT(ctx sdk.Context, denomID string, revealed types.RevealedONFT, sender sdk.AccAddress) error {
+	commitment, err := k.authorizeReveal(ctx, denomID, sender)
+	if err != nil {
+		return err
+	}
+	return k.revealONFT(ctx, commitment, revealed)
+}
+
+// RevealDenom reveals the given oNFTs and ends the unrevealed mode of the
+// denom. It fails if any minted oNFT of the denom is left unrevealed.
+func (k Keeper) RevealDenom(ctx sdk.Context, denomID string, revealed []types.RevealedONFT, sender sdk.AccAddress) error {
+	commitment, err := k.authorizeReveal(ctx, denomID, sender)
+	if err != nil {
+		return err
+	}
+	for _, onft := range revealed {
+		if err := k.revealONFT(ctx, commitment, onft); err != nil {
+			return err
+		}
+	}
+
+	commitment, err = k.GetMetadataCommitment(ctx, denomID)
+	if err != nil {
+		return err
+	}
+	if commitment.Unrevealed > 0 {
+		return errorsmod.Wrapf(types.ErrInvalidReveal, "%d onfts of denom %s are still unrevealed", commitment.Unrevealed, denomID)
+	}
+	commitment.Revealed = true
+	k.SetMetadataCommitment(ctx, commitment)
+	k.emitRevealDenomEvent(ctx, denomID, commitment.MetadataRoot, sender.String())
+	return nil
+}
+
+func (k Keeper) authorizeReveal(ctx sdk.Context, denomID string, sender sdk.AccAddress) (types.MetadataCommitment, error) {
+	commitment, err := k.GetMetadataCommitment(ctx, denomID)
+	if err != nil {
+		return commitment, err
+	}
+	if commitment.Revealed {
+		return commitment, errorsmod.Wrapf(types.ErrInvalidReveal, "denom %s is already revealed", denomID)
+	}
+	denom, err := k.GetDenom(ctx, denomID)
+	if err != nil {
+		return commitment, err
+	}
+	if sender.String() != denom.Creator {
+		return commitment, errorsmod.Wrapf(types.ErrUnauthorized, "%s is not allowed to reveal denom %s", sender, denomID)
+	}
+	if denom.Paused.Edit {
+		return commitment, errorsmod.Wrapf(types.ErrDenomPaused, "edits are paused on denom %s", denomID)
+	}
+	return commitment, nil
+}
+
+func (k Keeper) revealONFT(ctx sdk.Context, commitment types.MetadataCommitment, revealed types.RevealedONFT) error {
+	denomID := commitment.DenomId
+	if !k.IsUnrevealed(ctx, denomID, revealed.Id) {
+		return errorsmod.Wrapf(types.ErrInvalidReveal, "onft %s of denom %s is not unrevealed", revealed.Id, denomID)
+	}
+	if err := revealed.Verify(commitment.MetadataRoot); err != nil {
+		return err
+	}
+	nft, err := k.GetONFT(ctx, denomID, revealed.Id)
+	if err != nil {
+		return err
+	}
+
+	onft := nft.(types.ONFT)
+	onft.Metadata = revealed.Metadata
+	onft.Data = revealed.Data
+	k.setONFT(ctx, denomID, onft)
+	k.clearUnrevealed(ctx, denomID, onft.Id)
+	k.emitRevealONFTEvent(ctx, denomID, onft.Id, onft.Metadata.Name, onft.Metadata.MediaURI, onft.Metadata.PreviewURI)
+	return nil
+}
+
+// markUnrevealed flags a newly minted oNFT of a denom in unrevealed mode
+func (k Keeper) markUnrevealed(ctx sdk.Context, denomID, onftID string) {
+	commitment, err := k.GetMetadataCommitment(ctx, denomID)
+	if err != nil || commitment.Revealed {
+		return
+	}
+	commitment.Unrevealed++
+	k.SetMetadataCommitment(ctx, commitment)
+	k.SetUnrevealedONFT(ctx, denomID, onftID)
+}
+
+// clearUnrevealed drops the unrevealed flag of a revealed or burned oNFT
+func (k Keeper) clearUnrevealed(ctx sdk.Context, denomID, onftID string) {
+	if !k.IsUnrevealed(ctx, denomID, onftID) {
+		return
+	}
+	commitment, err := k.GetMetadataCommitment(ctx, denomID)
+	if err != nil {
+		panic(err)
+	}
+	commitment.Unrevealed--
+	k.SetMetadataCommitment(ctx, commitment)
+
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.KeyUnrevealedONFT(denomID, onftID))
+}
+
+func (k Keeper) HasMetadataCommitment(ctx sdk.Context, denomID string) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.KeyMetadataCommitment(denomID))
+}
+
+func (k Keeper) GetMetadataCommitment(ctx sdk.Context, denomID string) (commitment types.MetadataCommitment, err error) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyMetadataCommitment(denomID))
+	if bz == nil {
+		return commitment, errorsmod.Wrapf(types.ErrUnknownCommitment, "denom %s has no metadata commitment", denomID)
+	}
+	k.cdc.MustUnmarshal(bz, &commitment)
+	return commitment, nil
+}
+
+func (k Keeper) GetMetadataCommitments(ctx sdk.Context) (commitments []types.MetadataCommitment) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyMetadataCommitment(""))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var commitment types.MetadataCommitment
+		k.cdc.MustUnmarshal(iterator.Value(), &commitment)
+		commitments = append(commitments, commitment)
+	}
+	return commitments
+}
+
+func (k Keeper) SetMetadataCommitment(ctx sdk.Context, commitment types.MetadataCommitment) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.KeyMetadataCommitment(commitment.DenomId), k.cdc.MustMarshal(&commitment))
+}
+
+func (k Keeper) IsUnrevealed(ctx sdk.Context, denomID, onftID string) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.KeyUnrevealedONFT(denomID, onftID))
+}
+
+func (k Keeper) GetUnrevealedONFTs(ctx sdk.Context) (refs []types.ONFTRef) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyUnrevealedONFT("", ""))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var ref types.ONFTRef
+		k.cdc.MustUnmarshal(iterator.Value(), &ref)
+		refs = append(refs, ref)
+	}
+	return refs
+}
+
+func (k Keeper) SetUnrevealedONFT(ctx sdk.Context, denomID, onftID string) {
+	store := ctx.KVStore(k.storeKey)
+	ref := types.NewONFTRef(denomID, onftID)
+	store.Set(types.KeyUnrevealedONFT(denomID, onftID), k.cdc.MustMarshal(&ref))
+}
@@ -0,0 +1,86 @@
+package keeper_test
+
+import (
+	"encoding/hex"
+
+	"github.com/OmniFlix/onft/types"
+)
+
+func revealedONFT(id string) types.RevealedONFT {
+	return types.RevealedONFT{
+		Id:       id,
+		Metadata: types.Metadata{Name: "revealed " + id, MediaURI: "https://onft.test/" + id},
+		Data:     `{"rarity":"rare"}`,
+	}
+}
+
+// commitMetadata commits the metadata root of the given revealed oNFTs and
+// returns them with their proofs
+func (s *KeeperTestSuite) commitMetadata(revealed ...types.RevealedONFT) []types.RevealedONFT {
+	leaves := make([][]byte, len(revealed))
+	for i, onft := range revealed {
+		leaves[i] = types.RevealLeafHash(onft.Id, onft.Metadata, onft.Data)
+	}
+	root, proofs := types.BuildMerkleTree(leaves)
+	for i := range revealed {
+		revealed[i].Proof = types.EncodeMerkleProof(proofs[i])
+	}
+	s.Require().NoError(s.keeper.CommitDenomMetadata(s.ctx, denomID, hex.EncodeToString(root), s.creator))
+	return revealed
+}
+
+func (s *KeeperTestSuite) TestRevealDenom() {
+	s.createDenom(denomID, s.creator)
+	revealed := s.commitMetadata(revealedONFT(onftID), revealedONFT(onftID2))
+	s.mint(denomID, onftID, s.creator, s.alice)
+	s.mint(denomID, onftID2, s.creator, s.bob)
+	s.Require().True(s.keeper.IsUnrevealed(s.ctx, denomID, onftID))
+
+	// a proof of another oNFT does not reveal the metadata
+	forged := revealed[0]
+	forged.Proof = revealed[1].Proof
+	s.Require().ErrorIs(s.keeper.RevealONFT(s.ctx, denomID, forged, s.creator), types.ErrInvalidMerkleProof)
+	s.Require().ErrorIs(s.keeper.RevealONFT(s.ctx, denomID, revealed[0], s.alice), types.ErrUnauthorized)
+
+	s.Require().NoError(s.keeper.RevealONFT(s.ctx, denomID, revealed[0], s.creator))
+	onft, err := s.keeper.GetONFT(s.ctx, denomID, onftID)
+	s.Require().NoError(err)
+	s.Require().Equal("revealed "+onftID, onft.GetName())
+	s.Require().False(s.keeper.IsUnrevealed(s.ctx, denomID, onftID))
+	s.Require().ErrorIs(s.keeper.RevealONFT(s.ctx, denomID, revealed[0], s.creator), types.ErrInvalidReveal)
+
+	s.Require().NoError(s.keeper.RevealDenom(s.ctx, denomID, revealed[1:], s.creator))
+	commitment, err := s.keeper.GetMetadataCommitment(s.ctx, denomID)
+	s.Require().NoError(err)
+	s.Require().True(commitment.Revealed)
+	s.Require().Zero(commitment.Unrevealed)
+}
+
+func (s *KeeperTestSuite) TestRevealDenomRequiresAllONFTs() {
+	s.createDenom(denomID, s.creator)
+	s.commitMetadata(revealedONFT(onftID), revealedONFT(onftID2))
+	s.mint(denomID, onftID, s.creator, s.alice)
+
+	s.Require().ErrorIs(s.keeper.RevealDenom(s.ctx, denomID, nil, s.creator), types.ErrInvalidReveal)
+}
+
+func (s *KeeperTestSuite) TestRevealSingleONFTTree() {
+	s.createDenom(denomID, s.creator)
+	revealed := s.commitMetadata(revealedONFT(onftID))
+	s.Require().Empty(revealed[0].Proof)
+	s.Require().NoError(revealed[0].Validate())
+	s.mint(denomID, onftID, s.creator, s.alice)
+
+	s.Require().NoError(s.keeper.RevealDenom(s.ctx, denomID, revealed, s.creator))
+	onft, err := s.keeper.GetONFT(s.ctx, denomID, onftID)
+	s.Require().NoError(err)
+	s.Require().Equal(`{"rarity":"rare"}`, onft.GetData())
+}
+
+func (s *KeeperTestSuite) TestCommitDenomMetadataRequiresEmptyDenom() {
+	s.createDenom(denomID, s.creator)
+	s.mint(denomID, onftID, s.creator, s.alice)
+
+	root := hex.EncodeToString(types.RevealLeafHash(onftID, types.Metadata{}, ""))
+	s.Require().ErrorIs(s.keeper.CommitDenomMetadata(s.ctx, denomID, root, s.creator), types.ErrInvalidReveal)
+}
@@ -0,0 +1,195 @@
+package keeper
+
+import (
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/OmniFlix/onft/types"
+)
+
+// SetDenomSubscription sets the period and price of a subscription denom.
+// Only a denom without oNFTs can become a subscription denom.
+func (k Keeper) SetDenomSubscription(ctx sdk.Context, denomID string, config types.SubscriptionConfig, sender sdk.AccAddress) error {
+	denom, err := k.AuthorizeDenomCreator(ctx, denomID, sender)
+	if err != nil {
+		return err
+	}
+	if denom.Subscription == nil && k.GetTotalSupply(ctx, denomID) > 0 {
+		return errorsmod.Wrapf(types.ErrInvalidSubscription, "denom %s already has minted onfts", denomID)
+	}
+	denom.Subscription = &config
+	k.SetDenom(ctx, denom)
+	return nil
+}
+
+// GetDenomSubscription returns the subscription config of the denom
+func (k Keeper) GetDenomSubscription(ctx sdk.Context, denomID string) (types.SubscriptionConfig, error) {
+	denom, err := k.GetDenom(ctx, denomID)
+	if err != nil {
+		return types.SubscriptionConfig{}, err
+	}
+	if denom.Subscription == nil {
+		return types.SubscriptionConfig{}, errorsmod.Wrapf(types.ErrInvalidSubscription, "denom %s is not a subscription denom", denomID)
+	}
+	return *denom.Subscription, nil
+}
+
+// startSubscription sets the expiry of an oNFT minted into a subscription
+// denom to the end of its first period
+func (k Keeper) startSubscription(ctx sdk.Context, denomID string, onft types.ONFT) types.ONFT {
+	onft.ExpiresAt = nil
+	config, err := k.GetDenomSubscription(ctx, denomID)
+	if err != nil {
+		return onft
+	}
+	expiresAt := ctx.BlockTime().Add(config.Period)
+	onft.ExpiresAt = &expiresAt
+	k.setExpiring(ctx, expiresAt, denomID, onft.Id)
+	return onft
+}
+
+// RenewONFT extends a subscription oNFT by a number of periods. The payer
+// pays the price of the periods to the denom creator. A lapsed oNFT is renewed
+// from the current block time.
+func (k Keeper) RenewONFT(ctx sdk.Context, denomID, onftID string, periods uint64, payer sdk.AccAddress) (time.Time, error) {
+	if err := types.ValidateRenewalPeriods(periods); err != nil {
+		return time.Time{}, err
+	}
+	denom, err := k.GetDenom(ctx, denomID)
+	if err != nil {
+		return time.Time{}, err
+	}
+	if denom.Subscription == nil {
+		return time.Time{}, errorsmod.Wrapf(types.ErrInvalidSubscription, "denom %s is not a subscription denom", denomID)
+	}
+	nft, err := k.GetONFT(ctx, denomID, onftID)
+	if err != nil {
+		return time.Time{}, err
+	}
+	onft := nft.(types.ONFT)
+	if onft.ExpiresAt == nil {
+		return time.Time{}, errorsmod.Wrapf(types.ErrInvalidSubscription, "onft %s has no expiry", onftID)
+	}
+	creator, err := sdk.AccAddressFromBech32(denom.Creator)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	amount := denom.Subscription.RenewalPrice(periods)
+	if err := k.bankKeeper.SendCoins(ctx, payer, creator, sdk.NewCoins(amount)); err != nil {
+		return time.Time{}, err
+	}
+	expiresAt := denom.Subscription.Extend(*onft.ExpiresAt, ctx.BlockTime(), periods)
+	k.deleteExpiring(ctx, *onft.ExpiresAt, denomID, onftID)
+	k.setExpiring(ctx, expiresAt, denomID, onftID)
+	onft.ExpiresAt = &expiresAt
+	k.setONFT(ctx, denomID, onft)
+	k.SetRenewal(ctx, types.NewRenewal(denomID, onftID, payer, periods, amount, ctx.BlockTime(), expiresAt))
+
+	k.emitRenewONFTEvent(ctx, denomID, onftID, payer.String(), amount.String(), expiresAt.String())
+	return expiresAt, nil
+}
+
+// IsSubscriptionActive returns true if the oNFT belongs to a subscription
+// denom and has not expired at the current block time
+func (k Keeper) IsSubscriptionActive(ctx sdk.Context, denomID, onftID string) bool {
+	return k.ValidateSubscription(ctx, denomID, onftID, nil) == nil
+}
+
+// ValidateSubscription checks that the oNFT is an active subscription, and
+// when holder is given that the holder owns it. Other modules gate access to
+// members with it.
+func (k Keeper) ValidateSubscription(ctx sdk.Context, denomID, onftID string, holder sdk.AccAddress) error {
+	if _, err := k.GetDenomSubscription(ctx, denomID); err != nil {
+		return err
+	}
+	nft, err := k.GetONFT(ctx, denomID, onftID)
+	if err != nil {
+		return err
+	}
+	onft := nft.(types.ONFT)
+	if onft.ExpiresAt == nil || onft.IsExpired(ctx.BlockTime()) {
+		return errorsmod.Wrapf(types.ErrONFTExpired, "subscription %s of denom %s is expired", onftID, denomID)
+	}
+	if holder != nil && !holder.Equals(onft.GetOwner()) {
+		return errorsmod.Wrapf(types.ErrUnauthorized, "%s is not the owner of subscription %s", holder, onftID)
+	}
+	return nil
+}
+
+// ExpireSubscriptions removes the oNFTs that expired by the current block
+// time from the expiry index, and emits an event for each of them
+func (k Keeper) ExpireSubscriptions(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(
+		types.KeyExpiring(time.Time{}, "", ""),
+		sdk.PrefixEndBytes(types.KeyExpiring(ctx.BlockTime(), "", "")),
+	)
+	var keys [][]byte
+	var refs []types.ONFTRef
+	for ; iterator.Valid(); iterator.Next() {
+		var ref types.ONFTRef
+		k.cdc.MustUnmarshal(iterator.Value(), &ref)
+		keys = append(keys, iterator.Key())
+		refs = append(refs, ref)
+	}
+	iterator.Close()
+
+	for i, ref := range refs {
+		store.Delete(keys[i])
+		nft, err := k.GetONFT(ctx, ref.DenomId, ref.OnftId)
+		if err != nil {
+			continue
+		}
+		k.emitExpireONFTEvent(ctx, ref.DenomId, ref.OnftId, nft.GetOwner().String())
+	}
+}
+
+// clearSubscription deletes the expiry index and renewals of a burnt oNFT
+func (k Keeper) clearSubscription(ctx sdk.Context, denomID string, onft types.ONFT) {
+	if onft.ExpiresAt == nil {
+		return
+	}
+	k.deleteExpiring(ctx, *onft.ExpiresAt, denomID, onft.Id)
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyRenewal(denomID, onft.Id, time.Time{}))
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+func (k Keeper) setExpiring(ctx sdk.Context, expiresAt time.Time, denomID, onftID string) {
+	store := ctx.KVStore(k.storeKey)
+	ref := types.NewONFTRef(denomID, onftID)
+	store.Set(types.KeyExpiring(expiresAt, denomID, onftID), k.cdc.MustMarshal(&ref))
+}
+
+func (k Keeper) deleteExpiring(ctx sdk.Context, expiresAt time.Time, denomID, onftID string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.KeyExpiring(expiresAt, denomID, onftID))
+}
+
+func (k Keeper) GetRenewals(ctx sdk.Context) (renewals []types.Renewal) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyRenewal("", "", time.Time{}))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var renewal types.Renewal
+		k.cdc.MustUnmarshal(iterator.Value(), &renewal)
+		renewals = append(renewals, renewal)
+	}
+	return renewals
+}
+
+func (k Keeper) SetRenewal(ctx sdk.Context, renewal types.Renewal) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.KeyRenewal(renewal.DenomId, renewal.OnftId, renewal.ExpiresAt), k.cdc.MustMarshal(&renewal))
+}
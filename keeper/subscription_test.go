@@ -0,0 +1,52 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/OmniFlix/onft/types"
+)
+
+const subscriptionPeriod = 30 * 24 * time.Hour
+
+func (s *KeeperTestSuite) TestRenewONFT() {
+	s.createDenom(denomID, s.creator)
+	config := types.NewSubscriptionConfig(subscriptionPeriod, sdk.NewInt64Coin(feeDenom, 100))
+	s.Require().ErrorIs(s.keeper.SetDenomSubscription(s.ctx, denomID, *config, s.alice), types.ErrUnauthorized)
+	s.Require().NoError(s.keeper.SetDenomSubscription(s.ctx, denomID, *config, s.creator))
+	s.mint(denomID, onftID, s.creator, s.alice)
+	s.fund(s.bob, sdk.NewInt64Coin(feeDenom, 300))
+
+	start := s.ctx.BlockTime()
+	s.Require().NoError(s.keeper.ValidateSubscription(s.ctx, denomID, onftID, s.alice))
+	s.Require().ErrorIs(s.keeper.ValidateSubscription(s.ctx, denomID, onftID, s.bob), types.ErrUnauthorized)
+
+	// anyone can pay the renewal
+	expiresAt, err := s.keeper.RenewONFT(s.ctx, denomID, onftID, 2, s.bob)
+	s.Require().NoError(err)
+	s.Require().Equal(start.Add(3*subscriptionPeriod), expiresAt)
+	s.Require().Equal(int64(200), s.balance(s.creator, feeDenom))
+	_, err = s.keeper.RenewONFT(s.ctx, denomID, onftID, types.MaxRenewalPeriods+1, s.bob)
+	s.Require().ErrorIs(err, types.ErrInvalidSubscription)
+
+	// a lapsed subscription renews from the current block time
+	s.nextBlock(3 * subscriptionPeriod)
+	s.keeper.ExpireSubscriptions(s.ctx)
+	s.Require().False(s.keeper.IsSubscriptionActive(s.ctx, denomID, onftID))
+	s.nextBlock(time.Hour)
+	expiresAt, err = s.keeper.RenewONFT(s.ctx, denomID, onftID, 1, s.bob)
+	s.Require().NoError(err)
+	s.Require().Equal(s.ctx.BlockTime().Add(subscriptionPeriod), expiresAt)
+	s.Require().True(s.keeper.IsSubscriptionActive(s.ctx, denomID, onftID))
+}
+
+func (s *KeeperTestSuite) TestSetDenomSubscriptionRequiresEmptyDenom() {
+	s.createDenom(denomID, s.creator)
+	s.mint(denomID, onftID, s.creator, s.alice)
+
+	config := types.NewSubscriptionConfig(subscriptionPeriod, sdk.NewInt64Coin(feeDenom, 100))
+	s.Require().ErrorIs(s.keeper.SetDenomSubscription(s.ctx, denomID, *config, s.creator), types.ErrInvalidSubscription)
+	_, err := s.keeper.RenewONFT(s.ctx, denomID, onftID, 1, s.alice)
+	s.Require().ErrorIs(err, types.ErrInvalidSubscription)
+}
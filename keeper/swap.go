@@ -0,0 +1,187 @@
+package keeper
+
+import (
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/OmniFlix/onft/types"
+)
+
+// CreateSwap escrows the offered oNFTs and coins of the creator in the module
+// account until the swap is accepted or cancelled.
+func (k Keeper) CreateSwap(
+	ctx sdk.Context,
+	offeredONFTs []types.ONFTRef, offeredCoins sdk.Coins,
+	requestedONFTs []types.ONFTRef, requestedCoins sdk.Coins,
+	counterparty string, expiry time.Time,
+	creator sdk.AccAddress,
+) (uint64, error) {
+	if !expiry.IsZero() && !expiry.After(ctx.BlockTime()) {
+		return 0, errorsmod.Wrapf(types.ErrInvalidSwap, "expiry %s must be in the future", expiry)
+	}
+	for _, ref := range requestedONFTs {
+		if !k.HasONFT(ctx, ref.DenomId, ref.OnftId) {
+			return 0, errorsmod.Wrapf(types.ErrUnknownONFT, "requested onft %s not found", ref)
+		}
+	}
+	if err := k.escrowAssets(ctx, offeredONFTs, offeredCoins, creator); err != nil {
+		return 0, err
+	}
+
+	swapID := k.GetNextSwapID(ctx)
+	k.SetNextSwapID(ctx, swapID+1)
+	k.SetSwap(ctx, types.Swap{
+		Id:             swapID,
+		Creator:        creator.String(),
+		OfferedOnfts:   offeredONFTs,
+		OfferedCoins:   offeredCoins,
+		RequestedOnfts: requestedONFTs,
+		RequestedCoins: requestedCoins,
+		Counterparty:   counterparty,
+		Expiry:         expiry,
+	})
+	k.emitCreateSwapEvent(ctx, swapID, creator.String(), counterparty)
+	return swapID, nil
+}
+
+// AcceptSwap sends the requested oNFTs and coins of the acceptor to the swap
+// creator and releases the escrowed assets to the acceptor in one step.
+func (k Keeper) AcceptSwap(ctx sdk.Context, swapID uint64, acceptor sdk.AccAddress) error {
+	swap, err := k.GetSwap(ctx, swapID)
+	if err != nil {
+		return err
+	}
+	if swap.IsExpired(ctx.BlockTime()) {
+		return errorsmod.Wrapf(types.ErrSwapExpired, "swap %d expired at %s", swapID, swap.Expiry)
+	}
+	acceptorAddr := acceptor.String()
+	if acceptorAddr == swap.Creator {
+		return errorsmod.Wrap(types.ErrInvalidSwap, "creator can not accept own swap")
+	}
+	if len(swap.Counterparty) > 0 && acceptorAddr != swap.Counterparty {
+		return errorsmod.Wrapf(types.ErrUnauthorized, "swap %d can only be accepted by %s", swapID, swap.Counterparty)
+	}
+	creator, err := sdk.AccAddressFromBech32(swap.Creator)
+	if err != nil {
+		return err
+	}
+
+	for _, ref := range swap.RequestedOnfts {
+		if err := k.TransferOwnership(ctx, ref.DenomId, ref.OnftId, acceptor, creator); err != nil {
+			return err
+		}
+	}
+	if !swap.RequestedCoins.IsZero() {
+		if err := k.bankKeeper.SendCoins(ctx, acceptor, creator, swap.RequestedCoins); err != nil {
+			return err
+		}
+	}
+	if err := k.releaseAssets(ctx, swap.OfferedOnfts, swap.OfferedCoins, acceptor); err != nil {
+		return err
+	}
+
+	k.deleteSwap(ctx, swapID)
+	k.emitAcceptSwapEvent(ctx, swapID, swap.Creator, acceptorAddr)
+	return nil
+}
+
+// CancelSwap returns the escrowed assets of a swap to its creator. The creator
+// can cancel at any time and anyone can cancel a swap after it expired.
+func (k Keeper) CancelSwap(ctx sdk.Context, swapID uint64, sender sdk.AccAddress) error {
+	swap, err := k.GetSwap(ctx, swapID)
+	if err != nil {
+		return err
+	}
+	if sender.String() != swap.Creator && !swap.IsExpired(ctx.BlockTime()) {
+		return errorsmod.Wrap(types.ErrUnauthorized, sender.String())
+	}
+	creator, err := sdk.AccAddressFromBech32(swap.Creator)
+	if err != nil {
+		return err
+	}
+	if err := k.releaseAssets(ctx, swap.OfferedOnfts, swap.OfferedCoins, creator); err != nil {
+		return err
+	}
+
+	k.deleteSwap(ctx, swapID)
+	k.emitCancelSwapEvent(ctx, swapID, swap.Creator, sender.String())
+	return nil
+}
+
+// escrowAssets moves oNFTs and coins of the owner into the module account
+func (k Keeper) escrowAssets(ctx sdk.Context, onfts []types.ONFTRef, coins sdk.Coins, owner sdk.AccAddress) error {
+	moduleAddr := k.GetModuleAddress()
+	for _, ref := range onfts {
+		if err := k.TransferOwnership(ctx, ref.DenomId, ref.OnftId, owner, moduleAddr); err != nil {
+			return err
+		}
+	}
+	if coins.IsZero() {
+		return nil
+	}
+	return k.bankKeeper.SendCoinsFromAccountToModule(ctx, owner, types.ModuleName, coins)
+}
+
+// releaseAssets moves escrowed oNFTs and coins from the module account to the recipient
+func (k Keeper) releaseAssets(ctx sdk.Context, onfts []types.ONFTRef, coins sdk.Coins, recipient sdk.AccAddress) error {
+	moduleAddr := k.GetModuleAddress()
+	for _, ref := range onfts {
+		if err := k.TransferOwnership(ctx, ref.DenomId, ref.OnftId, moduleAddr, recipient); err != nil {
+			return err
+		}
+	}
+	if coins.IsZero() {
+		return nil
+	}
+	return k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, recipient, coins)
+}
+
+func (k Keeper) GetNextSwapID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.NextSwapIDKey)
+	if len(bz) == 0 {
+		return 1
+	}
+	return types.MustUnMarshalSupply(k.cdc, bz)
+}
+
+func (k Keeper) SetNextSwapID(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.NextSwapIDKey, types.MustMarshalSupply(k.cdc, id))
+}
+
+func (k Keeper) GetSwap(ctx sdk.Context, id uint64) (swap types.Swap, err error) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeySwap(id))
+	if bz == nil {
+		return swap, errorsmod.Wrapf(types.ErrUnknownSwap, "swap %d not found", id)
+	}
+	k.cdc.MustUnmarshal(bz, &swap)
+	return swap, nil
+}
+
+func (k Keeper) GetSwaps(ctx sdk.Context) (swaps []types.Swap) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeySwap(0))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var swap types.Swap
+		k.cdc.MustUnmarshal(iterator.Value(), &swap)
+		swaps = append(swaps, swap)
+	}
+	return swaps
+}
+
+func (k Keeper) SetSwap(ctx sdk.Context, swap types.Swap) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&swap)
+	store.Set(types.KeySwap(swap.Id), bz)
+}
+
+func (k Keeper) deleteSwap(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.KeySwap(id))
+}
@@ -0,0 +1,77 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/OmniFlix/onft/types"
+)
+
+func (s *KeeperTestSuite) TestAcceptSwap() {
+	s.createDenom(denomID, s.creator)
+	s.mint(denomID, onftID, s.creator, s.alice)
+	s.mint(denomID, onftID2, s.creator, s.bob)
+	s.fund(s.alice, sdk.NewInt64Coin(feeDenom, 100))
+	s.fund(s.bob, sdk.NewInt64Coin(feeDenom, 50))
+
+	offered := []types.ONFTRef{{DenomId: denomID, OnftId: onftID}}
+	requested := []types.ONFTRef{{DenomId: denomID, OnftId: onftID2}}
+	swapID, err := s.keeper.CreateSwap(
+		s.ctx, offered, sdk.NewCoins(sdk.NewInt64Coin(feeDenom, 100)),
+		requested, sdk.NewCoins(sdk.NewInt64Coin(feeDenom, 50)),
+		s.bob.String(), time.Time{}, s.alice,
+	)
+	s.Require().NoError(err)
+	s.Require().Equal(s.keeper.GetModuleAddress(), s.owner(denomID, onftID))
+	s.Require().Equal(int64(100), s.moduleBalance(feeDenom))
+	s.Require().Equal(int64(0), s.balance(s.alice, feeDenom))
+
+	s.Require().ErrorIs(s.keeper.AcceptSwap(s.ctx, swapID, s.creator), types.ErrUnauthorized)
+	s.Require().NoError(s.keeper.AcceptSwap(s.ctx, swapID, s.bob))
+	s.Require().Equal(s.bob, s.owner(denomID, onftID))
+	s.Require().Equal(s.alice, s.owner(denomID, onftID2))
+	s.Require().Equal(int64(50), s.balance(s.alice, feeDenom))
+	s.Require().Equal(int64(100), s.balance(s.bob, feeDenom))
+	s.Require().Equal(int64(0), s.moduleBalance(feeDenom))
+	_, err = s.keeper.GetSwap(s.ctx, swapID)
+	s.Require().Error(err)
+}
+
+func (s *KeeperTestSuite) TestCancelSwap() {
+	s.createDenom(denomID, s.creator)
+	s.mint(denomID, onftID, s.creator, s.alice)
+	s.fund(s.alice, sdk.NewInt64Coin(feeDenom, 100))
+
+	offered := []types.ONFTRef{{DenomId: denomID, OnftId: onftID}}
+	expiry := s.ctx.BlockTime().Add(time.Hour)
+	swapID, err := s.keeper.CreateSwap(
+		s.ctx, offered, sdk.NewCoins(sdk.NewInt64Coin(feeDenom, 100)),
+		nil, sdk.NewCoins(sdk.NewInt64Coin(feeDenom, 10)),
+		"", expiry, s.alice,
+	)
+	s.Require().NoError(err)
+
+	// only the creator can cancel before the swap expires
+	s.Require().ErrorIs(s.keeper.CancelSwap(s.ctx, swapID, s.bob), types.ErrUnauthorized)
+	s.nextBlock(time.Hour)
+	s.Require().ErrorIs(s.keeper.AcceptSwap(s.ctx, swapID, s.bob), types.ErrSwapExpired)
+	s.Require().NoError(s.keeper.CancelSwap(s.ctx, swapID, s.bob))
+	s.Require().Equal(s.alice, s.owner(denomID, onftID))
+	s.Require().Equal(int64(100), s.balance(s.alice, feeDenom))
+	s.Require().Equal(int64(0), s.moduleBalance(feeDenom))
+}
+
+func (s *KeeperTestSuite) TestCreateSwapRequiresOwnership() {
+	s.createDenom(denomID, s.creator)
+	s.mint(denomID, onftID, s.creator, s.alice)
+
+	offered := []types.ONFTRef{{DenomId: denomID, OnftId: onftID}}
+	_, err := s.keeper.CreateSwap(s.ctx, offered, nil, nil, nil, "", time.Time{}, s.bob)
+	s.Require().Error(err)
+	s.Require().Equal(s.alice, s.owner(denomID, onftID))
+
+	requested := []types.ONFTRef{{DenomId: denomID, OnftId: "missing"}}
+	_, err = s.keeper.CreateSwap(s.ctx, offered, nil, requested, nil, "", time.Time{}, s.alice)
+	s.Require().ErrorIs(err, types.ErrUnknownONFT)
+}
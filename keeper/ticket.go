@@ -0,0 +1,62 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/OmniFlix/onft/types"
+)
+
+// SetDenomRedeemer sets the address redeeming tickets of the denom
+func (k Keeper) SetDenomRedeemer(ctx sdk.Context, denomID string, redeemer, sender sdk.AccAddress) error {
+	denom, err := k.AuthorizeDenomCreator(ctx, denomID, sender)
+	if err != nil {
+		return err
+	}
+	denom.Redeemer = redeemer.String()
+	k.SetDenom(ctx, denom)
+	return nil
+}
+
+// GetDenomRedeemer returns the redeemer of the denom, its creator by default
+func (k Keeper) GetDenomRedeemer(ctx sdk.Context, denomID string) (string, error) {
+	denom, err := k.GetDenom(ctx, denomID)
+	if err != nil {
+		return "", err
+	}
+	if len(denom.Redeemer) > 0 {
+		return denom.Redeemer, nil
+	}
+	return denom.Creator, nil
+}
+
+// RedeemONFT uses a ticket oNFT once without burning it. The redeemer of the
+// denom must sign, and the owner when given must hold the ticket.
+func (k Keeper) RedeemONFT(ctx sdk.Context, denomID, onftID string, redeemer, owner sdk.AccAddress) (uint64, error) {
+	denomRedeemer, err := k.GetDenomRedeemer(ctx, denomID)
+	if err != nil {
+		return 0, err
+	}
+	if redeemer.String() != denomRedeemer {
+		return 0, errorsmod.Wrapf(types.ErrUnauthorized, "%s is not the redeemer of denom %s", redeemer, denomID)
+	}
+	nft, err := k.GetONFT(ctx, denomID, onftID)
+	if err != nil {
+		return 0, err
+	}
+	onft := nft.(types.ONFT)
+	if owner != nil && !owner.Equals(onft.GetOwner()) {
+		return 0, errorsmod.Wrapf(types.ErrUnauthorized, "%s is not the owner of onft %s", owner, onftID)
+	}
+	if !onft.IsTicket() {
+		return 0, errorsmod.Wrapf(types.ErrInvalidRedemption, "onft %s of denom %s is not redeemable", onftID, denomID)
+	}
+	if onft.RemainingUses() == 0 {
+		return 0, errorsmod.Wrapf(types.ErrONFTExhausted, "all %d uses of onft %s are redeemed", onft.MaxUses, onftID)
+	}
+
+	onft.Uses++
+	k.setONFT(ctx, denomID, onft)
+	k.emitRedeemONFTEvent(ctx, denomID, onftID, onft.Owner, redeemer.String(), onft.Uses)
+	return onft.Uses, nil
+}
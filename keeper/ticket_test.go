@@ -0,0 +1,54 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/OmniFlix/onft/types"
+)
+
+// mintTicket mints a ticket oNFT redeemable maxUses times to owner
+func (s *KeeperTestSuite) mintTicket(onftID string, maxUses uint64, owner sdk.AccAddress) {
+	msg := types.NewMsgMintONFT(
+		denomID, s.creator.String(), owner.String(),
+		types.Metadata{Name: "ticket", MediaURI: "https://onft.test/media"}, "{}",
+		true, true, false, sdk.ZeroDec(),
+	)
+	msg.Id = onftID
+	msg.MaxUses = maxUses
+	_, err := s.msgServer.MintONFT(s.ctx, msg)
+	s.Require().NoError(err)
+}
+
+func (s *KeeperTestSuite) TestRedeemONFT() {
+	s.createDenom(denomID, s.creator)
+	s.mintTicket(onftID, 2, s.alice)
+	s.Require().NoError(s.keeper.SetDenomRedeemer(s.ctx, denomID, s.bob, s.creator))
+
+	_, err := s.keeper.RedeemONFT(s.ctx, denomID, onftID, s.creator, nil)
+	s.Require().ErrorIs(err, types.ErrUnauthorized)
+	_, err = s.keeper.RedeemONFT(s.ctx, denomID, onftID, s.bob, s.creator)
+	s.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	uses, err := s.keeper.RedeemONFT(s.ctx, denomID, onftID, s.bob, s.alice)
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(1), uses)
+	uses, err = s.keeper.RedeemONFT(s.ctx, denomID, onftID, s.bob, nil)
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(2), uses)
+	_, err = s.keeper.RedeemONFT(s.ctx, denomID, onftID, s.bob, nil)
+	s.Require().ErrorIs(err, types.ErrONFTExhausted)
+
+	// the oNFT is kept by its owner
+	s.Require().Equal(s.alice, s.owner(denomID, onftID))
+}
+
+func (s *KeeperTestSuite) TestRedeemONFTRequiresTicket() {
+	s.createDenom(denomID, s.creator)
+	s.mint(denomID, onftID, s.creator, s.alice)
+
+	redeemer, err := s.keeper.GetDenomRedeemer(s.ctx, denomID)
+	s.Require().NoError(err)
+	s.Require().Equal(s.creator.String(), redeemer)
+	_, err = s.keeper.RedeemONFT(s.ctx, denomID, onftID, s.creator, nil)
+	s.Require().ErrorIs(err, types.ErrInvalidRedemption)
+}
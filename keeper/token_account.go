@@ -0,0 +1,91 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/OmniFlix/onft/types"
+)
+
+// CreateTokenAccount enables the token account of an oNFT. The account is
+// derived from the oNFT, so its balances move with the oNFT.
+func (k Keeper) CreateTokenAccount(ctx sdk.Context, denomID, onftID string, owner sdk.AccAddress) (sdk.AccAddress, error) {
+	if _, err := k.Authorize(ctx, denomID, onftID, owner); err != nil {
+		return nil, err
+	}
+	if k.HasTokenAccount(ctx, denomID, onftID) {
+		return nil, errorsmod.Wrapf(types.ErrInvalidTokenAccount, "token account of %s/%s already exists", denomID, onftID)
+	}
+
+	account := types.TokenAccountAddress(denomID, onftID)
+	k.SetTokenAccount(ctx, denomID, onftID)
+	k.emitCreateTokenAccountEvent(ctx, denomID, onftID, owner.String(), account.String())
+	return account, nil
+}
+
+// ExecuteFromTokenAccount executes msgs signed by the token account of an
+// oNFT owned by the sender. Only bank MsgSend is supported.
+func (k Keeper) ExecuteFromTokenAccount(ctx sdk.Context, denomID, onftID string, sender sdk.AccAddress, msgs []sdk.Msg) error {
+	if !k.HasTokenAccount(ctx, denomID, onftID) {
+		return errorsmod.Wrapf(types.ErrInvalidTokenAccount, "token account of %s/%s does not exist", denomID, onftID)
+	}
+	if _, err := k.Authorize(ctx, denomID, onftID, sender); err != nil {
+		return err
+	}
+
+	account := types.TokenAccountAddress(denomID, onftID)
+	for _, msg := range msgs {
+		switch msg := msg.(type) {
+		case *banktypes.MsgSend:
+			if msg.FromAddress != account.String() {
+				return errorsmod.Wrapf(types.ErrUnauthorized, "msg must be sent from token account %s", account)
+			}
+			to, err := sdk.AccAddressFromBech32(msg.ToAddress)
+			if err != nil {
+				return err
+			}
+			if k.bankKeeper.BlockedAddr(to) {
+				return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", msg.ToAddress)
+			}
+			if err := k.bankKeeper.SendCoins(ctx, account, to, msg.Amount); err != nil {
+				return err
+			}
+		default:
+			return errorsmod.Wrapf(types.ErrInvalidTokenAccount, "unsupported msg %s", sdk.MsgTypeURL(msg))
+		}
+	}
+
+	k.emitExecuteFromTokenAccountEvent(ctx, denomID, onftID, sender.String(), account.String())
+	return nil
+}
+
+func (k Keeper) HasTokenAccount(ctx sdk.Context, denomID, onftID string) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.KeyTokenAccount(denomID, onftID))
+}
+
+func (k Keeper) GetTokenAccounts(ctx sdk.Context) (refs []types.ONFTRef) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyTokenAccount("", ""))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var ref types.ONFTRef
+		k.cdc.MustUnmarshal(iterator.Value(), &ref)
+		refs = append(refs, ref)
+	}
+	return refs
+}
+
+func (k Keeper) SetTokenAccount(ctx sdk.Context, denomID, onftID string) {
+	store := ctx.KVStore(k.storeKey)
+	ref := types.NewONFTRef(denomID, onftID)
+	store.Set(types.KeyTokenAccount(denomID, onftID), k.cdc.MustMarshal(&ref))
+}
+
+func (k Keeper) deleteTokenAccount(ctx sdk.Context, denomID, onftID string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.KeyTokenAccount(denomID, onftID))
+}
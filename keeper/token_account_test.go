@@ -0,0 +1,52 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/OmniFlix/onft/types"
+)
+
+func (s *KeeperTestSuite) TestTokenAccountMovesWithONFT() {
+	s.createDenom(denomID, s.creator)
+	s.mint(denomID, onftID, s.creator, s.alice)
+
+	s.Require().ErrorIs(s.keeper.ExecuteFromTokenAccount(s.ctx, denomID, onftID, s.alice, nil), types.ErrInvalidTokenAccount)
+	_, err := s.keeper.CreateTokenAccount(s.ctx, denomID, onftID, s.bob)
+	s.Require().ErrorIs(err, types.ErrUnauthorized)
+	account, err := s.keeper.CreateTokenAccount(s.ctx, denomID, onftID, s.alice)
+	s.Require().NoError(err)
+	s.Require().Equal(types.TokenAccountAddress(denomID, onftID), account)
+	_, err = s.keeper.CreateTokenAccount(s.ctx, denomID, onftID, s.alice)
+	s.Require().ErrorIs(err, types.ErrInvalidTokenAccount)
+	s.fund(account, sdk.NewInt64Coin(feeDenom, 100))
+
+	send := banktypes.NewMsgSend(account, s.alice, sdk.NewCoins(sdk.NewInt64Coin(feeDenom, 40)))
+	s.Require().NoError(s.keeper.ExecuteFromTokenAccount(s.ctx, denomID, onftID, s.alice, []sdk.Msg{send}))
+	s.Require().Equal(int64(40), s.balance(s.alice, feeDenom))
+
+	// the new owner controls the balances of the token account
+	s.Require().NoError(s.keeper.TransferOwnership(s.ctx, denomID, onftID, s.alice, s.bob))
+	send = banktypes.NewMsgSend(account, s.bob, sdk.NewCoins(sdk.NewInt64Coin(feeDenom, 10)))
+	s.Require().ErrorIs(s.keeper.ExecuteFromTokenAccount(s.ctx, denomID, onftID, s.alice, []sdk.Msg{send}), types.ErrUnauthorized)
+	s.Require().NoError(s.keeper.ExecuteFromTokenAccount(s.ctx, denomID, onftID, s.bob, []sdk.Msg{send}))
+	s.Require().Equal(int64(50), s.balance(account, feeDenom))
+
+	// msgs must be sent from the token account
+	send = banktypes.NewMsgSend(s.bob, s.alice, sdk.NewCoins(sdk.NewInt64Coin(feeDenom, 10)))
+	s.Require().ErrorIs(s.keeper.ExecuteFromTokenAccount(s.ctx, denomID, onftID, s.bob, []sdk.Msg{send}), types.ErrUnauthorized)
+}
+
+func (s *KeeperTestSuite) TestBurnSweepsTokenAccount() {
+	s.createDenom(denomID, s.creator)
+	s.mint(denomID, onftID, s.creator, s.alice)
+	account, err := s.keeper.CreateTokenAccount(s.ctx, denomID, onftID, s.alice)
+	s.Require().NoError(err)
+	s.fund(account, sdk.NewInt64Coin(feeDenom, 5), sdk.NewInt64Coin(bondDenom, 7))
+
+	s.Require().NoError(s.keeper.BurnONFT(s.ctx, denomID, onftID, s.alice))
+	s.Require().Equal(int64(5), s.balance(s.alice, feeDenom))
+	s.Require().Equal(int64(7), s.balance(s.alice, bondDenom))
+	s.Require().True(s.app.BankKeeper.GetAllBalances(s.ctx, account).IsZero())
+	s.Require().False(s.keeper.HasTokenAccount(s.ctx, denomID, onftID))
+}
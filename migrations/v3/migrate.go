@@ -0,0 +1,45 @@
+package v3
+
+import (
+	"github.com/OmniFlix/onft/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	ModuleName = "onft"
+)
+
+var ParamsKey = []byte{0x07}
+
+// Migrate migrates the onft module state from the consensus version 2 to
+// version 3. Specifically, it moves the single denom creation fee of the
+// stored parameters into the list of accepted denom creation fees and enables
+// nesting with the default max nesting depth, which was not set before.
+func Migrate(
+	ctx sdk.Context,
+	store sdk.KVStore,
+	cdc codec.BinaryCodec,
+) error {
+	var currParams types.Params
+	bz := store.Get(ParamsKey)
+	if bz == nil {
+		return nil
+	}
+	cdc.MustUnmarshal(bz, &currParams)
+
+	currParams.DenomCreationFees = currParams.GetDenomCreationFees()
+	currParams.DenomCreationFee = sdk.Coin{Amount: sdk.ZeroInt()} //nolint:staticcheck // cleared by this migration
+	if currParams.MaxNestingDepth == 0 {
+		currParams.MaxNestingDepth = types.DefaultMaxNestingDepth
+	}
+
+	if err := currParams.ValidateBasic(); err != nil {
+		return err
+	}
+
+	bz = cdc.MustMarshal(&currParams)
+	store.Set(ParamsKey, bz)
+
+	return nil
+}
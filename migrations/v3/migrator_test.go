@@ -0,0 +1,41 @@
+package v3_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/OmniFlix/onft"
+	v3 "github.com/OmniFlix/onft/migrations/v3"
+	"github.com/OmniFlix/onft/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+)
+
+func TestMigrate(t *testing.T) {
+	encCfg := moduletestutil.MakeTestEncodingConfig(onft.AppModuleBasic{})
+	cdc := encCfg.Codec
+
+	storeKey := sdk.NewKVStoreKey(v3.ModuleName)
+	tKey := sdk.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(storeKey, tKey)
+	store := ctx.KVStore(storeKey)
+
+	oldParams := types.DefaultParams()
+	oldParams.DenomCreationFees = nil
+	oldParams.DenomCreationFee = sdk.NewInt64Coin("uflix", 50_000_000) //nolint:staticcheck
+	oldParams.MaxNestingDepth = 0
+	store.Set(v3.ParamsKey, cdc.MustMarshal(&oldParams))
+	require.NoError(t, v3.Migrate(ctx, store, cdc))
+
+	var res types.Params
+	bz := store.Get(v3.ParamsKey)
+	require.NoError(t, cdc.Unmarshal(bz, &res))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("uflix", 50_000_000)), res.DenomCreationFees)
+	require.False(t, res.DenomCreationFee.Amount.IsNil()) //nolint:staticcheck
+	require.True(t, res.DenomCreationFee.Amount.IsZero()) //nolint:staticcheck
+	require.Equal(t, oldParams.MintFee, res.MintFee)
+	require.Equal(t, oldParams.FeeDistribution, res.FeeDistribution)
+	require.Equal(t, types.DefaultMaxNestingDepth, res.MaxNestingDepth)
+}
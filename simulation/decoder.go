@@ -1,38 +1,161 @@
 package simulation
 
 import (
-	"bytes"
 	"fmt"
 
 	"github.com/OmniFlix/onft/types"
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/kv"
+	gogotypes "github.com/cosmos/gogoproto/types"
 )
 
+// valueDecoder decodes the values stored under a key prefix of the onft store.
+type valueDecoder struct {
+	name   string
+	decode func(cdc codec.Codec, value []byte) (codec.ProtoMarshaler, error)
+}
+
+// protoValue decodes values holding a marshaled record.
+func protoValue[T any, PT interface {
+	*T
+	codec.ProtoMarshaler
+}]() func(codec.Codec, []byte) (codec.ProtoMarshaler, error) {
+	return func(cdc codec.Codec, value []byte) (codec.ProtoMarshaler, error) {
+		msg := PT(new(T))
+		if err := cdc.Unmarshal(value, msg); err != nil {
+			return nil, err
+		}
+		return msg, nil
+	}
+}
+
+// rawStringValue decodes values holding a plain string, like the denom id of
+// the denom symbol index.
+func rawStringValue(_ codec.Codec, value []byte) (codec.ProtoMarshaler, error) {
+	return &gogotypes.StringValue{Value: string(value)}, nil
+}
+
+// bigEndianIDValue decodes values holding a big endian id, like the entries
+// of the listing, auction and recipe indexes.
+func bigEndianIDValue(_ codec.Codec, value []byte) (codec.ProtoMarshaler, error) {
+	if len(value) != 8 {
+		return nil, fmt.Errorf("invalid id length %d", len(value))
+	}
+	return &gogotypes.UInt64Value{Value: sdk.BigEndianToUint64(value)}, nil
+}
+
+// valueDecoders holds a decoder for every key prefix in types/keys.go. A
+// prefix added to the store must be added here as well.
+var valueDecoders = map[byte]valueDecoder{
+	types.PrefixONFT[0]:        {"PrefixONFT", protoValue[types.ONFT]()},
+	types.PrefixOwners[0]:      {"PrefixOwners", protoValue[gogotypes.StringValue]()},
+	types.PrefixCollection[0]:  {"PrefixCollection", protoValue[gogotypes.UInt64Value]()},
+	types.PrefixDenom[0]:       {"PrefixDenom", protoValue[types.Denom]()},
+	types.PrefixDenomSymbol[0]: {"PrefixDenomSymbol", rawStringValue},
+	types.PrefixCreator[0]:     {"PrefixCreator", protoValue[gogotypes.StringValue]()},
+	types.ParamsKey[0]:         {"ParamsKey", protoValue[types.Params]()},
+
+	types.PrefixEdition[0]:       {"PrefixEdition", protoValue[gogotypes.StringValue]()},
+	types.PrefixEditionSupply[0]: {"PrefixEditionSupply", protoValue[gogotypes.UInt64Value]()},
+
+	types.PrefixClaim[0]:           {"PrefixClaim", protoValue[types.Claim]()},
+	types.PrefixClaimCommitment[0]: {"PrefixClaimCommitment", protoValue[types.ClaimCommitment]()},
+	types.PrefixClaimRecord[0]:     {"PrefixClaimRecord", protoValue[types.ClaimRecord]()},
+	types.NextClaimIDKey[0]:        {"NextClaimIDKey", protoValue[gogotypes.UInt64Value]()},
+
+	types.PrefixAirdrop[0]:      {"PrefixAirdrop", protoValue[types.MintAirdrop]()},
+	types.PrefixAirdropClaim[0]: {"PrefixAirdropClaim", protoValue[types.AirdropClaimRecord]()},
+	types.NextAirdropIDKey[0]:   {"NextAirdropIDKey", protoValue[gogotypes.UInt64Value]()},
+
+	types.PrefixSwap[0]:    {"PrefixSwap", protoValue[types.Swap]()},
+	types.NextSwapIDKey[0]: {"NextSwapIDKey", protoValue[gogotypes.UInt64Value]()},
+
+	types.PrefixListing[0]:         {"PrefixListing", protoValue[types.Listing]()},
+	types.PrefixListingByDenom[0]:  {"PrefixListingByDenom", bigEndianIDValue},
+	types.PrefixListingBySeller[0]: {"PrefixListingBySeller", bigEndianIDValue},
+	types.PrefixListingByPrice[0]:  {"PrefixListingByPrice", bigEndianIDValue},
+	types.PrefixListingByONFT[0]:   {"PrefixListingByONFT", bigEndianIDValue},
+	types.NextListingIDKey[0]:      {"NextListingIDKey", protoValue[gogotypes.UInt64Value]()},
+	types.PrefixOffer[0]:           {"PrefixOffer", protoValue[types.Offer]()},
+	types.NextOfferIDKey[0]:        {"NextOfferIDKey", protoValue[gogotypes.UInt64Value]()},
+
+	types.PrefixAuction[0]:          {"PrefixAuction", protoValue[types.Auction]()},
+	types.PrefixAuctionByEndTime[0]: {"PrefixAuctionByEndTime", bigEndianIDValue},
+	types.PrefixBid[0]:              {"PrefixBid", protoValue[types.Bid]()},
+	types.NextAuctionIDKey[0]:       {"NextAuctionIDKey", protoValue[gogotypes.UInt64Value]()},
+
+	types.PrefixLoan[0]:    {"PrefixLoan", protoValue[types.Loan]()},
+	types.NextLoanIDKey[0]: {"NextLoanIDKey", protoValue[gogotypes.UInt64Value]()},
+
+	types.PrefixFractionalization[0]: {"PrefixFractionalization", protoValue[types.Fractionalization]()},
+
+	types.PrefixNestedParent[0]:   {"PrefixNestedParent", protoValue[types.ONFTRef]()},
+	types.PrefixNestedChildren[0]: {"PrefixNestedChildren", protoValue[types.ONFTRef]()},
+
+	types.PrefixTokenAccount[0]: {"PrefixTokenAccount", protoValue[types.ONFTRef]()},
+
+	types.PrefixRecipe[0]:        {"PrefixRecipe", protoValue[types.Recipe]()},
+	types.PrefixRecipeByDenom[0]: {"PrefixRecipeByDenom", bigEndianIDValue},
+	types.NextRecipeIDKey[0]:     {"NextRecipeIDKey", protoValue[gogotypes.UInt64Value]()},
+
+	types.PrefixMetadataCommitment[0]: {"PrefixMetadataCommitment", protoValue[types.MetadataCommitment]()},
+	types.PrefixUnrevealedONFT[0]:     {"PrefixUnrevealedONFT", protoValue[types.ONFTRef]()},
+
+	types.PrefixRevocation[0]: {"PrefixRevocation", protoValue[types.Revocation]()},
+
+	types.PrefixRenewal[0]:  {"PrefixRenewal", protoValue[types.Renewal]()},
+	types.PrefixExpiring[0]: {"PrefixExpiring", protoValue[types.ONFTRef]()},
+
+	types.PrefixModerationStatus[0]: {"PrefixModerationStatus", protoValue[types.ModerationStatus]()},
+	types.PrefixFeeExemption[0]:     {"PrefixFeeExemption", protoValue[types.FeeExemption]()},
+}
+
 func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
 	return func(kvA, kvB kv.Pair) string {
-		switch {
-		case bytes.Equal(kvA.Key[:1], types.PrefixONFT):
-			var nftA, nftB types.ONFT
-			cdc.MustUnmarshal(kvA.Value, &nftA)
-			cdc.MustUnmarshal(kvB.Value, &nftB)
-			return fmt.Sprintf("%v\n%v", nftA, nftB)
-		case bytes.Equal(kvA.Key[:1], types.PrefixOwners):
-			idA := types.MustUnMarshalONFTID(cdc, kvA.Value)
-			idB := types.MustUnMarshalDenomID(cdc, kvB.Value)
-			return fmt.Sprintf("%v\n%v", idA, idB)
-		case bytes.Equal(kvA.Key[:1], types.PrefixCollection):
-			supplyA := types.MustUnMarshalSupply(cdc, kvA.Value)
-			supplyB := types.MustUnMarshalSupply(cdc, kvB.Value)
-			return fmt.Sprintf("%d\n%d", supplyA, supplyB)
-		case bytes.Equal(kvA.Key[:1], types.PrefixDenom):
-			var denomA, denomB types.Denom
-			cdc.MustUnmarshal(kvA.Value, &denomA)
-			cdc.MustUnmarshal(kvB.Value, &denomB)
-			return fmt.Sprintf("%v\n%v", denomA, denomB)
-
-		default:
-			panic(fmt.Sprintf("invalid %s key prefix %X", types.ModuleName, kvA.Key[:1]))
+		valueA, err := DecodeValue(cdc, kvA.Key, kvA.Value)
+		if err != nil {
+			panic(err)
 		}
+		valueB, err := DecodeValue(cdc, kvB.Key, kvB.Value)
+		if err != nil {
+			panic(err)
+		}
+		return fmt.Sprintf("%v\n%v", valueA, valueB)
+	}
+}
+
+// PrefixName returns the name of the key prefix of an onft store key, as
+// declared in types/keys.go.
+func PrefixName(key []byte) (string, error) {
+	decoder, err := getValueDecoder(key)
+	if err != nil {
+		return "", err
+	}
+	return decoder.name, nil
+}
+
+// DecodeValue unmarshals a value of the onft store into the type kept under
+// the prefix of its key.
+func DecodeValue(cdc codec.Codec, key, value []byte) (codec.ProtoMarshaler, error) {
+	decoder, err := getValueDecoder(key)
+	if err != nil {
+		return nil, err
+	}
+	msg, err := decoder.decode(cdc, value)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode %s value: %w", decoder.name, err)
+	}
+	return msg, nil
+}
+
+func getValueDecoder(key []byte) (valueDecoder, error) {
+	if len(key) == 0 {
+		return valueDecoder{}, fmt.Errorf("empty %s store key", types.ModuleName)
+	}
+	decoder, ok := valueDecoders[key[0]]
+	if !ok {
+		return valueDecoder{}, fmt.Errorf("invalid %s key prefix %X", types.ModuleName, key[:1])
 	}
+	return decoder, nil
 }
@@ -0,0 +1,108 @@
+package simulation_test
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/OmniFlix/onft"
+	"github.com/OmniFlix/onft/simulation"
+	"github.com/OmniFlix/onft/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	gogotypes "github.com/cosmos/gogoproto/types"
+)
+
+func TestDecodeStore(t *testing.T) {
+	cdc := moduletestutil.MakeTestEncodingConfig(onft.AppModuleBasic{}).Codec
+	dec := simulation.NewDecodeStore(cdc)
+
+	creator := sdk.AccAddress("creator_____________")
+	denom := types.NewDenom("denom1", "sym", "name", "{}", creator, "", "")
+	nft := types.ONFT{Id: "onft1", Owner: creator.String(), CreatedAt: time.Unix(0, 0).UTC()}
+	listing := types.Listing{Id: 1, DenomId: "denom1", OnftId: "onft1", Seller: creator.String(), Price: sdk.NewInt64Coin("uflix", 10)}
+	params := types.DefaultParams()
+	ref := types.NewONFTRef("denom1", "onft1")
+
+	kvPairs := kv.Pairs{Pairs: []kv.Pair{
+		{Key: types.KeyONFT("denom1", "onft1"), Value: cdc.MustMarshal(&nft)},
+		{Key: types.KeyOwner(creator, "denom1", "onft1"), Value: types.MustMarshalONFTID(cdc, "onft1")},
+		{Key: types.KeyCollection("denom1"), Value: types.MustMarshalSupply(cdc, 3)},
+		{Key: types.KeyDenomID("denom1"), Value: cdc.MustMarshal(&denom)},
+		{Key: types.KeyDenomSymbol("sym"), Value: []byte("denom1")},
+		{Key: types.KeyDenomCreator(creator, "denom1"), Value: types.MustMarshalDenomID(cdc, "denom1")},
+		{Key: types.ParamsKey, Value: cdc.MustMarshal(&params)},
+		{Key: types.KeyListing(1), Value: cdc.MustMarshal(&listing)},
+		{Key: types.KeyListingByONFT("denom1", "onft1"), Value: sdk.Uint64ToBigEndian(1)},
+		{Key: types.KeyTokenAccount("denom1", "onft1"), Value: cdc.MustMarshal(&ref)},
+		{Key: []byte{0xFF}, Value: []byte{0x99}},
+	}}
+
+	tests := []struct {
+		name        string
+		expectedLog string
+	}{
+		{"ONFT", fmt.Sprintf("%v\n%v", &nft, &nft)},
+		{"Owners", fmt.Sprintf("%v\n%v", &gogotypes.StringValue{Value: "onft1"}, &gogotypes.StringValue{Value: "onft1"})},
+		{"Collection", fmt.Sprintf("%v\n%v", &gogotypes.UInt64Value{Value: 3}, &gogotypes.UInt64Value{Value: 3})},
+		{"Denom", fmt.Sprintf("%v\n%v", &denom, &denom)},
+		{"DenomSymbol", fmt.Sprintf("%v\n%v", &gogotypes.StringValue{Value: "denom1"}, &gogotypes.StringValue{Value: "denom1"})},
+		{"Creator", fmt.Sprintf("%v\n%v", &gogotypes.StringValue{Value: "denom1"}, &gogotypes.StringValue{Value: "denom1"})},
+		{"Params", fmt.Sprintf("%v\n%v", &params, &params)},
+		{"Listing", fmt.Sprintf("%v\n%v", &listing, &listing)},
+		{"ListingByONFT", fmt.Sprintf("%v\n%v", &gogotypes.UInt64Value{Value: 1}, &gogotypes.UInt64Value{Value: 1})},
+		{"TokenAccount", fmt.Sprintf("%v\n%v", &ref, &ref)},
+		{"other", ""},
+	}
+
+	for i, tt := range tests {
+		i, tt := i, tt
+		t.Run(tt.name, func(t *testing.T) {
+			switch i {
+			case len(tests) - 1:
+				require.Panics(t, func() { dec(kvPairs.Pairs[i], kvPairs.Pairs[i]) }, tt.name)
+			default:
+				require.Equal(t, tt.expectedLog, dec(kvPairs.Pairs[i], kvPairs.Pairs[i]), tt.name)
+			}
+		})
+	}
+}
+
+// TestDecodeValueCoversAllPrefixes makes sure every key prefix declared in
+// types/keys.go has a decoder.
+func TestDecodeValueCoversAllPrefixes(t *testing.T) {
+	file, err := parser.ParseFile(token.NewFileSet(), "../types/keys.go", nil, 0)
+	require.NoError(t, err)
+
+	prefixes := 0
+	ast.Inspect(file, func(node ast.Node) bool {
+		spec, ok := node.(*ast.ValueSpec)
+		if !ok || len(spec.Values) != 1 {
+			return true
+		}
+		lit, ok := spec.Values[0].(*ast.CompositeLit)
+		if !ok || len(lit.Elts) != 1 {
+			return true
+		}
+		value, ok := lit.Elts[0].(*ast.BasicLit)
+		if !ok {
+			return true
+		}
+		prefix, err := strconv.ParseUint(value.Value, 0, 8)
+		require.NoError(t, err)
+
+		name, err := simulation.PrefixName([]byte{byte(prefix)})
+		require.NoError(t, err, spec.Names[0].Name)
+		require.Equal(t, spec.Names[0].Name, name)
+		prefixes++
+		return true
+	})
+	require.Greater(t, prefixes, 0)
+}
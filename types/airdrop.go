@@ -0,0 +1,57 @@
+package types
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// AirdropMetadataHash returns the hex encoded sha256 hash of the metadata of an
+// airdrop leaf, or an empty string when the leaf uses the template metadata.
+func AirdropMetadataHash(metadata *Metadata) string {
+	if metadata == nil {
+		return ""
+	}
+	bz, err := metadata.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	hash := sha256.Sum256(bz)
+	return hex.EncodeToString(hash[:])
+}
+
+// AirdropLeafHash returns the merkle leaf hash of an airdrop recipient
+func AirdropLeafHash(recipient sdk.AccAddress, onftID string, metadata *Metadata) []byte {
+	data := strings.Join([]string{recipient.String(), onftID, AirdropMetadataHash(metadata)}, "/")
+	return MerkleLeafHash([]byte(data))
+}
+
+func (a MintAirdrop) IsExpired(blockTime time.Time) bool {
+	return !blockTime.Before(a.Expiry)
+}
+
+// Validate checks the stateless consistency of a stored airdrop
+func (a MintAirdrop) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(a.Creator); err != nil {
+		return err
+	}
+	if err := ValidateDenomID(a.DenomId); err != nil {
+		return err
+	}
+	if err := ValidateMerkleRoot(a.MerkleRoot); err != nil {
+		return err
+	}
+	return ValidateONFTTemplate(a.Template)
+}
+
+func ValidateMerkleRoot(root string) error {
+	bz, err := hex.DecodeString(root)
+	if err != nil || len(bz) != sha256.Size {
+		return errorsmod.Wrapf(ErrInvalidAirdrop, "invalid merkle root %s", root)
+	}
+	return nil
+}
@@ -0,0 +1,831 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: OmniFlix/onft/v1beta1/airdrop.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// MintAirdrop lets the addresses committed in a merkle tree mint oNFTs of a
+// denom on behalf of its creator. Each leaf commits to the recipient address,
+// the oNFT id and the hash of the oNFT metadata.
+type MintAirdrop struct {
+	Id         uint64       `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Creator    string       `protobuf:"bytes,2,opt,name=creator,proto3" json:"creator,omitempty"`
+	DenomId    string       `protobuf:"bytes,3,opt,name=denom_id,json=denomId,proto3" json:"denom_id,omitempty" yaml:"denom_id"`
+	MerkleRoot string       `protobuf:"bytes,4,opt,name=merkle_root,json=merkleRoot,proto3" json:"merkle_root,omitempty" yaml:"merkle_root"`
+	Template   ONFTTemplate `protobuf:"bytes,5,opt,name=template,proto3" json:"template"`
+	Expiry     time.Time    `protobuf:"bytes,6,opt,name=expiry,proto3,stdtime" json:"expiry"`
+	Claimed    uint64       `protobuf:"varint,7,opt,name=claimed,proto3" json:"claimed,omitempty"`
+}
+
+func (m *MintAirdrop) Reset()         { *m = MintAirdrop{} }
+func (m *MintAirdrop) String() string { return proto.CompactTextString(m) }
+func (*MintAirdrop) ProtoMessage()    {}
+func (*MintAirdrop) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fd746e3a46022161, []int{0}
+}
+func (m *MintAirdrop) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MintAirdrop) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MintAirdrop.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MintAirdrop) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MintAirdrop.Merge(m, src)
+}
+func (m *MintAirdrop) XXX_Size() int {
+	return m.Size()
+}
+func (m *MintAirdrop) XXX_DiscardUnknown() {
+	xxx_messageInfo_MintAirdrop.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MintAirdrop proto.InternalMessageInfo
+
+// AirdropClaimRecord marks a merkle leaf of an airdrop as claimed.
+type AirdropClaimRecord struct {
+	AirdropId uint64 `protobuf:"varint,1,opt,name=airdrop_id,json=airdropId,proto3" json:"airdrop_id,omitempty" yaml:"airdrop_id"`
+	LeafHash  string `protobuf:"bytes,2,opt,name=leaf_hash,json=leafHash,proto3" json:"leaf_hash,omitempty" yaml:"leaf_hash"`
+}
+
+func (m *AirdropClaimRecord) Reset()         { *m = AirdropClaimRecord{} }
+func (m *AirdropClaimRecord) String() string { return proto.CompactTextString(m) }
+func (*AirdropClaimRecord) ProtoMessage()    {}
+func (*AirdropClaimRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fd746e3a46022161, []int{1}
+}
+func (m *AirdropClaimRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AirdropClaimRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AirdropClaimRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AirdropClaimRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AirdropClaimRecord.Merge(m, src)
+}
+func (m *AirdropClaimRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *AirdropClaimRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_AirdropClaimRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AirdropClaimRecord proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MintAirdrop)(nil), "OmniFlix.onft.v1beta1.MintAirdrop")
+	proto.RegisterType((*AirdropClaimRecord)(nil), "OmniFlix.onft.v1beta1.AirdropClaimRecord")
+}
+
+func init() {
+	proto.RegisterFile("OmniFlix/onft/v1beta1/airdrop.proto", fileDescriptor_fd746e3a46022161)
+}
+
+var fileDescriptor_fd746e3a46022161 = []byte{
+	// 452 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x92, 0x3f, 0x8f, 0xd3, 0x30,
+	0x18, 0xc6, 0xe3, 0x52, 0xfa, 0xc7, 0x95, 0xf8, 0x63, 0xee, 0x50, 0xd4, 0x21, 0x29, 0xb9, 0xa5,
+	0x93, 0xa3, 0x02, 0x12, 0xd2, 0xe9, 0x16, 0x82, 0x38, 0x71, 0x03, 0x9c, 0x64, 0x75, 0x62, 0xa9,
+	0xdc, 0xda, 0x4d, 0x2d, 0xe2, 0x3a, 0x72, 0x7d, 0xe8, 0x3a, 0xf3, 0x05, 0xee, 0x23, 0xf0, 0x65,
+	0x90, 0x3a, 0xde, 0xc8, 0x14, 0xa0, 0x5d, 0x98, 0xfb, 0x09, 0x50, 0xec, 0xe4, 0x00, 0xa9, 0xdb,
+	0xfb, 0xe8, 0x79, 0x9e, 0x37, 0x6f, 0x7e, 0x09, 0x3c, 0xb9, 0x94, 0x4b, 0x71, 0x9e, 0x89, 0xeb,
+	0x58, 0x2d, 0xe7, 0x26, 0xfe, 0x3c, 0x9a, 0x72, 0x43, 0x47, 0x31, 0x15, 0x9a, 0x69, 0x95, 0xe3,
+	0x5c, 0x2b, 0xa3, 0xd0, 0x71, 0x1d, 0xc2, 0x65, 0x08, 0x57, 0xa1, 0xfe, 0x51, 0xaa, 0x52, 0x65,
+	0x13, 0x71, 0x39, 0xb9, 0x70, 0x3f, 0x4c, 0x95, 0x4a, 0x33, 0x1e, 0x5b, 0x35, 0xbd, 0x9a, 0xc7,
+	0x46, 0x48, 0xbe, 0x32, 0x54, 0x56, 0xdb, 0xfa, 0xcf, 0x0e, 0x3f, 0x72, 0x96, 0x51, 0x21, 0x5d,
+	0x24, 0xfa, 0xd6, 0x80, 0xbd, 0xf7, 0x62, 0x69, 0x5e, 0xbb, 0x33, 0xd0, 0x03, 0xd8, 0x10, 0xcc,
+	0x07, 0x03, 0x30, 0x6c, 0x92, 0x86, 0x60, 0xc8, 0x87, 0xed, 0x99, 0xe6, 0xd4, 0x28, 0xed, 0x37,
+	0x06, 0x60, 0xd8, 0x25, 0xb5, 0x44, 0x18, 0x76, 0x18, 0x5f, 0x2a, 0x39, 0x11, 0xcc, 0xbf, 0x57,
+	0x5a, 0xc9, 0x93, 0x7d, 0x11, 0x3e, 0x5c, 0x53, 0x99, 0x9d, 0x46, 0xb5, 0x13, 0x91, 0xb6, 0x1d,
+	0x2f, 0x18, 0x7a, 0x05, 0x7b, 0x92, 0xeb, 0x4f, 0x19, 0x9f, 0x68, 0xa5, 0x8c, 0xdf, 0xb4, 0x95,
+	0xa7, 0xfb, 0x22, 0x44, 0xae, 0xf2, 0x8f, 0x19, 0x11, 0xe8, 0x14, 0x51, 0xca, 0xa0, 0xb7, 0xb0,
+	0x63, 0xb8, 0xcc, 0x33, 0x6a, 0xb8, 0x7f, 0x7f, 0x00, 0x86, 0xbd, 0xe7, 0x27, 0xf8, 0x20, 0x26,
+	0x7c, 0xf9, 0xe1, 0x7c, 0x3c, 0xae, 0xa2, 0x49, 0x73, 0x53, 0x84, 0x1e, 0xb9, 0xab, 0xa2, 0x33,
+	0xd8, 0xe2, 0xd7, 0xb9, 0xd0, 0x6b, 0xbf, 0x65, 0x97, 0xf4, 0xb1, 0xc3, 0x87, 0x6b, 0x7c, 0x78,
+	0x5c, 0xe3, 0x4b, 0x3a, 0x65, 0xf7, 0xe6, 0x47, 0x08, 0x48, 0xd5, 0xb1, 0x1c, 0x4a, 0x6c, 0x9c,
+	0xf9, 0x6d, 0x0b, 0xa7, 0x96, 0xa7, 0xcd, 0xdf, 0x5f, 0x43, 0x10, 0x7d, 0x01, 0x10, 0x55, 0x0c,
+	0xdf, 0x94, 0x06, 0xe1, 0x33, 0xa5, 0x19, 0x7a, 0x09, 0x61, 0xf5, 0x81, 0x27, 0x35, 0xd6, 0xe4,
+	0x78, 0x5f, 0x84, 0x8f, 0xdd, 0x3b, 0xff, 0xf5, 0x22, 0xd2, 0xad, 0xc4, 0x05, 0x43, 0x23, 0xd8,
+	0xcd, 0x38, 0x9d, 0x4f, 0x16, 0x74, 0xb5, 0x70, 0xd8, 0x93, 0xa3, 0x7d, 0x11, 0x3e, 0x72, 0xa5,
+	0x3b, 0x2b, 0x22, 0x9d, 0x72, 0x7e, 0x47, 0x57, 0x0b, 0x77, 0x45, 0x72, 0xb6, 0xf9, 0x15, 0x78,
+	0x9b, 0x6d, 0x00, 0x6e, 0xb7, 0x01, 0xf8, 0xb9, 0x0d, 0xc0, 0xcd, 0x2e, 0xf0, 0x6e, 0x77, 0x81,
+	0xf7, 0x7d, 0x17, 0x78, 0x1f, 0x83, 0x54, 0x98, 0xc5, 0xd5, 0x14, 0xcf, 0x94, 0x8c, 0xff, 0xff,
+	0x33, 0xcc, 0x3a, 0xe7, 0xab, 0x69, 0xcb, 0x92, 0x78, 0xf1, 0x67, 0x00, 0x76, 0xe3, 0xbc, 0x31,
+	0xaa, 0x02, 0x00, 0x00,
+}
+
+func (this *MintAirdrop) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MintAirdrop)
+	if !ok {
+		that2, ok := that.(MintAirdrop)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Id != that1.Id {
+		return false
+	}
+	if this.Creator != that1.Creator {
+		return false
+	}
+	if this.DenomId != that1.DenomId {
+		return false
+	}
+	if this.MerkleRoot != that1.MerkleRoot {
+		return false
+	}
+	if !this.Template.Equal(&that1.Template) {
+		return false
+	}
+	if !this.Expiry.Equal(that1.Expiry) {
+		return false
+	}
+	if this.Claimed != that1.Claimed {
+		return false
+	}
+	return true
+}
+func (this *AirdropClaimRecord) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AirdropClaimRecord)
+	if !ok {
+		that2, ok := that.(AirdropClaimRecord)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.AirdropId != that1.AirdropId {
+		return false
+	}
+	if this.LeafHash != that1.LeafHash {
+		return false
+	}
+	return true
+}
+func (m *MintAirdrop) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MintAirdrop) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MintAirdrop) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Claimed != 0 {
+		i = encodeVarintAirdrop(dAtA, i, uint64(m.Claimed))
+		i--
+		dAtA[i] = 0x38
+	}
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Expiry, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Expiry):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintAirdrop(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x32
+	{
+		size, err := m.Template.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintAirdrop(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.MerkleRoot) > 0 {
+		i -= len(m.MerkleRoot)
+		copy(dAtA[i:], m.MerkleRoot)
+		i = encodeVarintAirdrop(dAtA, i, uint64(len(m.MerkleRoot)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.DenomId) > 0 {
+		i -= len(m.DenomId)
+		copy(dAtA[i:], m.DenomId)
+		i = encodeVarintAirdrop(dAtA, i, uint64(len(m.DenomId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintAirdrop(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintAirdrop(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AirdropClaimRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AirdropClaimRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AirdropClaimRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.LeafHash) > 0 {
+		i -= len(m.LeafHash)
+		copy(dAtA[i:], m.LeafHash)
+		i = encodeVarintAirdrop(dAtA, i, uint64(len(m.LeafHash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.AirdropId != 0 {
+		i = encodeVarintAirdrop(dAtA, i, uint64(m.AirdropId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintAirdrop(dAtA []byte, offset int, v uint64) int {
+	offset -= sovAirdrop(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MintAirdrop) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovAirdrop(uint64(m.Id))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovAirdrop(uint64(l))
+	}
+	l = len(m.DenomId)
+	if l > 0 {
+		n += 1 + l + sovAirdrop(uint64(l))
+	}
+	l = len(m.MerkleRoot)
+	if l > 0 {
+		n += 1 + l + sovAirdrop(uint64(l))
+	}
+	l = m.Template.Size()
+	n += 1 + l + sovAirdrop(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Expiry)
+	n += 1 + l + sovAirdrop(uint64(l))
+	if m.Claimed != 0 {
+		n += 1 + sovAirdrop(uint64(m.Claimed))
+	}
+	return n
+}
+
+func (m *AirdropClaimRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AirdropId != 0 {
+		n += 1 + sovAirdrop(uint64(m.AirdropId))
+	}
+	l = len(m.LeafHash)
+	if l > 0 {
+		n += 1 + l + sovAirdrop(uint64(l))
+	}
+	return n
+}
+
+func sovAirdrop(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozAirdrop(x uint64) (n int) {
+	return sovAirdrop(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MintAirdrop) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAirdrop
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MintAirdrop: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MintAirdrop: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAirdrop
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAirdrop
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAirdrop
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAirdrop
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAirdrop
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAirdrop
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAirdrop
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MerkleRoot", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAirdrop
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAirdrop
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAirdrop
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MerkleRoot = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAirdrop
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAirdrop
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAirdrop
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Template.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiry", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAirdrop
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAirdrop
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAirdrop
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Expiry, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Claimed", wireType)
+			}
+			m.Claimed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAirdrop
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Claimed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAirdrop(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAirdrop
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AirdropClaimRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAirdrop
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AirdropClaimRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AirdropClaimRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AirdropId", wireType)
+			}
+			m.AirdropId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAirdrop
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AirdropId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LeafHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAirdrop
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAirdrop
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAirdrop
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LeafHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAirdrop(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAirdrop
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipAirdrop(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowAirdrop
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAirdrop
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAirdrop
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthAirdrop
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupAirdrop
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthAirdrop
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthAirdrop        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowAirdrop          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupAirdrop = fmt.Errorf("proto: unexpected end of group")
+)
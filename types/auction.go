@@ -0,0 +1,151 @@
+package types
+
+import (
+	"strings"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ParseAuctionType parses the short name of an auction type
+func ParseAuctionType(name string) (AuctionType, error) {
+	switch strings.ToLower(strings.TrimSpace(name)) {
+	case "english":
+		return AuctionTypeEnglish, nil
+	case "dutch":
+		return AuctionTypeDutch, nil
+	}
+	return AuctionTypeUnspecified, errorsmod.Wrapf(ErrInvalidAuction, "unknown auction type %s", name)
+}
+
+// ParseAuctionStatus parses the short name of an auction status
+func ParseAuctionStatus(name string) (AuctionStatus, error) {
+	switch strings.ToLower(strings.TrimSpace(name)) {
+	case "":
+		return AuctionStatusUnspecified, nil
+	case "active":
+		return AuctionStatusActive, nil
+	case "sold":
+		return AuctionStatusSold, nil
+	case "unsold":
+		return AuctionStatusUnsold, nil
+	case "cancelled":
+		return AuctionStatusCancelled, nil
+	}
+	return AuctionStatusUnspecified, errorsmod.Wrapf(ErrInvalidAuction, "unknown auction status %s", name)
+}
+
+// ValidateTerms checks the stateless consistency of the terms of an auction
+func (a Auction) ValidateTerms() error {
+	if err := ValidateDenomID(a.DenomId); err != nil {
+		return err
+	}
+	if err := ValidateONFTID(a.OnftId); err != nil {
+		return err
+	}
+	if !a.StartPrice.IsValid() || !a.StartPrice.IsPositive() {
+		return errorsmod.Wrapf(ErrInvalidAuction, "invalid start price %s, must be positive", a.StartPrice)
+	}
+	if a.EndTime.IsZero() {
+		return errorsmod.Wrap(ErrInvalidAuction, "end time is required")
+	}
+	if !a.StartTime.IsZero() && !a.EndTime.After(a.StartTime) {
+		return errorsmod.Wrapf(ErrInvalidAuction, "end time %s must be after start time %s", a.EndTime, a.StartTime)
+	}
+	if a.ExtensionWindow < 0 || a.DecrementInterval < 0 {
+		return errorsmod.Wrap(ErrInvalidAuction, "durations can not be negative")
+	}
+
+	switch a.AuctionType {
+	case AuctionTypeEnglish:
+		if a.MinIncrement.IsNil() || a.MinIncrement.IsNegative() {
+			return errorsmod.Wrap(ErrInvalidAuction, "min increment can not be negative")
+		}
+		if !a.FloorPrice.IsNil() && !a.FloorPrice.IsZero() {
+			return errorsmod.Wrap(ErrInvalidAuction, "floor price is only valid for dutch auctions")
+		}
+		if !a.Decrement.IsNil() && !a.Decrement.IsZero() || a.DecrementInterval != 0 {
+			return errorsmod.Wrap(ErrInvalidAuction, "decrement is only valid for dutch auctions")
+		}
+	case AuctionTypeDutch:
+		if !a.FloorPrice.IsValid() || !a.FloorPrice.IsPositive() || a.FloorPrice.Denom != a.StartPrice.Denom {
+			return errorsmod.Wrapf(ErrInvalidAuction, "invalid floor price %s, must be positive in %s", a.FloorPrice, a.StartPrice.Denom)
+		}
+		if !a.FloorPrice.IsLT(a.StartPrice) {
+			return errorsmod.Wrapf(ErrInvalidAuction, "floor price %s must be less than start price %s", a.FloorPrice, a.StartPrice)
+		}
+		if a.Decrement.IsNil() || !a.Decrement.IsPositive() || a.DecrementInterval <= 0 {
+			return errorsmod.Wrap(ErrInvalidAuction, "decrement and decrement interval must be positive")
+		}
+		if !a.MinIncrement.IsNil() && !a.MinIncrement.IsZero() || a.ExtensionWindow != 0 {
+			return errorsmod.Wrap(ErrInvalidAuction, "min increment and extension window are only valid for english auctions")
+		}
+	default:
+		return errorsmod.Wrapf(ErrInvalidAuction, "invalid auction type %s", a.AuctionType)
+	}
+	return nil
+}
+
+// Validate checks the stateless consistency of a stored auction
+func (a Auction) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(a.Seller); err != nil {
+		return err
+	}
+	if a.Status == AuctionStatusUnspecified {
+		return errorsmod.Wrapf(ErrInvalidAuction, "auction %d has no status", a.Id)
+	}
+	if a.HighestBid != nil && a.HighestBid.AuctionId != a.Id {
+		return errorsmod.Wrapf(ErrInvalidAuction, "highest bid of auction %d belongs to auction %d", a.Id, a.HighestBid.AuctionId)
+	}
+	return a.ValidateTerms()
+}
+
+// IsOpen returns true if the auction accepts bids at the given time
+func (a Auction) IsOpen(blockTime time.Time) bool {
+	return a.Status == AuctionStatusActive && !blockTime.Before(a.StartTime) && blockTime.Before(a.EndTime)
+}
+
+// CurrentPrice returns the lowest amount a bid must pay at the given time. For
+// English auctions this is the start price or the highest bid plus the minimum
+// increment, at least one unit so a bid has to outbid the highest one. Dutch
+// auctions lower the start price by the decrement for every elapsed interval
+// down to the floor price.
+func (a Auction) CurrentPrice(blockTime time.Time) sdk.Coin {
+	if a.AuctionType == AuctionTypeDutch {
+		if !blockTime.After(a.StartTime) || a.DecrementInterval <= 0 || a.Decrement.IsNil() || !a.Decrement.IsPositive() {
+			return a.StartPrice
+		}
+		// the price reaches the floor after this many steps, clamping the steps
+		// keeps the decrement of a long elapsed auction from overflowing
+		floorSteps := a.StartPrice.Amount.Sub(a.FloorPrice.Amount).Quo(a.Decrement).AddRaw(1)
+		steps := sdk.NewInt(int64(blockTime.Sub(a.StartTime) / a.DecrementInterval))
+		if steps.GT(floorSteps) {
+			steps = floorSteps
+		}
+		price := a.StartPrice.Amount.Sub(a.Decrement.Mul(steps))
+		if price.LT(a.FloorPrice.Amount) {
+			return a.FloorPrice
+		}
+		return sdk.NewCoin(a.StartPrice.Denom, price)
+	}
+	if a.HighestBid == nil {
+		return a.StartPrice
+	}
+	increment := a.MinIncrement
+	if increment.IsNil() || !increment.IsPositive() {
+		increment = sdk.OneInt()
+	}
+	return a.HighestBid.Amount.AddAmount(increment)
+}
+
+// Validate checks the stateless consistency of a bid
+func (b Bid) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(b.Bidder); err != nil {
+		return err
+	}
+	if !b.Amount.IsValid() || !b.Amount.IsPositive() {
+		return errorsmod.Wrapf(ErrInvalidBid, "invalid bid amount %s, must be positive", b.Amount)
+	}
+	return nil
+}
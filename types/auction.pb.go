@@ -0,0 +1,1444 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: OmniFlix/onft/v1beta1/auction.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// AuctionType is the price discovery mechanism of an auction
+type AuctionType int32
+
+const (
+	AuctionTypeUnspecified AuctionType = 0
+	// AUCTION_TYPE_ENGLISH sells to the highest bid at the end of the auction
+	AuctionTypeEnglish AuctionType = 1
+	// AUCTION_TYPE_DUTCH sells to the first bid at the current, decreasing price
+	AuctionTypeDutch AuctionType = 2
+)
+
+var AuctionType_name = map[int32]string{
+	0: "AUCTION_TYPE_UNSPECIFIED",
+	1: "AUCTION_TYPE_ENGLISH",
+	2: "AUCTION_TYPE_DUTCH",
+}
+
+var AuctionType_value = map[string]int32{
+	"AUCTION_TYPE_UNSPECIFIED": 0,
+	"AUCTION_TYPE_ENGLISH":     1,
+	"AUCTION_TYPE_DUTCH":       2,
+}
+
+func (x AuctionType) String() string {
+	return proto.EnumName(AuctionType_name, int32(x))
+}
+
+func (AuctionType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_e428cbff9a14558f, []int{0}
+}
+
+// AuctionStatus is the state of an auction
+type AuctionStatus int32
+
+const (
+	AuctionStatusUnspecified AuctionStatus = 0
+	AuctionStatusActive      AuctionStatus = 1
+	AuctionStatusSold        AuctionStatus = 2
+	AuctionStatusUnsold      AuctionStatus = 3
+	AuctionStatusCancelled   AuctionStatus = 4
+)
+
+var AuctionStatus_name = map[int32]string{
+	0: "AUCTION_STATUS_UNSPECIFIED",
+	1: "AUCTION_STATUS_ACTIVE",
+	2: "AUCTION_STATUS_SOLD",
+	3: "AUCTION_STATUS_UNSOLD",
+	4: "AUCTION_STATUS_CANCELLED",
+}
+
+var AuctionStatus_value = map[string]int32{
+	"AUCTION_STATUS_UNSPECIFIED": 0,
+	"AUCTION_STATUS_ACTIVE":      1,
+	"AUCTION_STATUS_SOLD":        2,
+	"AUCTION_STATUS_UNSOLD":      3,
+	"AUCTION_STATUS_CANCELLED":   4,
+}
+
+func (x AuctionStatus) String() string {
+	return proto.EnumName(AuctionStatus_name, int32(x))
+}
+
+func (AuctionStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_e428cbff9a14558f, []int{1}
+}
+
+// Auction sells an escrowed oNFT over a time window. English auctions use
+// start_price as the reserve price, min_increment between bids and extend the
+// end time by extension_window for late bids. Dutch auctions lower the price
+// from start_price by decrement every decrement_interval down to floor_price.
+type Auction struct {
+	Id                uint64                                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	AuctionType       AuctionType                            `protobuf:"varint,2,opt,name=auction_type,json=auctionType,proto3,enum=OmniFlix.onft.v1beta1.AuctionType" json:"auction_type,omitempty" yaml:"auction_type"`
+	Status            AuctionStatus                          `protobuf:"varint,3,opt,name=status,proto3,enum=OmniFlix.onft.v1beta1.AuctionStatus" json:"status,omitempty"`
+	DenomId           string                                 `protobuf:"bytes,4,opt,name=denom_id,json=denomId,proto3" json:"denom_id,omitempty" yaml:"denom_id"`
+	OnftId            string                                 `protobuf:"bytes,5,opt,name=onft_id,json=onftId,proto3" json:"onft_id,omitempty" yaml:"onft_id"`
+	Seller            string                                 `protobuf:"bytes,6,opt,name=seller,proto3" json:"seller,omitempty"`
+	StartPrice        types.Coin                             `protobuf:"bytes,7,opt,name=start_price,json=startPrice,proto3" json:"start_price" yaml:"start_price"`
+	FloorPrice        types.Coin                             `protobuf:"bytes,8,opt,name=floor_price,json=floorPrice,proto3" json:"floor_price" yaml:"floor_price"`
+	MinIncrement      github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,9,opt,name=min_increment,json=minIncrement,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"min_increment" yaml:"min_increment"`
+	Decrement         github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,10,opt,name=decrement,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"decrement"`
+	DecrementInterval time.Duration                          `protobuf:"bytes,11,opt,name=decrement_interval,json=decrementInterval,proto3,stdduration" json:"decrement_interval" yaml:"decrement_interval"`
+	ExtensionWindow   time.Duration                          `protobuf:"bytes,12,opt,name=extension_window,json=extensionWindow,proto3,stdduration" json:"extension_window" yaml:"extension_window"`
+	StartTime         time.Time                              `protobuf:"bytes,13,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time" yaml:"start_time"`
+	EndTime           time.Time                              `protobuf:"bytes,14,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time" yaml:"end_time"`
+	HighestBid        *Bid                                   `protobuf:"bytes,15,opt,name=highest_bid,json=highestBid,proto3" json:"highest_bid,omitempty" yaml:"highest_bid"`
+	BidCount          uint64                                 `protobuf:"varint,16,opt,name=bid_count,json=bidCount,proto3" json:"bid_count,omitempty" yaml:"bid_count"`
+}
+
+func (m *Auction) Reset()         { *m = Auction{} }
+func (m *Auction) String() string { return proto.CompactTextString(m) }
+func (*Auction) ProtoMessage()    {}
+func (*Auction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e428cbff9a14558f, []int{0}
+}
+func (m *Auction) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Auction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Auction.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Auction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Auction.Merge(m, src)
+}
+func (m *Auction) XXX_Size() int {
+	return m.Size()
+}
+func (m *Auction) XXX_DiscardUnknown() {
+	xxx_messageInfo_Auction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Auction proto.InternalMessageInfo
+
+// Bid is a bid placed on an auction
+type Bid struct {
+	AuctionId uint64     `protobuf:"varint,1,opt,name=auction_id,json=auctionId,proto3" json:"auction_id,omitempty" yaml:"auction_id"`
+	Bidder    string     `protobuf:"bytes,2,opt,name=bidder,proto3" json:"bidder,omitempty"`
+	Amount    types.Coin `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount"`
+	Time      time.Time  `protobuf:"bytes,4,opt,name=time,proto3,stdtime" json:"time"`
+}
+
+func (m *Bid) Reset()         { *m = Bid{} }
+func (m *Bid) String() string { return proto.CompactTextString(m) }
+func (*Bid) ProtoMessage()    {}
+func (*Bid) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e428cbff9a14558f, []int{1}
+}
+func (m *Bid) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Bid) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Bid.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Bid) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Bid.Merge(m, src)
+}
+func (m *Bid) XXX_Size() int {
+	return m.Size()
+}
+func (m *Bid) XXX_DiscardUnknown() {
+	xxx_messageInfo_Bid.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Bid proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterEnum("OmniFlix.onft.v1beta1.AuctionType", AuctionType_name, AuctionType_value)
+	proto.RegisterEnum("OmniFlix.onft.v1beta1.AuctionStatus", AuctionStatus_name, AuctionStatus_value)
+	proto.RegisterType((*Auction)(nil), "OmniFlix.onft.v1beta1.Auction")
+	proto.RegisterType((*Bid)(nil), "OmniFlix.onft.v1beta1.Bid")
+}
+
+func init() {
+	proto.RegisterFile("OmniFlix/onft/v1beta1/auction.proto", fileDescriptor_e428cbff9a14558f)
+}
+
+var fileDescriptor_e428cbff9a14558f = []byte{
+	// 1014 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x56, 0xcb, 0x6f, 0xe2, 0x46,
+	0x18, 0xc7, 0x84, 0x92, 0x30, 0xe4, 0x41, 0x26, 0x2f, 0xaf, 0xdb, 0xda, 0xc8, 0xdb, 0x56, 0xd1,
+	0xb6, 0x35, 0x4d, 0x5a, 0xa9, 0xab, 0x55, 0x2e, 0x18, 0x48, 0x17, 0x29, 0x4a, 0x22, 0x03, 0xdb,
+	0xc7, 0xa1, 0xc8, 0x78, 0x26, 0x30, 0x5a, 0x6c, 0x23, 0x6c, 0xb2, 0x9b, 0xff, 0xa0, 0xe2, 0xb4,
+	0xc7, 0x5e, 0x90, 0x2a, 0x55, 0x3d, 0x56, 0xfd, 0x37, 0x72, 0xe8, 0x61, 0x8f, 0x55, 0x0f, 0xb4,
+	0x4d, 0x2e, 0x3d, 0xf3, 0x17, 0x54, 0xf3, 0x30, 0x18, 0x1a, 0x6d, 0xb4, 0xa7, 0xcc, 0x37, 0xf3,
+	0x7b, 0x7c, 0xfe, 0xe6, 0x9b, 0x2f, 0x80, 0x87, 0x67, 0xae, 0x47, 0x8e, 0xbb, 0xe4, 0x65, 0xc1,
+	0xf7, 0x2e, 0xc2, 0xc2, 0xe5, 0x41, 0x0b, 0x87, 0xf6, 0x41, 0xc1, 0x1e, 0x38, 0x21, 0xf1, 0x3d,
+	0xa3, 0xd7, 0xf7, 0x43, 0x1f, 0xee, 0x44, 0x20, 0x83, 0x82, 0x0c, 0x01, 0x52, 0xb6, 0xdb, 0x7e,
+	0xdb, 0x67, 0x88, 0x02, 0x5d, 0x71, 0xb0, 0xa2, 0xb5, 0x7d, 0xbf, 0xdd, 0xc5, 0x05, 0x16, 0xb5,
+	0x06, 0x17, 0x85, 0x90, 0xb8, 0x38, 0x08, 0x6d, 0xb7, 0x27, 0x00, 0xea, 0x22, 0x00, 0x0d, 0xfa,
+	0xf6, 0xcc, 0x4d, 0x51, 0x1d, 0x3f, 0x70, 0xfd, 0xa0, 0xd0, 0xb2, 0x03, 0x3c, 0x4d, 0xc8, 0xf1,
+	0x89, 0x38, 0xd7, 0x7f, 0xcb, 0x80, 0xe5, 0x22, 0xcf, 0x0f, 0xae, 0x83, 0x24, 0x41, 0xb2, 0x94,
+	0x97, 0xf6, 0x53, 0x56, 0x92, 0x20, 0xf8, 0x3d, 0x58, 0x15, 0xa9, 0x37, 0xc3, 0xab, 0x1e, 0x96,
+	0x93, 0x79, 0x69, 0x7f, 0xfd, 0x50, 0x37, 0xee, 0xfc, 0x00, 0x43, 0xa8, 0xd4, 0xaf, 0x7a, 0xd8,
+	0xdc, 0x9b, 0x8c, 0xb5, 0xad, 0x2b, 0xdb, 0xed, 0x3e, 0xd1, 0xe3, 0x0a, 0xba, 0x95, 0xb5, 0x67,
+	0x28, 0x78, 0x04, 0xd2, 0x41, 0x68, 0x87, 0x83, 0x40, 0x5e, 0x62, 0xca, 0x1f, 0xbc, 0x59, 0xb9,
+	0xc6, 0xb0, 0x96, 0xe0, 0x40, 0x03, 0xac, 0x20, 0xec, 0xf9, 0x6e, 0x93, 0x20, 0x39, 0x95, 0x97,
+	0xf6, 0x33, 0xe6, 0xd6, 0x64, 0xac, 0x6d, 0x70, 0xd7, 0xe8, 0x44, 0xb7, 0x96, 0xd9, 0xb2, 0x8a,
+	0xe0, 0xc7, 0x60, 0x99, 0xaa, 0x52, 0xf8, 0x3b, 0x0c, 0x0e, 0x27, 0x63, 0x6d, 0x9d, 0xc3, 0xc5,
+	0x81, 0x6e, 0xa5, 0xe9, 0xaa, 0x8a, 0xe0, 0x2e, 0x48, 0x07, 0xb8, 0xdb, 0xc5, 0x7d, 0x39, 0x4d,
+	0xb1, 0x96, 0x88, 0xe0, 0x33, 0x90, 0x0d, 0x42, 0xbb, 0x1f, 0x36, 0x7b, 0x7d, 0xe2, 0x60, 0x79,
+	0x39, 0x2f, 0xed, 0x67, 0x0f, 0x1f, 0x18, 0xbc, 0xc8, 0x06, 0x2d, 0xf2, 0x34, 0xeb, 0x92, 0x4f,
+	0x3c, 0x53, 0xb9, 0x1e, 0x6b, 0x89, 0xc9, 0x58, 0x83, 0xdc, 0x27, 0xc6, 0xd5, 0x2d, 0xc0, 0xa2,
+	0x73, 0x1a, 0x50, 0xdd, 0x8b, 0xae, 0xef, 0xf7, 0x85, 0xee, 0xca, 0x5b, 0xea, 0xc6, 0xb8, 0xba,
+	0x05, 0x58, 0xc4, 0x75, 0x9f, 0x83, 0x35, 0x97, 0x78, 0x4d, 0xe2, 0x39, 0x7d, 0xec, 0x62, 0x2f,
+	0x94, 0x33, 0xec, 0xd3, 0x8f, 0x29, 0xfd, 0xcf, 0xb1, 0xf6, 0x51, 0x9b, 0x84, 0x9d, 0x41, 0xcb,
+	0x70, 0x7c, 0xb7, 0x20, 0x1a, 0x85, 0xff, 0xf9, 0x34, 0x40, 0xcf, 0x0b, 0xf4, 0xca, 0x02, 0xa3,
+	0xea, 0x85, 0x93, 0xb1, 0xb6, 0xcd, 0x8d, 0xe6, 0xc4, 0x74, 0x6b, 0xd5, 0x25, 0x5e, 0x35, 0x0a,
+	0xe1, 0x09, 0xc8, 0x20, 0x1c, 0x19, 0x01, 0x66, 0x64, 0xbc, 0x9d, 0x91, 0x35, 0x13, 0x80, 0x3e,
+	0x80, 0xd3, 0xa0, 0x49, 0xbc, 0x10, 0xf7, 0x2f, 0xed, 0xae, 0x9c, 0x15, 0x95, 0xe1, 0x6d, 0x6f,
+	0x44, 0x6d, 0x6f, 0x94, 0x45, 0xdb, 0x9b, 0x1f, 0x8a, 0xca, 0x3c, 0x88, 0x1a, 0x61, 0x51, 0x42,
+	0xff, 0xf1, 0x2f, 0x4d, 0xb2, 0x36, 0xa7, 0x07, 0x55, 0xb1, 0x0f, 0x09, 0xc8, 0xe1, 0x97, 0x21,
+	0xf6, 0x02, 0xda, 0xae, 0x2f, 0x88, 0x87, 0xfc, 0x17, 0xf2, 0xea, 0x7d, 0x76, 0x0f, 0x85, 0xdd,
+	0x1e, 0xb7, 0x5b, 0x14, 0xe0, 0x66, 0x1b, 0xd3, 0xed, 0xaf, 0xd9, 0x2e, 0xfc, 0x06, 0xf0, 0xcb,
+	0x6f, 0xd2, 0xe7, 0x2c, 0xaf, 0x31, 0x13, 0xe5, 0x7f, 0x26, 0xf5, 0xe8, 0xad, 0x9b, 0xef, 0x0b,
+	0x97, 0xcd, 0x78, 0x1b, 0x51, 0xae, 0xfe, 0x8a, 0xea, 0x67, 0xd8, 0x06, 0x85, 0x43, 0x0b, 0xac,
+	0x60, 0x0f, 0x71, 0xdd, 0xf5, 0x7b, 0x75, 0xdf, 0x15, 0xba, 0xe2, 0xd5, 0x44, 0x4c, 0xae, 0xba,
+	0x8c, 0x3d, 0xc4, 0x34, 0x6b, 0x20, 0xdb, 0x21, 0xed, 0x0e, 0x0e, 0xc2, 0x66, 0x8b, 0x20, 0x79,
+	0x43, 0xc8, 0xde, 0xfd, 0x58, 0x4d, 0x82, 0xcc, 0xdd, 0x59, 0x67, 0xc6, 0x88, 0xba, 0x05, 0x44,
+	0x64, 0x12, 0x04, 0x0f, 0x40, 0xa6, 0x45, 0x50, 0xd3, 0xf1, 0x07, 0x5e, 0x28, 0xe7, 0xe8, 0xcc,
+	0x31, 0xb7, 0x27, 0x63, 0x2d, 0xc7, 0x69, 0xd3, 0x23, 0xdd, 0x5a, 0x69, 0x11, 0x54, 0xa2, 0xcb,
+	0x27, 0xa9, 0x7f, 0x7f, 0xd2, 0x24, 0xfd, 0x77, 0x09, 0x2c, 0x51, 0x81, 0x2f, 0x00, 0x88, 0x66,
+	0x4b, 0x34, 0xb5, 0xcc, 0x9d, 0x59, 0x8d, 0x66, 0x67, 0xba, 0x95, 0x11, 0x01, 0x7f, 0xd8, 0x2d,
+	0x82, 0x10, 0xee, 0xb3, 0x69, 0x96, 0xb1, 0x44, 0x04, 0xbf, 0x04, 0x69, 0xdb, 0x65, 0xb9, 0x2c,
+	0xdd, 0xf7, 0xf6, 0x52, 0xb4, 0x68, 0x96, 0x80, 0xc3, 0xc7, 0x20, 0xc5, 0x8a, 0x9d, 0xba, 0xb7,
+	0xd8, 0x2b, 0x94, 0xc7, 0x2a, 0xcb, 0x18, 0xfc, 0x73, 0x1e, 0xfd, 0x2a, 0x81, 0x6c, 0x6c, 0x74,
+	0xc2, 0xc7, 0x40, 0x2e, 0x36, 0x4a, 0xf5, 0xea, 0xd9, 0x69, 0xb3, 0xfe, 0xed, 0x79, 0xa5, 0xd9,
+	0x38, 0xad, 0x9d, 0x57, 0x4a, 0xd5, 0xe3, 0x6a, 0xa5, 0x9c, 0x4b, 0x28, 0xca, 0x70, 0x94, 0xdf,
+	0x8d, 0xc1, 0x1b, 0x5e, 0xd0, 0xc3, 0x0e, 0xb9, 0x20, 0x18, 0xc1, 0xcf, 0xc0, 0xf6, 0x1c, 0xb3,
+	0x72, 0xfa, 0xd5, 0x49, 0xb5, 0xf6, 0x34, 0x27, 0x29, 0xbb, 0xc3, 0x51, 0x1e, 0xc6, 0x58, 0x15,
+	0xaf, 0xdd, 0x25, 0x41, 0x07, 0x7e, 0x02, 0xe0, 0x1c, 0xa3, 0xdc, 0xa8, 0x97, 0x9e, 0xe6, 0x92,
+	0xca, 0xf6, 0x70, 0x94, 0xcf, 0xc5, 0xf0, 0xe5, 0x41, 0xe8, 0x74, 0x94, 0xd4, 0x0f, 0x3f, 0xab,
+	0x89, 0x47, 0xbf, 0x24, 0xc1, 0xda, 0xdc, 0x40, 0x86, 0x47, 0x40, 0x89, 0x54, 0x6a, 0xf5, 0x62,
+	0xbd, 0x51, 0x5b, 0xc8, 0xf9, 0xbd, 0xe1, 0x28, 0x2f, 0xcf, 0x51, 0xe2, 0x59, 0x1f, 0x82, 0x9d,
+	0x05, 0x76, 0xb1, 0x54, 0xaf, 0x3e, 0xab, 0xe4, 0x24, 0x65, 0x6f, 0x38, 0xca, 0x6f, 0xcd, 0x11,
+	0x8b, 0x4e, 0x48, 0x2e, 0x31, 0x34, 0xc0, 0xd6, 0x02, 0xa7, 0x76, 0x76, 0x52, 0xce, 0x25, 0x95,
+	0x9d, 0xe1, 0x28, 0xbf, 0x39, 0xc7, 0xa8, 0xf9, 0xdd, 0xbb, 0x3c, 0x1a, 0xa7, 0x8c, 0xb1, 0x74,
+	0x87, 0x47, 0xc3, 0x0b, 0x28, 0x27, 0x76, 0x0f, 0x82, 0x53, 0x2a, 0x9e, 0x96, 0x2a, 0x27, 0x27,
+	0x95, 0x72, 0x2e, 0x35, 0x77, 0x0f, 0x9c, 0x56, 0xb2, 0x3d, 0x87, 0xfe, 0x8f, 0x40, 0xbc, 0x4e,
+	0xe6, 0xd1, 0xf5, 0x3f, 0x6a, 0xe2, 0xfa, 0x46, 0x95, 0x5e, 0xdf, 0xa8, 0xd2, 0xdf, 0x37, 0xaa,
+	0xf4, 0xea, 0x56, 0x4d, 0xbc, 0xbe, 0x55, 0x13, 0x7f, 0xdc, 0xaa, 0x89, 0xef, 0xd4, 0xd8, 0x3c,
+	0x9c, 0xff, 0xd1, 0xc0, 0x66, 0x61, 0x2b, 0xcd, 0xfa, 0xe7, 0xf3, 0xff, 0x06, 0x00, 0xe0, 0x1b,
+	0xec, 0xa2, 0x52, 0x08, 0x00, 0x00,
+}
+
+func (this *Auction) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Auction)
+	if !ok {
+		that2, ok := that.(Auction)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Id != that1.Id {
+		return false
+	}
+	if this.AuctionType != that1.AuctionType {
+		return false
+	}
+	if this.Status != that1.Status {
+		return false
+	}
+	if this.DenomId != that1.DenomId {
+		return false
+	}
+	if this.OnftId != that1.OnftId {
+		return false
+	}
+	if this.Seller != that1.Seller {
+		return false
+	}
+	if !this.StartPrice.Equal(&that1.StartPrice) {
+		return false
+	}
+	if !this.FloorPrice.Equal(&that1.FloorPrice) {
+		return false
+	}
+	if !this.MinIncrement.Equal(that1.MinIncrement) {
+		return false
+	}
+	if !this.Decrement.Equal(that1.Decrement) {
+		return false
+	}
+	if this.DecrementInterval != that1.DecrementInterval {
+		return false
+	}
+	if this.ExtensionWindow != that1.ExtensionWindow {
+		return false
+	}
+	if !this.StartTime.Equal(that1.StartTime) {
+		return false
+	}
+	if !this.EndTime.Equal(that1.EndTime) {
+		return false
+	}
+	if !this.HighestBid.Equal(that1.HighestBid) {
+		return false
+	}
+	if this.BidCount != that1.BidCount {
+		return false
+	}
+	return true
+}
+func (this *Bid) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Bid)
+	if !ok {
+		that2, ok := that.(Bid)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.AuctionId != that1.AuctionId {
+		return false
+	}
+	if this.Bidder != that1.Bidder {
+		return false
+	}
+	if !this.Amount.Equal(&that1.Amount) {
+		return false
+	}
+	if !this.Time.Equal(that1.Time) {
+		return false
+	}
+	return true
+}
+func (m *Auction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Auction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Auction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BidCount != 0 {
+		i = encodeVarintAuction(dAtA, i, uint64(m.BidCount))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if m.HighestBid != nil {
+		{
+			size, err := m.HighestBid.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAuction(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x7a
+	}
+	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintAuction(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x72
+	n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintAuction(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x6a
+	n4, err4 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.ExtensionWindow, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.ExtensionWindow):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintAuction(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x62
+	n5, err5 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.DecrementInterval, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.DecrementInterval):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintAuction(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x5a
+	{
+		size := m.Decrement.Size()
+		i -= size
+		if _, err := m.Decrement.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintAuction(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	{
+		size := m.MinIncrement.Size()
+		i -= size
+		if _, err := m.MinIncrement.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintAuction(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	{
+		size, err := m.FloorPrice.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintAuction(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size, err := m.StartPrice.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintAuction(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if len(m.Seller) > 0 {
+		i -= len(m.Seller)
+		copy(dAtA[i:], m.Seller)
+		i = encodeVarintAuction(dAtA, i, uint64(len(m.Seller)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.OnftId) > 0 {
+		i -= len(m.OnftId)
+		copy(dAtA[i:], m.OnftId)
+		i = encodeVarintAuction(dAtA, i, uint64(len(m.OnftId)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.DenomId) > 0 {
+		i -= len(m.DenomId)
+		copy(dAtA[i:], m.DenomId)
+		i = encodeVarintAuction(dAtA, i, uint64(len(m.DenomId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Status != 0 {
+		i = encodeVarintAuction(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.AuctionType != 0 {
+		i = encodeVarintAuction(dAtA, i, uint64(m.AuctionType))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Id != 0 {
+		i = encodeVarintAuction(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Bid) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Bid) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Bid) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n8, err8 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err8 != nil {
+		return 0, err8
+	}
+	i -= n8
+	i = encodeVarintAuction(dAtA, i, uint64(n8))
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintAuction(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Bidder) > 0 {
+		i -= len(m.Bidder)
+		copy(dAtA[i:], m.Bidder)
+		i = encodeVarintAuction(dAtA, i, uint64(len(m.Bidder)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.AuctionId != 0 {
+		i = encodeVarintAuction(dAtA, i, uint64(m.AuctionId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintAuction(dAtA []byte, offset int, v uint64) int {
+	offset -= sovAuction(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Auction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovAuction(uint64(m.Id))
+	}
+	if m.AuctionType != 0 {
+		n += 1 + sovAuction(uint64(m.AuctionType))
+	}
+	if m.Status != 0 {
+		n += 1 + sovAuction(uint64(m.Status))
+	}
+	l = len(m.DenomId)
+	if l > 0 {
+		n += 1 + l + sovAuction(uint64(l))
+	}
+	l = len(m.OnftId)
+	if l > 0 {
+		n += 1 + l + sovAuction(uint64(l))
+	}
+	l = len(m.Seller)
+	if l > 0 {
+		n += 1 + l + sovAuction(uint64(l))
+	}
+	l = m.StartPrice.Size()
+	n += 1 + l + sovAuction(uint64(l))
+	l = m.FloorPrice.Size()
+	n += 1 + l + sovAuction(uint64(l))
+	l = m.MinIncrement.Size()
+	n += 1 + l + sovAuction(uint64(l))
+	l = m.Decrement.Size()
+	n += 1 + l + sovAuction(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.DecrementInterval)
+	n += 1 + l + sovAuction(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.ExtensionWindow)
+	n += 1 + l + sovAuction(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovAuction(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime)
+	n += 1 + l + sovAuction(uint64(l))
+	if m.HighestBid != nil {
+		l = m.HighestBid.Size()
+		n += 1 + l + sovAuction(uint64(l))
+	}
+	if m.BidCount != 0 {
+		n += 2 + sovAuction(uint64(m.BidCount))
+	}
+	return n
+}
+
+func (m *Bid) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AuctionId != 0 {
+		n += 1 + sovAuction(uint64(m.AuctionId))
+	}
+	l = len(m.Bidder)
+	if l > 0 {
+		n += 1 + l + sovAuction(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovAuction(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovAuction(uint64(l))
+	return n
+}
+
+func sovAuction(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozAuction(x uint64) (n int) {
+	return sovAuction(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Auction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuction
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Auction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Auction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionType", wireType)
+			}
+			m.AuctionType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuctionType |= AuctionType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= AuctionStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuction
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OnftId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuction
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OnftId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Seller", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuction
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Seller = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartPrice", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StartPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FloorPrice", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FloorPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinIncrement", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuction
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinIncrement.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Decrement", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuction
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Decrement.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecrementInterval", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.DecrementInterval, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtensionWindow", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.ExtensionWindow, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HighestBid", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.HighestBid == nil {
+				m.HighestBid = &Bid{}
+			}
+			if err := m.HighestBid.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BidCount", wireType)
+			}
+			m.BidCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BidCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuction(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Bid) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuction
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Bid: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Bid: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionId", wireType)
+			}
+			m.AuctionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuctionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bidder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuction
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bidder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuction(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipAuction(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowAuction
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthAuction
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupAuction
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthAuction
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthAuction        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowAuction          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupAuction = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,62 @@
+package types_test
+
+import (
+	"math"
+	"math/big"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/OmniFlix/onft/types"
+)
+
+func TestAuctionCurrentPrice(t *testing.T) {
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	dutch := types.Auction{
+		AuctionType:       types.AuctionTypeDutch,
+		StartPrice:        sdk.NewInt64Coin("uflix", 1000),
+		FloorPrice:        sdk.NewInt64Coin("uflix", 100),
+		Decrement:         sdk.NewInt(100),
+		DecrementInterval: time.Minute,
+		StartTime:         start,
+	}
+	hugeDecrement := dutch
+	hugeDecrement.Decrement = sdk.NewIntFromBigInt(new(big.Int).Lsh(big.NewInt(1), 250))
+	english := types.Auction{
+		AuctionType: types.AuctionTypeEnglish,
+		StartPrice:  sdk.NewInt64Coin("uflix", 1000),
+		StartTime:   start,
+	}
+	englishWithBid := english
+	englishWithBid.HighestBid = &types.Bid{Amount: sdk.NewInt64Coin("uflix", 1500)}
+	englishWithIncrement := englishWithBid
+	englishWithIncrement.MinIncrement = sdk.NewInt(50)
+
+	tests := []struct {
+		name    string
+		auction types.Auction
+		elapsed time.Duration
+		price   int64
+	}{
+		{"dutch before start", dutch, -time.Hour, 1000},
+		{"dutch at start", dutch, 0, 1000},
+		{"dutch within first interval", dutch, 59 * time.Second, 1000},
+		{"dutch after three intervals", dutch, 3 * time.Minute, 700},
+		{"dutch at floor", dutch, 9 * time.Minute, 100},
+		{"dutch past floor", dutch, 10 * time.Minute, 100},
+		{"dutch long elapsed", dutch, time.Duration(math.MaxInt64), 100},
+		{"dutch huge decrement long elapsed", hugeDecrement, time.Duration(math.MaxInt64), 100},
+		{"english without bids", english, time.Hour, 1000},
+		{"english outbids by one unit", englishWithBid, time.Hour, 1501},
+		{"english min increment", englishWithIncrement, time.Hour, 1550},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var price sdk.Coin
+			require.NotPanics(t, func() { price = tc.auction.CurrentPrice(start.Add(tc.elapsed)) })
+			require.Equal(t, sdk.NewInt64Coin("uflix", tc.price), price)
+		})
+	}
+}
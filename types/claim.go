@@ -0,0 +1,124 @@
+package types
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// HashClaimSecret returns the hex encoded sha256 hash of a claim secret
+func HashClaimSecret(secret string) string {
+	hash := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(hash[:])
+}
+
+// ClaimCommitmentHash returns the hex encoded sha256 hash of a claim secret
+// bound to the claimant address
+func ClaimCommitmentHash(secret string, claimant sdk.AccAddress) string {
+	hash := sha256.Sum256(claimCommitmentPreimage(secret, claimant))
+	return hex.EncodeToString(hash[:])
+}
+
+// MatchClaimSecret reports whether the secret hashes to the hex encoded hash.
+// Hashes are compared decoded, so the case of the hex digits does not matter.
+func MatchClaimSecret(hash, secret string) bool {
+	return matchSHA256Hash(hash, []byte(secret))
+}
+
+// MatchClaimCommitment reports whether the secret bound to the claimant hashes
+// to the hex encoded commitment
+func MatchClaimCommitment(commitment, secret string, claimant sdk.AccAddress) bool {
+	return matchSHA256Hash(commitment, claimCommitmentPreimage(secret, claimant))
+}
+
+func claimCommitmentPreimage(secret string, claimant sdk.AccAddress) []byte {
+	return append([]byte(secret), claimant.String()...)
+}
+
+func matchSHA256Hash(hash string, preimage []byte) bool {
+	bz, err := hex.DecodeString(hash)
+	if err != nil {
+		return false
+	}
+	digest := sha256.Sum256(preimage)
+	return bytes.Equal(bz, digest[:])
+}
+
+func NewONFTTemplate(
+	metadata Metadata, data string, transferable, extensible, nsfw bool, royaltyShare sdk.Dec,
+) ONFTTemplate {
+	return ONFTTemplate{
+		Metadata:     metadata,
+		Data:         data,
+		Transferable: transferable,
+		Extensible:   extensible,
+		Nsfw:         nsfw,
+		RoyaltyShare: royaltyShare,
+	}
+}
+
+// NewONFT creates an oNFT from the template
+func (t ONFTTemplate) NewONFT(id string, owner sdk.AccAddress, createdTime time.Time) ONFT {
+	onft := NewONFT(id, t.Metadata, t.Data, t.Transferable, t.Extensible, owner, createdTime, t.Nsfw, t.RoyaltyShare)
+	onft.MaxUses = t.MaxUses
+	return onft
+}
+
+// ClaimONFTID returns the id of the n-th oNFT minted from a claim
+func ClaimONFTID(claimID, n uint64) string {
+	return fmt.Sprintf("claim%dn%d", claimID, n)
+}
+
+func (c Claim) IsExpired(blockTime time.Time) bool {
+	return !blockTime.Before(c.Expiry)
+}
+
+func (c Claim) IsMintClaim() bool {
+	return len(c.OnftId) == 0
+}
+
+func ValidateONFTTemplate(template ONFTTemplate) error {
+	if err := ValidateMetadata(template.Metadata); err != nil {
+		return err
+	}
+	if template.RoyaltyShare.IsNil() || template.RoyaltyShare.IsNegative() || template.RoyaltyShare.GTE(sdk.NewDec(1)) {
+		return errorsmod.Wrapf(ErrInvalidPercentage, "invalid royalty share percentage decimal value; %s, must be positive and less than 1", template.RoyaltyShare)
+	}
+	return nil
+}
+
+func ValidateSHA256Hash(hash string) error {
+	bz, err := hex.DecodeString(hash)
+	if err != nil || len(bz) != sha256.Size {
+		return errorsmod.Wrapf(ErrInvalidClaim, "invalid sha256 hash %s", hash)
+	}
+	return nil
+}
+
+// Validate checks the stateless consistency of a stored claim
+func (c Claim) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(c.Creator); err != nil {
+		return err
+	}
+	if err := ValidateDenomID(c.DenomId); err != nil {
+		return err
+	}
+	if err := ValidateSHA256Hash(c.Hash); err != nil {
+		return err
+	}
+	if c.Claimed > c.MaxClaims {
+		return errorsmod.Wrapf(ErrInvalidClaim, "claim %d claimed more than max claims", c.Id)
+	}
+	if c.IsMintClaim() {
+		if c.Template == nil {
+			return errorsmod.Wrapf(ErrInvalidClaim, "claim %d has no template", c.Id)
+		}
+		return ValidateONFTTemplate(*c.Template)
+	}
+	return ValidateONFTID(c.OnftId)
+}
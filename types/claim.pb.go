@@ -0,0 +1,1654 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: OmniFlix/onft/v1beta1/claim.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// ONFTTemplate holds the properties of oNFTs minted on behalf of a denom creator
+type ONFTTemplate struct {
+	Metadata     Metadata                               `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata"`
+	Data         string                                 `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	Transferable bool                                   `protobuf:"varint,3,opt,name=transferable,proto3" json:"transferable,omitempty"`
+	Extensible   bool                                   `protobuf:"varint,4,opt,name=extensible,proto3" json:"extensible,omitempty"`
+	Nsfw         bool                                   `protobuf:"varint,5,opt,name=nsfw,proto3" json:"nsfw,omitempty"`
+	RoyaltyShare github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=royalty_share,json=royaltyShare,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"royalty_share" yaml:"royalty_share"`
+	MaxUses      uint64                                 `protobuf:"varint,7,opt,name=max_uses,json=maxUses,proto3" json:"max_uses,omitempty" yaml:"max_uses"`
+}
+
+func (m *ONFTTemplate) Reset()         { *m = ONFTTemplate{} }
+func (m *ONFTTemplate) String() string { return proto.CompactTextString(m) }
+func (*ONFTTemplate) ProtoMessage()    {}
+func (*ONFTTemplate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_23241c7f66c207ed, []int{0}
+}
+func (m *ONFTTemplate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ONFTTemplate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ONFTTemplate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ONFTTemplate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ONFTTemplate.Merge(m, src)
+}
+func (m *ONFTTemplate) XXX_Size() int {
+	return m.Size()
+}
+func (m *ONFTTemplate) XXX_DiscardUnknown() {
+	xxx_messageInfo_ONFTTemplate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ONFTTemplate proto.InternalMessageInfo
+
+// Claim is a hash locked oNFT that can be claimed by revealing the secret.
+// An existing oNFT is escrowed when onft_id is set, otherwise oNFTs are minted
+// from the template.
+type Claim struct {
+	Id        uint64        `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Creator   string        `protobuf:"bytes,2,opt,name=creator,proto3" json:"creator,omitempty"`
+	DenomId   string        `protobuf:"bytes,3,opt,name=denom_id,json=denomId,proto3" json:"denom_id,omitempty" yaml:"denom_id"`
+	OnftId    string        `protobuf:"bytes,4,opt,name=onft_id,json=onftId,proto3" json:"onft_id,omitempty" yaml:"onft_id"`
+	Template  *ONFTTemplate `protobuf:"bytes,5,opt,name=template,proto3" json:"template,omitempty"`
+	Hash      string        `protobuf:"bytes,6,opt,name=hash,proto3" json:"hash,omitempty"`
+	Expiry    time.Time     `protobuf:"bytes,7,opt,name=expiry,proto3,stdtime" json:"expiry"`
+	MaxClaims uint64        `protobuf:"varint,8,opt,name=max_claims,json=maxClaims,proto3" json:"max_claims,omitempty" yaml:"max_claims"`
+	Claimed   uint64        `protobuf:"varint,9,opt,name=claimed,proto3" json:"claimed,omitempty"`
+}
+
+func (m *Claim) Reset()         { *m = Claim{} }
+func (m *Claim) String() string { return proto.CompactTextString(m) }
+func (*Claim) ProtoMessage()    {}
+func (*Claim) Descriptor() ([]byte, []int) {
+	return fileDescriptor_23241c7f66c207ed, []int{1}
+}
+func (m *Claim) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Claim) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Claim.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Claim) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Claim.Merge(m, src)
+}
+func (m *Claim) XXX_Size() int {
+	return m.Size()
+}
+func (m *Claim) XXX_DiscardUnknown() {
+	xxx_messageInfo_Claim.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Claim proto.InternalMessageInfo
+
+// ClaimCommitment binds a claim secret to the claimant address before the
+// secret is revealed.
+type ClaimCommitment struct {
+	ClaimId    uint64 `protobuf:"varint,1,opt,name=claim_id,json=claimId,proto3" json:"claim_id,omitempty" yaml:"claim_id"`
+	Claimant   string `protobuf:"bytes,2,opt,name=claimant,proto3" json:"claimant,omitempty"`
+	Commitment string `protobuf:"bytes,3,opt,name=commitment,proto3" json:"commitment,omitempty"`
+	Height     int64  `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *ClaimCommitment) Reset()         { *m = ClaimCommitment{} }
+func (m *ClaimCommitment) String() string { return proto.CompactTextString(m) }
+func (*ClaimCommitment) ProtoMessage()    {}
+func (*ClaimCommitment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_23241c7f66c207ed, []int{2}
+}
+func (m *ClaimCommitment) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClaimCommitment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ClaimCommitment.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ClaimCommitment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClaimCommitment.Merge(m, src)
+}
+func (m *ClaimCommitment) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClaimCommitment) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClaimCommitment.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClaimCommitment proto.InternalMessageInfo
+
+// ClaimRecord marks an address that has claimed from a claim.
+type ClaimRecord struct {
+	ClaimId  uint64 `protobuf:"varint,1,opt,name=claim_id,json=claimId,proto3" json:"claim_id,omitempty" yaml:"claim_id"`
+	Claimant string `protobuf:"bytes,2,opt,name=claimant,proto3" json:"claimant,omitempty"`
+}
+
+func (m *ClaimRecord) Reset()         { *m = ClaimRecord{} }
+func (m *ClaimRecord) String() string { return proto.CompactTextString(m) }
+func (*ClaimRecord) ProtoMessage()    {}
+func (*ClaimRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_23241c7f66c207ed, []int{3}
+}
+func (m *ClaimRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClaimRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ClaimRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ClaimRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClaimRecord.Merge(m, src)
+}
+func (m *ClaimRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClaimRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClaimRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClaimRecord proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*ONFTTemplate)(nil), "OmniFlix.onft.v1beta1.ONFTTemplate")
+	proto.RegisterType((*Claim)(nil), "OmniFlix.onft.v1beta1.Claim")
+	proto.RegisterType((*ClaimCommitment)(nil), "OmniFlix.onft.v1beta1.ClaimCommitment")
+	proto.RegisterType((*ClaimRecord)(nil), "OmniFlix.onft.v1beta1.ClaimRecord")
+}
+
+func init() { proto.RegisterFile("OmniFlix/onft/v1beta1/claim.proto", fileDescriptor_23241c7f66c207ed) }
+
+var fileDescriptor_23241c7f66c207ed = []byte{
+	// 647 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0xbf, 0x6f, 0xd3, 0x4e,
+	0x14, 0x8f, 0x53, 0x37, 0x3f, 0x2e, 0xfd, 0xb6, 0xfa, 0x1e, 0x2d, 0xb2, 0x32, 0xd8, 0xc1, 0x48,
+	0x28, 0x12, 0xc2, 0x56, 0x0b, 0x53, 0x55, 0x09, 0x91, 0xa2, 0x4a, 0x1d, 0xa0, 0xd2, 0x51, 0x16,
+	0x96, 0xe8, 0x62, 0x5f, 0x12, 0xab, 0x3e, 0x5f, 0xe4, 0xbb, 0x42, 0xf2, 0x5f, 0x74, 0x67, 0xe1,
+	0xcf, 0xe9, 0xd8, 0x11, 0x31, 0x84, 0xd2, 0x2e, 0xcc, 0x59, 0x58, 0xd1, 0x3d, 0x9f, 0x43, 0x2a,
+	0x95, 0x8d, 0xc9, 0xef, 0xdd, 0xfb, 0xbc, 0x1f, 0xfa, 0x7c, 0xde, 0x33, 0x7a, 0x74, 0xc2, 0xb3,
+	0xe4, 0x28, 0x4d, 0xa6, 0xa1, 0xc8, 0x86, 0x2a, 0xfc, 0xb8, 0x3b, 0x60, 0x8a, 0xee, 0x86, 0x51,
+	0x4a, 0x13, 0x1e, 0x4c, 0x72, 0xa1, 0x04, 0xde, 0x29, 0x21, 0x81, 0x86, 0x04, 0x06, 0xd2, 0xde,
+	0x1e, 0x89, 0x91, 0x00, 0x44, 0xa8, 0xad, 0x02, 0xdc, 0xf6, 0x46, 0x42, 0x8c, 0x52, 0x16, 0x82,
+	0x37, 0x38, 0x1f, 0x86, 0x2a, 0xe1, 0x4c, 0x2a, 0xca, 0x27, 0x06, 0xd0, 0xb9, 0xbf, 0x21, 0x94,
+	0x06, 0x84, 0x7f, 0x5d, 0x45, 0x1b, 0x27, 0x6f, 0x8f, 0x4e, 0x4f, 0x19, 0x9f, 0xa4, 0x54, 0x31,
+	0xfc, 0x0a, 0x35, 0x38, 0x53, 0x34, 0xa6, 0x8a, 0x3a, 0x56, 0xc7, 0xea, 0xb6, 0xf6, 0xbc, 0xe0,
+	0xde, 0x99, 0x82, 0x37, 0x06, 0xd6, 0xb3, 0x2f, 0xe7, 0x5e, 0x85, 0x2c, 0xd3, 0x30, 0x46, 0x36,
+	0xa4, 0x57, 0x3b, 0x56, 0xb7, 0x49, 0xc0, 0xc6, 0x3e, 0xda, 0x50, 0x39, 0xcd, 0xe4, 0x90, 0xe5,
+	0x74, 0x90, 0x32, 0x67, 0xad, 0x63, 0x75, 0x1b, 0xe4, 0xce, 0x1b, 0x76, 0x11, 0x62, 0x53, 0xc5,
+	0x32, 0x99, 0x68, 0x84, 0x0d, 0x88, 0x95, 0x17, 0x5d, 0x37, 0x93, 0xc3, 0x4f, 0xce, 0x3a, 0x44,
+	0xc0, 0xc6, 0x67, 0xe8, 0xbf, 0x5c, 0xcc, 0x68, 0xaa, 0x66, 0x7d, 0x39, 0xa6, 0x39, 0x73, 0x6a,
+	0xba, 0x69, 0xef, 0x48, 0x8f, 0xf4, 0x6d, 0xee, 0x3d, 0x19, 0x25, 0x6a, 0x7c, 0x3e, 0x08, 0x22,
+	0xc1, 0xc3, 0x48, 0x48, 0x2e, 0xa4, 0xf9, 0x3c, 0x93, 0xf1, 0x59, 0xa8, 0x66, 0x13, 0x26, 0x83,
+	0xd7, 0x2c, 0x5a, 0xcc, 0xbd, 0xed, 0x19, 0xe5, 0xe9, 0xbe, 0x7f, 0xa7, 0x98, 0x4f, 0x36, 0x8c,
+	0xff, 0x4e, 0xbb, 0x38, 0x40, 0x0d, 0x4e, 0xa7, 0xfd, 0x73, 0xc9, 0xa4, 0x53, 0xef, 0x58, 0x5d,
+	0xbb, 0xf7, 0x60, 0x31, 0xf7, 0xb6, 0x8a, 0xcc, 0x32, 0xe2, 0x93, 0x3a, 0xa7, 0xd3, 0xf7, 0x92,
+	0xc9, 0x7d, 0xfb, 0xe7, 0x17, 0xcf, 0xf2, 0x7f, 0x55, 0xd1, 0xfa, 0xa1, 0x96, 0x18, 0x6f, 0xa2,
+	0x6a, 0x12, 0x03, 0xab, 0x36, 0xa9, 0x26, 0x31, 0x76, 0x50, 0x3d, 0xca, 0x19, 0x55, 0x22, 0x37,
+	0x5c, 0x95, 0xae, 0xee, 0x14, 0xb3, 0x4c, 0xf0, 0x7e, 0x12, 0x03, 0x55, 0xcd, 0xd5, 0x4e, 0x65,
+	0xc4, 0x27, 0x75, 0x30, 0x8f, 0x63, 0xfc, 0x14, 0xd5, 0xb5, 0x36, 0x1a, 0x6e, 0x03, 0x1c, 0x2f,
+	0xe6, 0xde, 0x66, 0x01, 0x37, 0x01, 0x9f, 0xd4, 0xb4, 0x75, 0x1c, 0xe3, 0x97, 0xa8, 0xa1, 0x8c,
+	0xdc, 0xc0, 0x65, 0x6b, 0xef, 0xf1, 0x5f, 0x24, 0x5e, 0xdd, 0x0c, 0xb2, 0x4c, 0xd2, 0x42, 0x8c,
+	0xa9, 0x1c, 0x17, 0x5c, 0x13, 0xb0, 0xf1, 0x01, 0xaa, 0xb1, 0xe9, 0x24, 0xc9, 0x67, 0xc0, 0x4c,
+	0x6b, 0xaf, 0x1d, 0x14, 0xcb, 0x19, 0x94, 0xcb, 0x19, 0x9c, 0x96, 0xcb, 0xd9, 0x6b, 0x68, 0x75,
+	0x2e, 0xbe, 0x7b, 0x16, 0x31, 0x39, 0xf8, 0x05, 0x42, 0x9a, 0x3f, 0xb8, 0x04, 0xe9, 0x34, 0x80,
+	0xdb, 0x9d, 0xc5, 0xdc, 0xfb, 0xff, 0x0f, 0xb7, 0x45, 0xcc, 0x27, 0x4d, 0x4e, 0xa7, 0x40, 0xa7,
+	0x04, 0xfe, 0xb4, 0xc5, 0x62, 0xa7, 0x09, 0xa4, 0x96, 0xae, 0x61, 0xfe, 0xb3, 0x85, 0xb6, 0x00,
+	0x7a, 0x28, 0x38, 0x4f, 0x14, 0x67, 0x99, 0xd2, 0xcc, 0x02, 0xa8, 0x5f, 0x2a, 0xb1, 0xca, 0x6c,
+	0x19, 0xf1, 0x4d, 0xa5, 0xe3, 0x18, 0xb7, 0x0d, 0x9e, 0x66, 0xca, 0x88, 0xb4, 0xf4, 0xf5, 0xc2,
+	0x46, 0xcb, 0xca, 0x85, 0x4e, 0x64, 0xe5, 0x05, 0x3f, 0x44, 0xb5, 0x31, 0x4b, 0x46, 0x63, 0x05,
+	0xa2, 0xac, 0x11, 0xe3, 0x99, 0xe9, 0xfa, 0xa8, 0x05, 0xc3, 0x11, 0x16, 0x89, 0x3c, 0xfe, 0x97,
+	0x83, 0x15, 0x0d, 0x7a, 0x07, 0x97, 0x3f, 0xdc, 0xca, 0xe5, 0x8d, 0x6b, 0x5d, 0xdd, 0xb8, 0xd6,
+	0xf5, 0x8d, 0x6b, 0x5d, 0xdc, 0xba, 0x95, 0xab, 0x5b, 0xb7, 0xf2, 0xf5, 0xd6, 0xad, 0x7c, 0x70,
+	0x57, 0x4e, 0xe3, 0xee, 0x6f, 0x02, 0xce, 0x62, 0x50, 0x03, 0xe1, 0x9e, 0xff, 0x1e, 0x00, 0x51,
+	0x59, 0x81, 0x7d, 0xb5, 0x04, 0x00, 0x00,
+}
+
+func (this *ONFTTemplate) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ONFTTemplate)
+	if !ok {
+		that2, ok := that.(ONFTTemplate)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Metadata.Equal(&that1.Metadata) {
+		return false
+	}
+	if this.Data != that1.Data {
+		return false
+	}
+	if this.Transferable != that1.Transferable {
+		return false
+	}
+	if this.Extensible != that1.Extensible {
+		return false
+	}
+	if this.Nsfw != that1.Nsfw {
+		return false
+	}
+	if !this.RoyaltyShare.Equal(that1.RoyaltyShare) {
+		return false
+	}
+	if this.MaxUses != that1.MaxUses {
+		return false
+	}
+	return true
+}
+func (this *Claim) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Claim)
+	if !ok {
+		that2, ok := that.(Claim)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Id != that1.Id {
+		return false
+	}
+	if this.Creator != that1.Creator {
+		return false
+	}
+	if this.DenomId != that1.DenomId {
+		return false
+	}
+	if this.OnftId != that1.OnftId {
+		return false
+	}
+	if !this.Template.Equal(that1.Template) {
+		return false
+	}
+	if this.Hash != that1.Hash {
+		return false
+	}
+	if !this.Expiry.Equal(that1.Expiry) {
+		return false
+	}
+	if this.MaxClaims != that1.MaxClaims {
+		return false
+	}
+	if this.Claimed != that1.Claimed {
+		return false
+	}
+	return true
+}
+func (this *ClaimCommitment) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ClaimCommitment)
+	if !ok {
+		that2, ok := that.(ClaimCommitment)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ClaimId != that1.ClaimId {
+		return false
+	}
+	if this.Claimant != that1.Claimant {
+		return false
+	}
+	if this.Commitment != that1.Commitment {
+		return false
+	}
+	if this.Height != that1.Height {
+		return false
+	}
+	return true
+}
+func (this *ClaimRecord) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ClaimRecord)
+	if !ok {
+		that2, ok := that.(ClaimRecord)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ClaimId != that1.ClaimId {
+		return false
+	}
+	if this.Claimant != that1.Claimant {
+		return false
+	}
+	return true
+}
+func (m *ONFTTemplate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ONFTTemplate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ONFTTemplate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxUses != 0 {
+		i = encodeVarintClaim(dAtA, i, uint64(m.MaxUses))
+		i--
+		dAtA[i] = 0x38
+	}
+	{
+		size := m.RoyaltyShare.Size()
+		i -= size
+		if _, err := m.RoyaltyShare.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintClaim(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if m.Nsfw {
+		i--
+		if m.Nsfw {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Extensible {
+		i--
+		if m.Extensible {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Transferable {
+		i--
+		if m.Transferable {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintClaim(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintClaim(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *Claim) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Claim) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Claim) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Claimed != 0 {
+		i = encodeVarintClaim(dAtA, i, uint64(m.Claimed))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.MaxClaims != 0 {
+		i = encodeVarintClaim(dAtA, i, uint64(m.MaxClaims))
+		i--
+		dAtA[i] = 0x40
+	}
+	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Expiry, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Expiry):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintClaim(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x3a
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintClaim(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Template != nil {
+		{
+			size, err := m.Template.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintClaim(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.OnftId) > 0 {
+		i -= len(m.OnftId)
+		copy(dAtA[i:], m.OnftId)
+		i = encodeVarintClaim(dAtA, i, uint64(len(m.OnftId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.DenomId) > 0 {
+		i -= len(m.DenomId)
+		copy(dAtA[i:], m.DenomId)
+		i = encodeVarintClaim(dAtA, i, uint64(len(m.DenomId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintClaim(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintClaim(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ClaimCommitment) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClaimCommitment) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClaimCommitment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintClaim(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Commitment) > 0 {
+		i -= len(m.Commitment)
+		copy(dAtA[i:], m.Commitment)
+		i = encodeVarintClaim(dAtA, i, uint64(len(m.Commitment)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Claimant) > 0 {
+		i -= len(m.Claimant)
+		copy(dAtA[i:], m.Claimant)
+		i = encodeVarintClaim(dAtA, i, uint64(len(m.Claimant)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ClaimId != 0 {
+		i = encodeVarintClaim(dAtA, i, uint64(m.ClaimId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ClaimRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClaimRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClaimRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Claimant) > 0 {
+		i -= len(m.Claimant)
+		copy(dAtA[i:], m.Claimant)
+		i = encodeVarintClaim(dAtA, i, uint64(len(m.Claimant)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ClaimId != 0 {
+		i = encodeVarintClaim(dAtA, i, uint64(m.ClaimId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintClaim(dAtA []byte, offset int, v uint64) int {
+	offset -= sovClaim(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ONFTTemplate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Metadata.Size()
+	n += 1 + l + sovClaim(uint64(l))
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovClaim(uint64(l))
+	}
+	if m.Transferable {
+		n += 2
+	}
+	if m.Extensible {
+		n += 2
+	}
+	if m.Nsfw {
+		n += 2
+	}
+	l = m.RoyaltyShare.Size()
+	n += 1 + l + sovClaim(uint64(l))
+	if m.MaxUses != 0 {
+		n += 1 + sovClaim(uint64(m.MaxUses))
+	}
+	return n
+}
+
+func (m *Claim) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovClaim(uint64(m.Id))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovClaim(uint64(l))
+	}
+	l = len(m.DenomId)
+	if l > 0 {
+		n += 1 + l + sovClaim(uint64(l))
+	}
+	l = len(m.OnftId)
+	if l > 0 {
+		n += 1 + l + sovClaim(uint64(l))
+	}
+	if m.Template != nil {
+		l = m.Template.Size()
+		n += 1 + l + sovClaim(uint64(l))
+	}
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovClaim(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Expiry)
+	n += 1 + l + sovClaim(uint64(l))
+	if m.MaxClaims != 0 {
+		n += 1 + sovClaim(uint64(m.MaxClaims))
+	}
+	if m.Claimed != 0 {
+		n += 1 + sovClaim(uint64(m.Claimed))
+	}
+	return n
+}
+
+func (m *ClaimCommitment) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ClaimId != 0 {
+		n += 1 + sovClaim(uint64(m.ClaimId))
+	}
+	l = len(m.Claimant)
+	if l > 0 {
+		n += 1 + l + sovClaim(uint64(l))
+	}
+	l = len(m.Commitment)
+	if l > 0 {
+		n += 1 + l + sovClaim(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovClaim(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *ClaimRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ClaimId != 0 {
+		n += 1 + sovClaim(uint64(m.ClaimId))
+	}
+	l = len(m.Claimant)
+	if l > 0 {
+		n += 1 + l + sovClaim(uint64(l))
+	}
+	return n
+}
+
+func sovClaim(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozClaim(x uint64) (n int) {
+	return sovClaim(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ONFTTemplate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowClaim
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ONFTTemplate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ONFTTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaim
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthClaim
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthClaim
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaim
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClaim
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClaim
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Transferable", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaim
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Transferable = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Extensible", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaim
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Extensible = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nsfw", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaim
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Nsfw = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RoyaltyShare", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaim
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClaim
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClaim
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RoyaltyShare.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxUses", wireType)
+			}
+			m.MaxUses = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaim
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxUses |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipClaim(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthClaim
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Claim) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowClaim
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Claim: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Claim: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaim
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaim
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClaim
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClaim
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaim
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClaim
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClaim
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OnftId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaim
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClaim
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClaim
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OnftId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaim
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthClaim
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthClaim
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Template == nil {
+				m.Template = &ONFTTemplate{}
+			}
+			if err := m.Template.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaim
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClaim
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClaim
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiry", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaim
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthClaim
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthClaim
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Expiry, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxClaims", wireType)
+			}
+			m.MaxClaims = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaim
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxClaims |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Claimed", wireType)
+			}
+			m.Claimed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaim
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Claimed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipClaim(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthClaim
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClaimCommitment) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowClaim
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClaimCommitment: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClaimCommitment: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimId", wireType)
+			}
+			m.ClaimId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaim
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClaimId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Claimant", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaim
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClaim
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClaim
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Claimant = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commitment", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaim
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClaim
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClaim
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Commitment = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaim
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipClaim(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthClaim
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClaimRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowClaim
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClaimRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClaimRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimId", wireType)
+			}
+			m.ClaimId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaim
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClaimId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Claimant", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaim
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClaim
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClaim
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Claimant = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipClaim(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthClaim
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipClaim(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowClaim
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowClaim
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowClaim
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthClaim
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupClaim
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthClaim
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthClaim        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowClaim          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupClaim = fmt.Errorf("proto: unexpected end of group")
+)
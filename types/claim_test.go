@@ -0,0 +1,38 @@
+package types_test
+
+import (
+	"strings"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/OmniFlix/onft/types"
+)
+
+func TestMatchClaimSecret(t *testing.T) {
+	claimant := sdk.AccAddress("claimant____________")
+	hash := types.HashClaimSecret("secret")
+	commitment := types.ClaimCommitmentHash("secret", claimant)
+
+	tests := []struct {
+		name    string
+		hash    string
+		secret  string
+		matches bool
+	}{
+		{"lowercase", hash, "secret", true},
+		{"uppercase", strings.ToUpper(hash), "secret", true},
+		{"wrong secret", hash, "other", false},
+		{"not hex", "zz" + hash[2:], "secret", false},
+		{"truncated", hash[:32], "secret", false},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.matches, types.MatchClaimSecret(tc.hash, tc.secret))
+		})
+	}
+
+	require.True(t, types.MatchClaimCommitment(strings.ToUpper(commitment), "secret", claimant))
+	require.False(t, types.MatchClaimCommitment(commitment, "secret", sdk.AccAddress("other_______________")))
+}
@@ -7,6 +7,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/msgservice"
 	authzcodec "github.com/cosmos/cosmos-sdk/x/authz/codec"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 )
 
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
@@ -16,6 +17,52 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgTransferONFT{}, "OmniFlix/onft/MsgTransferONFT", nil)
 	cdc.RegisterConcrete(&MsgMintONFT{}, "OmniFlix/onft/MsgMintONFT", nil)
 	cdc.RegisterConcrete(&MsgBurnONFT{}, "OmniFlix/onft/MsgBurnONFT", nil)
+	cdc.RegisterConcrete(&MsgPrintEdition{}, "OmniFlix/onft/MsgPrintEdition", nil)
+	cdc.RegisterConcrete(&MsgCreateClaim{}, "OmniFlix/onft/MsgCreateClaim", nil)
+	cdc.RegisterConcrete(&MsgCommitClaim{}, "OmniFlix/onft/MsgCommitClaim", nil)
+	cdc.RegisterConcrete(&MsgClaim{}, "OmniFlix/onft/MsgClaim", nil)
+	cdc.RegisterConcrete(&MsgRefundClaim{}, "OmniFlix/onft/MsgRefundClaim", nil)
+	cdc.RegisterConcrete(&MsgCreateMintAirdrop{}, "OmniFlix/onft/MsgCreateMintAirdrop", nil)
+	cdc.RegisterConcrete(&MsgClaimAirdrop{}, "OmniFlix/onft/MsgClaimAirdrop", nil)
+	cdc.RegisterConcrete(&MsgCreateSwap{}, "OmniFlix/onft/MsgCreateSwap", nil)
+	cdc.RegisterConcrete(&MsgAcceptSwap{}, "OmniFlix/onft/MsgAcceptSwap", nil)
+	cdc.RegisterConcrete(&MsgCancelSwap{}, "OmniFlix/onft/MsgCancelSwap", nil)
+	cdc.RegisterConcrete(&MsgListONFT{}, "OmniFlix/onft/MsgListONFT", nil)
+	cdc.RegisterConcrete(&MsgBuyONFT{}, "OmniFlix/onft/MsgBuyONFT", nil)
+	cdc.RegisterConcrete(&MsgDelistONFT{}, "OmniFlix/onft/MsgDelistONFT", nil)
+	cdc.RegisterConcrete(&MsgMakeOffer{}, "OmniFlix/onft/MsgMakeOffer", nil)
+	cdc.RegisterConcrete(&MsgAcceptOffer{}, "OmniFlix/onft/MsgAcceptOffer", nil)
+	cdc.RegisterConcrete(&MsgCancelOffer{}, "OmniFlix/onft/MsgCancelOffer", nil)
+	cdc.RegisterConcrete(&MsgCreateAuction{}, "OmniFlix/onft/MsgCreateAuction", nil)
+	cdc.RegisterConcrete(&MsgPlaceBid{}, "OmniFlix/onft/MsgPlaceBid", nil)
+	cdc.RegisterConcrete(&MsgCancelAuction{}, "OmniFlix/onft/MsgCancelAuction", nil)
+	cdc.RegisterConcrete(&MsgRequestLoan{}, "OmniFlix/onft/MsgRequestLoan", nil)
+	cdc.RegisterConcrete(&MsgFundLoan{}, "OmniFlix/onft/MsgFundLoan", nil)
+	cdc.RegisterConcrete(&MsgRepayLoan{}, "OmniFlix/onft/MsgRepayLoan", nil)
+	cdc.RegisterConcrete(&MsgClaimDefault{}, "OmniFlix/onft/MsgClaimDefault", nil)
+	cdc.RegisterConcrete(&MsgCancelLoan{}, "OmniFlix/onft/MsgCancelLoan", nil)
+	cdc.RegisterConcrete(&MsgFractionalize{}, "OmniFlix/onft/MsgFractionalize", nil)
+	cdc.RegisterConcrete(&MsgRedeem{}, "OmniFlix/onft/MsgRedeem", nil)
+	cdc.RegisterConcrete(&MsgBuyout{}, "OmniFlix/onft/MsgBuyout", nil)
+	cdc.RegisterConcrete(&MsgNestONFT{}, "OmniFlix/onft/MsgNestONFT", nil)
+	cdc.RegisterConcrete(&MsgUnnestONFT{}, "OmniFlix/onft/MsgUnnestONFT", nil)
+	cdc.RegisterConcrete(&MsgCreateTokenAccount{}, "OmniFlix/onft/MsgCreateTokenAccount", nil)
+	cdc.RegisterConcrete(&MsgExecuteFromTokenAccount{}, "OmniFlix/onft/MsgExecuteFromTokenAccount", nil)
+	cdc.RegisterConcrete(&MsgCreateRecipe{}, "OmniFlix/onft/MsgCreateRecipe", nil)
+	cdc.RegisterConcrete(&MsgExecuteRecipe{}, "OmniFlix/onft/MsgExecuteRecipe", nil)
+	cdc.RegisterConcrete(&MsgDeleteRecipe{}, "OmniFlix/onft/MsgDeleteRecipe", nil)
+	cdc.RegisterConcrete(&MsgRevealONFT{}, "OmniFlix/onft/MsgRevealONFT", nil)
+	cdc.RegisterConcrete(&MsgRevealDenom{}, "OmniFlix/onft/MsgRevealDenom", nil)
+	cdc.RegisterConcrete(&MsgRevokeONFT{}, "OmniFlix/onft/MsgRevokeONFT", nil)
+	cdc.RegisterConcrete(&MsgRedeemONFT{}, "OmniFlix/onft/MsgRedeemONFT", nil)
+	cdc.RegisterConcrete(&MsgRenewONFT{}, "OmniFlix/onft/MsgRenewONFT", nil)
+	cdc.RegisterConcrete(&MsgPauseDenom{}, "OmniFlix/onft/MsgPauseDenom", nil)
+	cdc.RegisterConcrete(&MsgUnpauseDenom{}, "OmniFlix/onft/MsgUnpauseDenom", nil)
+	cdc.RegisterConcrete(&MsgClawbackONFT{}, "OmniFlix/onft/MsgClawbackONFT", nil)
+	cdc.RegisterConcrete(&MsgFreezeDenom{}, "OmniFlix/onft/MsgFreezeDenom", nil)
+	cdc.RegisterConcrete(&MsgSetDenomNSFW{}, "OmniFlix/onft/MsgSetDenomNSFW", nil)
+	cdc.RegisterConcrete(&MsgSetDenomVisibility{}, "OmniFlix/onft/MsgSetDenomVisibility", nil)
+	cdc.RegisterConcrete(&MsgSetFeeExemption{}, "OmniFlix/onft/MsgSetFeeExemption", nil)
 	cdc.RegisterConcrete(&MsgUpdateParams{}, "OmniFlix/onft/MsgUpdateParams", nil)
 
 	cdc.RegisterConcrete(&Params{}, "OmniFlix/onft/Params", nil)
@@ -32,6 +79,52 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		&MsgTransferONFT{},
 		&MsgMintONFT{},
 		&MsgBurnONFT{},
+		&MsgPrintEdition{},
+		&MsgCreateClaim{},
+		&MsgCommitClaim{},
+		&MsgClaim{},
+		&MsgRefundClaim{},
+		&MsgCreateMintAirdrop{},
+		&MsgClaimAirdrop{},
+		&MsgCreateSwap{},
+		&MsgAcceptSwap{},
+		&MsgCancelSwap{},
+		&MsgListONFT{},
+		&MsgBuyONFT{},
+		&MsgDelistONFT{},
+		&MsgMakeOffer{},
+		&MsgAcceptOffer{},
+		&MsgCancelOffer{},
+		&MsgCreateAuction{},
+		&MsgPlaceBid{},
+		&MsgCancelAuction{},
+		&MsgRequestLoan{},
+		&MsgFundLoan{},
+		&MsgRepayLoan{},
+		&MsgClaimDefault{},
+		&MsgCancelLoan{},
+		&MsgFractionalize{},
+		&MsgRedeem{},
+		&MsgBuyout{},
+		&MsgNestONFT{},
+		&MsgUnnestONFT{},
+		&MsgCreateTokenAccount{},
+		&MsgExecuteFromTokenAccount{},
+		&MsgCreateRecipe{},
+		&MsgExecuteRecipe{},
+		&MsgDeleteRecipe{},
+		&MsgRevealONFT{},
+		&MsgRevealDenom{},
+		&MsgRevokeONFT{},
+		&MsgRedeemONFT{},
+		&MsgRenewONFT{},
+		&MsgPauseDenom{},
+		&MsgUnpauseDenom{},
+		&MsgClawbackONFT{},
+		&MsgFreezeDenom{},
+		&MsgSetDenomNSFW{},
+		&MsgSetDenomVisibility{},
+		&MsgSetFeeExemption{},
 		&MsgUpdateParams{},
 	)
 
@@ -56,4 +149,8 @@ func init() {
 	// used to properly serialize MsgGrant and MsgExec instances
 	RegisterLegacyAminoCodec(authzcodec.Amino)
 	amino.Seal()
+
+	// MsgExecuteFromTokenAccount packs bank msgs, their types must be known
+	// to produce its sign bytes
+	banktypes.RegisterInterfaces(ModuleCdc.InterfaceRegistry())
 }
@@ -0,0 +1,47 @@
+package types
+
+import (
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MaxRevokeReasonLen is the max length of the reason of a revocation
+const MaxRevokeReasonLen = 256
+
+func ValidateRevokeReason(reason string) error {
+	if len(reason) > MaxRevokeReasonLen {
+		return errorsmod.Wrapf(ErrInvalidCredential, "reason length must be at most %d", MaxRevokeReasonLen)
+	}
+	return nil
+}
+
+// NewRevocation creates the tombstone of a revoked credential
+func NewRevocation(denomID, onftID string, owner, issuer sdk.AccAddress, reason string, revokedAt time.Time) Revocation {
+	return Revocation{
+		DenomId:   denomID,
+		OnftId:    onftID,
+		Owner:     owner.String(),
+		Issuer:    issuer.String(),
+		Reason:    reason,
+		RevokedAt: revokedAt,
+	}
+}
+
+// Validate checks the stateless consistency of a stored revocation
+func (r Revocation) Validate() error {
+	if err := ValidateDenomID(r.DenomId); err != nil {
+		return err
+	}
+	if err := ValidateONFTID(r.OnftId); err != nil {
+		return err
+	}
+	if _, err := sdk.AccAddressFromBech32(r.Owner); err != nil {
+		return err
+	}
+	if _, err := sdk.AccAddressFromBech32(r.Issuer); err != nil {
+		return err
+	}
+	return ValidateRevokeReason(r.Reason)
+}
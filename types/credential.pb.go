@@ -0,0 +1,638 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: OmniFlix/onft/v1beta1/credential.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// CredentialStatus is the status of a credential oNFT reported to verifiers
+type CredentialStatus int32
+
+const (
+	CredentialStatusUnspecified CredentialStatus = 0
+	// CREDENTIAL_STATUS_VALID is an issued credential that is not revoked
+	CredentialStatusValid CredentialStatus = 1
+	// CREDENTIAL_STATUS_REVOKED is a credential revoked by its issuer
+	CredentialStatusRevoked CredentialStatus = 2
+	// CREDENTIAL_STATUS_NOT_FOUND is an oNFT that does not exist or is not a credential
+	CredentialStatusNotFound CredentialStatus = 3
+	// CREDENTIAL_STATUS_NOT_HELD is a valid credential held by another address
+	CredentialStatusNotHeld CredentialStatus = 4
+)
+
+var CredentialStatus_name = map[int32]string{
+	0: "CREDENTIAL_STATUS_UNSPECIFIED",
+	1: "CREDENTIAL_STATUS_VALID",
+	2: "CREDENTIAL_STATUS_REVOKED",
+	3: "CREDENTIAL_STATUS_NOT_FOUND",
+	4: "CREDENTIAL_STATUS_NOT_HELD",
+}
+
+var CredentialStatus_value = map[string]int32{
+	"CREDENTIAL_STATUS_UNSPECIFIED": 0,
+	"CREDENTIAL_STATUS_VALID":       1,
+	"CREDENTIAL_STATUS_REVOKED":     2,
+	"CREDENTIAL_STATUS_NOT_FOUND":   3,
+	"CREDENTIAL_STATUS_NOT_HELD":    4,
+}
+
+func (x CredentialStatus) String() string {
+	return proto.EnumName(CredentialStatus_name, int32(x))
+}
+
+func (CredentialStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_9b4f7e1afd2b5496, []int{0}
+}
+
+// Revocation is the tombstone of a revoked credential oNFT
+type Revocation struct {
+	DenomId string `protobuf:"bytes,1,opt,name=denom_id,json=denomId,proto3" json:"denom_id,omitempty" yaml:"denom_id"`
+	OnftId  string `protobuf:"bytes,2,opt,name=onft_id,json=onftId,proto3" json:"onft_id,omitempty" yaml:"onft_id"`
+	// owner holds the credential at revocation
+	Owner     string    `protobuf:"bytes,3,opt,name=owner,proto3" json:"owner,omitempty"`
+	Issuer    string    `protobuf:"bytes,4,opt,name=issuer,proto3" json:"issuer,omitempty"`
+	Reason    string    `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	RevokedAt time.Time `protobuf:"bytes,6,opt,name=revoked_at,json=revokedAt,proto3,stdtime" json:"revoked_at" yaml:"revoked_at"`
+}
+
+func (m *Revocation) Reset()         { *m = Revocation{} }
+func (m *Revocation) String() string { return proto.CompactTextString(m) }
+func (*Revocation) ProtoMessage()    {}
+func (*Revocation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b4f7e1afd2b5496, []int{0}
+}
+func (m *Revocation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Revocation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Revocation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Revocation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Revocation.Merge(m, src)
+}
+func (m *Revocation) XXX_Size() int {
+	return m.Size()
+}
+func (m *Revocation) XXX_DiscardUnknown() {
+	xxx_messageInfo_Revocation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Revocation proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterEnum("OmniFlix.onft.v1beta1.CredentialStatus", CredentialStatus_name, CredentialStatus_value)
+	proto.RegisterType((*Revocation)(nil), "OmniFlix.onft.v1beta1.Revocation")
+}
+
+func init() {
+	proto.RegisterFile("OmniFlix/onft/v1beta1/credential.proto", fileDescriptor_9b4f7e1afd2b5496)
+}
+
+var fileDescriptor_9b4f7e1afd2b5496 = []byte{
+	// 525 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x92, 0x4f, 0x8f, 0xd2, 0x40,
+	0x18, 0x87, 0x5b, 0x96, 0x65, 0xdd, 0x31, 0xd1, 0x3a, 0xee, 0xba, 0xdd, 0xe2, 0xb6, 0x0d, 0x07,
+	0xb3, 0xd1, 0xa4, 0xcd, 0x6a, 0xe2, 0x01, 0xf5, 0x00, 0xb4, 0x64, 0x1b, 0x49, 0x31, 0xe5, 0x4f,
+	0x8c, 0x17, 0x52, 0xe8, 0x80, 0x13, 0xdb, 0x0e, 0x69, 0xa7, 0xe8, 0x7e, 0x03, 0xc3, 0xc5, 0xfd,
+	0x02, 0x24, 0x26, 0x7e, 0x19, 0x0e, 0x1e, 0xf6, 0xe8, 0x09, 0x15, 0x2e, 0x9e, 0xf9, 0x04, 0xa6,
+	0x2d, 0x68, 0x16, 0xf6, 0xf6, 0xbe, 0xef, 0x3c, 0x4f, 0xfb, 0xfe, 0xda, 0x01, 0x8f, 0xea, 0x9e,
+	0x8f, 0xab, 0x2e, 0xfe, 0xa4, 0x12, 0xbf, 0x4f, 0xd5, 0xd1, 0x59, 0x17, 0x51, 0xfb, 0x4c, 0xed,
+	0x05, 0xc8, 0x41, 0x3e, 0xc5, 0xb6, 0xab, 0x0c, 0x03, 0x42, 0x09, 0x3c, 0x5c, 0x73, 0x4a, 0xcc,
+	0x29, 0x2b, 0x4e, 0x38, 0x18, 0x90, 0x01, 0x49, 0x08, 0x35, 0xae, 0x52, 0x58, 0x90, 0x06, 0x84,
+	0x0c, 0x5c, 0xa4, 0x26, 0x5d, 0x37, 0xea, 0xab, 0x14, 0x7b, 0x28, 0xa4, 0xb6, 0x37, 0x4c, 0x81,
+	0xc2, 0x97, 0x0c, 0x00, 0x16, 0x1a, 0x91, 0x9e, 0x4d, 0x31, 0xf1, 0xa1, 0x02, 0x6e, 0x39, 0xc8,
+	0x27, 0x5e, 0x07, 0x3b, 0x3c, 0x2b, 0xb3, 0xa7, 0xfb, 0xe5, 0xfb, 0xcb, 0x99, 0x74, 0xf7, 0xc2,
+	0xf6, 0xdc, 0x62, 0x61, 0x7d, 0x52, 0xb0, 0xf6, 0x92, 0xd2, 0x70, 0xe0, 0x13, 0xb0, 0x17, 0x6f,
+	0x11, 0xe3, 0x99, 0x04, 0x87, 0xcb, 0x99, 0x74, 0x27, 0xc5, 0x57, 0x07, 0x05, 0x2b, 0x17, 0x57,
+	0x86, 0x03, 0x0f, 0xc0, 0x2e, 0xf9, 0xe8, 0xa3, 0x80, 0xdf, 0x89, 0x51, 0x2b, 0x6d, 0xe0, 0x03,
+	0x90, 0xc3, 0x61, 0x18, 0xa1, 0x80, 0xcf, 0x26, 0xe3, 0x55, 0x17, 0xcf, 0x03, 0x64, 0x87, 0xc4,
+	0xe7, 0x77, 0xd3, 0x79, 0xda, 0xc1, 0xb7, 0x00, 0x04, 0x68, 0x44, 0x3e, 0x20, 0xa7, 0x63, 0x53,
+	0x3e, 0x27, 0xb3, 0xa7, 0xb7, 0x9f, 0x0a, 0x4a, 0x9a, 0x53, 0x59, 0xe7, 0x54, 0x9a, 0xeb, 0x9c,
+	0xe5, 0x93, 0xe9, 0x4c, 0x62, 0x96, 0x33, 0xe9, 0x5e, 0xba, 0xd5, 0x7f, 0xb7, 0x70, 0xf9, 0x53,
+	0x62, 0xad, 0xfd, 0xd5, 0xa0, 0x44, 0x8b, 0xd9, 0x3f, 0x5f, 0x25, 0xf6, 0xf1, 0xf7, 0x0c, 0xe0,
+	0x2a, 0xff, 0x3e, 0x7a, 0x83, 0xda, 0x34, 0x0a, 0x61, 0x19, 0x9c, 0x54, 0x2c, 0x5d, 0xd3, 0xcd,
+	0xa6, 0x51, 0xaa, 0x75, 0x1a, 0xcd, 0x52, 0xb3, 0xd5, 0xe8, 0xb4, 0xcc, 0xc6, 0x1b, 0xbd, 0x62,
+	0x54, 0x0d, 0x5d, 0xe3, 0x18, 0x41, 0x1a, 0x4f, 0xe4, 0xfc, 0xa6, 0xd8, 0xf2, 0xc3, 0x21, 0xea,
+	0xe1, 0x3e, 0x46, 0x0e, 0x7c, 0x0e, 0x8e, 0xb6, 0x9f, 0xd1, 0x2e, 0xd5, 0x0c, 0x8d, 0x63, 0x85,
+	0xe3, 0xf1, 0x44, 0x3e, 0xdc, 0xb4, 0xdb, 0xb6, 0x8b, 0x1d, 0x58, 0x04, 0xc7, 0xdb, 0x9e, 0xa5,
+	0xb7, 0xeb, 0xaf, 0x75, 0x8d, 0xcb, 0x08, 0xf9, 0xf1, 0x44, 0x3e, 0xda, 0x34, 0xad, 0x34, 0x14,
+	0x7c, 0x05, 0xf2, 0xdb, 0xae, 0x59, 0x6f, 0x76, 0xaa, 0xf5, 0x96, 0xa9, 0x71, 0x3b, 0xc2, 0xc3,
+	0xf1, 0x44, 0xe6, 0x37, 0x6d, 0x93, 0xd0, 0x2a, 0x89, 0x7c, 0x07, 0xbe, 0x00, 0xc2, 0xcd, 0xfa,
+	0xb9, 0x5e, 0xd3, 0xb8, 0xec, 0xcd, 0xef, 0x36, 0x09, 0x3d, 0x47, 0xae, 0x23, 0x64, 0x3f, 0x7f,
+	0x13, 0x99, 0xf2, 0xcb, 0xe9, 0x6f, 0x91, 0x99, 0xce, 0x45, 0xf6, 0x6a, 0x2e, 0xb2, 0xbf, 0xe6,
+	0x22, 0x7b, 0xb9, 0x10, 0x99, 0xab, 0x85, 0xc8, 0xfc, 0x58, 0x88, 0xcc, 0x3b, 0x71, 0x80, 0xe9,
+	0xfb, 0xa8, 0xab, 0xf4, 0x88, 0xa7, 0x5e, 0xbf, 0xff, 0xf4, 0x62, 0x88, 0xc2, 0x6e, 0x2e, 0xf9,
+	0xa1, 0xcf, 0xfe, 0x0e, 0x00, 0x94, 0xb0, 0x07, 0xc1, 0x1d, 0x03, 0x00, 0x00,
+}
+
+func (this *Revocation) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Revocation)
+	if !ok {
+		that2, ok := that.(Revocation)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.DenomId != that1.DenomId {
+		return false
+	}
+	if this.OnftId != that1.OnftId {
+		return false
+	}
+	if this.Owner != that1.Owner {
+		return false
+	}
+	if this.Issuer != that1.Issuer {
+		return false
+	}
+	if this.Reason != that1.Reason {
+		return false
+	}
+	if !this.RevokedAt.Equal(that1.RevokedAt) {
+		return false
+	}
+	return true
+}
+func (m *Revocation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Revocation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Revocation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.RevokedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.RevokedAt):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintCredential(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x32
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintCredential(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Issuer) > 0 {
+		i -= len(m.Issuer)
+		copy(dAtA[i:], m.Issuer)
+		i = encodeVarintCredential(dAtA, i, uint64(len(m.Issuer)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintCredential(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.OnftId) > 0 {
+		i -= len(m.OnftId)
+		copy(dAtA[i:], m.OnftId)
+		i = encodeVarintCredential(dAtA, i, uint64(len(m.OnftId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DenomId) > 0 {
+		i -= len(m.DenomId)
+		copy(dAtA[i:], m.DenomId)
+		i = encodeVarintCredential(dAtA, i, uint64(len(m.DenomId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintCredential(dAtA []byte, offset int, v uint64) int {
+	offset -= sovCredential(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Revocation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DenomId)
+	if l > 0 {
+		n += 1 + l + sovCredential(uint64(l))
+	}
+	l = len(m.OnftId)
+	if l > 0 {
+		n += 1 + l + sovCredential(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovCredential(uint64(l))
+	}
+	l = len(m.Issuer)
+	if l > 0 {
+		n += 1 + l + sovCredential(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovCredential(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.RevokedAt)
+	n += 1 + l + sovCredential(uint64(l))
+	return n
+}
+
+func sovCredential(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozCredential(x uint64) (n int) {
+	return sovCredential(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Revocation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCredential
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Revocation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Revocation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCredential
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCredential
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCredential
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OnftId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCredential
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCredential
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCredential
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OnftId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCredential
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCredential
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCredential
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Issuer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCredential
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCredential
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCredential
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Issuer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCredential
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCredential
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCredential
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RevokedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCredential
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCredential
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCredential
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.RevokedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCredential(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCredential
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipCredential(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowCredential
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowCredential
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowCredential
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthCredential
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupCredential
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthCredential
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthCredential        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowCredential          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupCredential = fmt.Errorf("proto: unexpected end of group")
+)
@@ -1,6 +1,7 @@
 package types
 
 import (
+	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -15,3 +16,33 @@ func NewDenom(id, symbol, name, schema string, creator sdk.AccAddress, descripti
 		PreviewURI:  previewURI,
 	}
 }
+
+// MaxRoyaltyReceivers is the maximum number of royalty receivers of a denom
+const MaxRoyaltyReceivers = 10
+
+// ValidateRoyaltyReceivers checks that the receivers are unique valid addresses
+// with positive weights that add up to one.
+func ValidateRoyaltyReceivers(receivers []WeightedAddress) error {
+	if len(receivers) > MaxRoyaltyReceivers {
+		return errorsmod.Wrapf(ErrInvalidRoyaltyReceivers, "at most %d royalty receivers are allowed", MaxRoyaltyReceivers)
+	}
+	total := sdk.ZeroDec()
+	seen := make(map[string]bool)
+	for _, receiver := range receivers {
+		if _, err := sdk.AccAddressFromBech32(receiver.Address); err != nil {
+			return errorsmod.Wrapf(ErrInvalidRoyaltyReceivers, "invalid address %s", receiver.Address)
+		}
+		if seen[receiver.Address] {
+			return errorsmod.Wrapf(ErrInvalidRoyaltyReceivers, "duplicate address %s", receiver.Address)
+		}
+		seen[receiver.Address] = true
+		if receiver.Weight.IsNil() || !receiver.Weight.IsPositive() {
+			return errorsmod.Wrapf(ErrInvalidRoyaltyReceivers, "weight of %s must be positive", receiver.Address)
+		}
+		total = total.Add(receiver.Weight)
+	}
+	if len(receivers) > 0 && !total.Equal(sdk.OneDec()) {
+		return errorsmod.Wrapf(ErrInvalidRoyaltyReceivers, "weights must add up to 1, got %s", total)
+	}
+	return nil
+}
@@ -7,26 +7,82 @@ import (
 )
 
 var (
-	ErrInvalidCollection       = errorsmod.Register(ModuleName, 3, "invalid ONFT collection")
-	ErrUnknownCollection       = errorsmod.Register(ModuleName, 4, "unknown ONFT collection")
-	ErrInvalidONFT             = errorsmod.Register(ModuleName, 5, "invalid ONFT")
-	ErrONFTAlreadyExists       = errorsmod.Register(ModuleName, 6, "ONFT already exists")
-	ErrUnknownONFT             = errorsmod.Register(ModuleName, 7, "unknown ONFT")
-	ErrEmptyMetaData           = errorsmod.Register(ModuleName, 8, "ONFT MetaData can't be empty")
-	ErrUnauthorized            = errorsmod.Register(ModuleName, 9, "unauthorized address")
-	ErrInvalidDenom            = errorsmod.Register(ModuleName, 10, "invalid denom")
-	ErrInvalidONFTID           = errorsmod.Register(ModuleName, 11, "invalid ID")
-	ErrInvalidONFTMeta         = errorsmod.Register(ModuleName, 12, "invalid metadata")
-	ErrInvalidMediaURI         = errorsmod.Register(ModuleName, 13, "invalid media URI")
-	ErrInvalidPreviewURI       = errorsmod.Register(ModuleName, 14, "invalid preview URI")
-	ErrNotTransferable         = errorsmod.Register(ModuleName, 15, "onft is not transferable")
-	ErrNotEditable             = errorsmod.Register(ModuleName, 16, "onft is not editable")
-	ErrInvalidOption           = errorsmod.Register(ModuleName, 17, "invalid option")
-	ErrInvalidName             = errorsmod.Register(ModuleName, 18, "invalid name")
-	ErrInvalidDescription      = errorsmod.Register(ModuleName, 19, "invalid description")
-	ErrInvalidURI              = errorsmod.Register(ModuleName, 20, "invalid URI")
-	ErrInvalidPercentage       = errorsmod.Register(ModuleName, 21, "invalid percentage")
-	ErrInvalidDenomCreationFee = errorsmod.Register(ModuleName, 22, "invalid denom creation fee")
-	ErrInvalidFeeDenom         = errorsmod.Register(ModuleName, 23, "invalid creation fee denom")
-	ErrNotEnoughFeeAmount      = errorsmod.Register(ModuleName, 24, "invalid creation fee amount")
+	ErrInvalidCollection        = errorsmod.Register(ModuleName, 3, "invalid ONFT collection")
+	ErrUnknownCollection        = errorsmod.Register(ModuleName, 4, "unknown ONFT collection")
+	ErrInvalidONFT              = errorsmod.Register(ModuleName, 5, "invalid ONFT")
+	ErrONFTAlreadyExists        = errorsmod.Register(ModuleName, 6, "ONFT already exists")
+	ErrUnknownONFT              = errorsmod.Register(ModuleName, 7, "unknown ONFT")
+	ErrEmptyMetaData            = errorsmod.Register(ModuleName, 8, "ONFT MetaData can't be empty")
+	ErrUnauthorized             = errorsmod.Register(ModuleName, 9, "unauthorized address")
+	ErrInvalidDenom             = errorsmod.Register(ModuleName, 10, "invalid denom")
+	ErrInvalidONFTID            = errorsmod.Register(ModuleName, 11, "invalid ID")
+	ErrInvalidONFTMeta          = errorsmod.Register(ModuleName, 12, "invalid metadata")
+	ErrInvalidMediaURI          = errorsmod.Register(ModuleName, 13, "invalid media URI")
+	ErrInvalidPreviewURI        = errorsmod.Register(ModuleName, 14, "invalid preview URI")
+	ErrNotTransferable          = errorsmod.Register(ModuleName, 15, "onft is not transferable")
+	ErrNotEditable              = errorsmod.Register(ModuleName, 16, "onft is not editable")
+	ErrInvalidOption            = errorsmod.Register(ModuleName, 17, "invalid option")
+	ErrInvalidName              = errorsmod.Register(ModuleName, 18, "invalid name")
+	ErrInvalidDescription       = errorsmod.Register(ModuleName, 19, "invalid description")
+	ErrInvalidURI               = errorsmod.Register(ModuleName, 20, "invalid URI")
+	ErrInvalidPercentage        = errorsmod.Register(ModuleName, 21, "invalid percentage")
+	ErrInvalidDenomCreationFee  = errorsmod.Register(ModuleName, 22, "invalid denom creation fee")
+	ErrInvalidFeeDenom          = errorsmod.Register(ModuleName, 23, "invalid creation fee denom")
+	ErrNotEnoughFeeAmount       = errorsmod.Register(ModuleName, 24, "invalid creation fee amount")
+	ErrNotMasterEdition         = errorsmod.Register(ModuleName, 25, "onft is not a master edition")
+	ErrEditionsExhausted        = errorsmod.Register(ModuleName, 26, "all editions of master onft are printed")
+	ErrUnknownClaim             = errorsmod.Register(ModuleName, 27, "unknown claim")
+	ErrInvalidClaim             = errorsmod.Register(ModuleName, 28, "invalid claim")
+	ErrClaimExpired             = errorsmod.Register(ModuleName, 29, "claim expired")
+	ErrClaimExhausted           = errorsmod.Register(ModuleName, 30, "claim has no remaining items")
+	ErrAlreadyClaimed           = errorsmod.Register(ModuleName, 31, "address already claimed")
+	ErrInvalidSecret            = errorsmod.Register(ModuleName, 32, "invalid claim secret")
+	ErrInvalidCommitment        = errorsmod.Register(ModuleName, 33, "invalid claim commitment")
+	ErrUnknownAirdrop           = errorsmod.Register(ModuleName, 34, "unknown airdrop")
+	ErrInvalidAirdrop           = errorsmod.Register(ModuleName, 35, "invalid airdrop")
+	ErrAirdropExpired           = errorsmod.Register(ModuleName, 36, "airdrop expired")
+	ErrInvalidMerkleProof       = errorsmod.Register(ModuleName, 37, "invalid merkle proof")
+	ErrAirdropClaimed           = errorsmod.Register(ModuleName, 38, "airdrop leaf already claimed")
+	ErrUnknownSwap              = errorsmod.Register(ModuleName, 39, "unknown swap")
+	ErrInvalidSwap              = errorsmod.Register(ModuleName, 40, "invalid swap")
+	ErrSwapExpired              = errorsmod.Register(ModuleName, 41, "swap expired")
+	ErrUnknownListing           = errorsmod.Register(ModuleName, 42, "unknown listing")
+	ErrInvalidListing           = errorsmod.Register(ModuleName, 43, "invalid listing")
+	ErrListingExpired           = errorsmod.Register(ModuleName, 44, "listing expired")
+	ErrUnknownOffer             = errorsmod.Register(ModuleName, 45, "unknown offer")
+	ErrInvalidOffer             = errorsmod.Register(ModuleName, 46, "invalid offer")
+	ErrOfferExpired             = errorsmod.Register(ModuleName, 47, "offer expired")
+	ErrInvalidRoyaltyReceivers  = errorsmod.Register(ModuleName, 48, "invalid royalty receivers")
+	ErrInvalidPlatformFee       = errorsmod.Register(ModuleName, 49, "invalid platform fee")
+	ErrUnknownAuction           = errorsmod.Register(ModuleName, 50, "unknown auction")
+	ErrInvalidAuction           = errorsmod.Register(ModuleName, 51, "invalid auction")
+	ErrInvalidBid               = errorsmod.Register(ModuleName, 52, "invalid bid")
+	ErrAuctionNotActive         = errorsmod.Register(ModuleName, 53, "auction not active")
+	ErrUnknownLoan              = errorsmod.Register(ModuleName, 54, "unknown loan")
+	ErrInvalidLoan              = errorsmod.Register(ModuleName, 55, "invalid loan")
+	ErrLoanOverdue              = errorsmod.Register(ModuleName, 56, "loan overdue")
+	ErrUnknownFractionalization = errorsmod.Register(ModuleName, 57, "unknown fractionalization")
+	ErrInvalidFractionalization = errorsmod.Register(ModuleName, 58, "invalid fractionalization")
+	ErrInsufficientShares       = errorsmod.Register(ModuleName, 59, "insufficient shares")
+	ErrInvalidNesting           = errorsmod.Register(ModuleName, 60, "invalid nesting")
+	ErrONFTNested               = errorsmod.Register(ModuleName, 61, "onft is nested")
+	ErrInvalidTokenAccount      = errorsmod.Register(ModuleName, 62, "invalid token account")
+	ErrUnknownRecipe            = errorsmod.Register(ModuleName, 63, "unknown recipe")
+	ErrInvalidRecipe            = errorsmod.Register(ModuleName, 64, "invalid recipe")
+	ErrUnknownCommitment        = errorsmod.Register(ModuleName, 65, "unknown metadata commitment")
+	ErrInvalidReveal            = errorsmod.Register(ModuleName, 66, "invalid reveal")
+	ErrONFTUnrevealed           = errorsmod.Register(ModuleName, 67, "onft is unrevealed")
+	ErrInvalidCredential        = errorsmod.Register(ModuleName, 68, "invalid credential")
+	ErrONFTRevoked              = errorsmod.Register(ModuleName, 69, "onft is revoked")
+	ErrInvalidRedemption        = errorsmod.Register(ModuleName, 70, "invalid redemption")
+	ErrONFTExhausted            = errorsmod.Register(ModuleName, 71, "onft uses exhausted")
+	ErrInvalidSubscription      = errorsmod.Register(ModuleName, 72, "invalid subscription")
+	ErrONFTExpired              = errorsmod.Register(ModuleName, 73, "onft is expired")
+	ErrInvalidModeration        = errorsmod.Register(ModuleName, 74, "invalid moderation")
+	ErrDenomFrozen              = errorsmod.Register(ModuleName, 75, "denom is frozen")
+	ErrDenomPaused              = errorsmod.Register(ModuleName, 76, "denom is paused")
+	ErrInvalidClawback          = errorsmod.Register(ModuleName, 77, "invalid clawback")
+	ErrInvalidFee               = errorsmod.Register(ModuleName, 78, "invalid fee")
+	ErrInvalidFeeDistribution   = errorsmod.Register(ModuleName, 79, "invalid fee distribution")
+	ErrInvalidFeeExemption      = errorsmod.Register(ModuleName, 80, "invalid fee exemption")
 )
@@ -8,6 +8,73 @@ const (
 	EventTypeMintONFT     = "mint_onft"
 	EventTypeTransferONFT = "transfer_onft"
 	EventTypeBurnONFT     = "burn_onft"
+	EventTypePrintEdition = "print_edition"
+
+	EventTypeCreateClaim = "create_claim"
+	EventTypeCommitClaim = "commit_claim"
+	EventTypeClaim       = "claim"
+	EventTypeRefundClaim = "refund_claim"
+
+	EventTypeCreateMintAirdrop = "create_mint_airdrop"
+	EventTypeClaimAirdrop      = "claim_airdrop"
+
+	EventTypeCreateSwap = "create_swap"
+	EventTypeAcceptSwap = "accept_swap"
+	EventTypeCancelSwap = "cancel_swap"
+
+	EventTypeListONFT    = "list_onft"
+	EventTypeBuyONFT     = "buy_onft"
+	EventTypeDelistONFT  = "delist_onft"
+	EventTypeMakeOffer   = "make_offer"
+	EventTypeAcceptOffer = "accept_offer"
+	EventTypeCancelOffer = "cancel_offer"
+	EventTypeRoyaltyPaid = "royalty_paid"
+
+	EventTypeCreateAuction = "create_auction"
+	EventTypePlaceBid      = "place_bid"
+	EventTypeCancelAuction = "cancel_auction"
+	EventTypeSettleAuction = "settle_auction"
+
+	EventTypeRequestLoan  = "request_loan"
+	EventTypeFundLoan     = "fund_loan"
+	EventTypeRepayLoan    = "repay_loan"
+	EventTypeClaimDefault = "claim_default"
+	EventTypeCancelLoan   = "cancel_loan"
+
+	EventTypeFractionalize = "fractionalize"
+	EventTypeRedeem        = "redeem"
+	EventTypeBuyout        = "buyout"
+
+	EventTypeNestONFT   = "nest_onft"
+	EventTypeUnnestONFT = "unnest_onft"
+
+	EventTypeCreateTokenAccount      = "create_token_account"
+	EventTypeExecuteFromTokenAccount = "execute_from_token_account"
+
+	EventTypeCreateRecipe  = "create_recipe"
+	EventTypeExecuteRecipe = "execute_recipe"
+	EventTypeDeleteRecipe  = "delete_recipe"
+
+	EventTypeRevealONFT  = "reveal_onft"
+	EventTypeRevealDenom = "reveal_denom"
+
+	EventTypeRevokeONFT = "revoke_onft"
+
+	EventTypeRedeemONFT = "redeem_onft"
+
+	EventTypeRenewONFT  = "renew_onft"
+	EventTypeExpireONFT = "expire_onft"
+
+	EventTypeFreezeDenom        = "freeze_denom"
+	EventTypeSetDenomNSFW       = "set_denom_nsfw"
+	EventTypeSetDenomVisibility = "set_denom_visibility"
+
+	EventTypePauseDenom   = "pause_denom"
+	EventTypeUnpauseDenom = "unpause_denom"
+
+	EventTypeClawbackONFT = "clawback_onft"
+
+	EventTypeSetFeeExemption = "set_fee_exemption"
 
 	AttributeValueCategory  = ModuleName
 	AttributeKeySender      = "sender"
@@ -21,4 +88,46 @@ const (
 	AttributeKeyDescription = "description"
 	AttributeKeyMediaURI    = "media-uri"
 	AttributeKeyPreviewURI  = "preview-uri"
+	AttributeKeyMasterID    = "master-id"
+	AttributeKeyEdition     = "edition-number"
+	AttributeKeyClaimID     = "claim-id"
+	AttributeKeyClaimant    = "claimant"
+	AttributeKeyAirdropID   = "airdrop-id"
+	AttributeKeyMerkleRoot  = "merkle-root"
+	AttributeKeySwapID      = "swap-id"
+	AttributeKeyAcceptor    = "acceptor"
+	AttributeKeyListingID   = "listing-id"
+	AttributeKeyOfferID     = "offer-id"
+	AttributeKeySeller      = "seller"
+	AttributeKeyBuyer       = "buyer"
+	AttributeKeyPrice       = "price"
+	AttributeKeyAmount      = "amount"
+	AttributeKeyRoyalty     = "royalty"
+	AttributeKeyPlatformFee = "platform-fee"
+	AttributeKeyAuctionID   = "auction-id"
+	AttributeKeyBidder      = "bidder"
+	AttributeKeyEndTime     = "end-time"
+	AttributeKeyStatus      = "status"
+	AttributeKeyAuctionType = "auction-type"
+	AttributeKeyLoanID      = "loan-id"
+	AttributeKeyBorrower    = "borrower"
+	AttributeKeyLender      = "lender"
+	AttributeKeyDueTime     = "due-time"
+	AttributeKeyShares      = "shares"
+	AttributeKeyParent      = "parent"
+	AttributeKeyAccount     = "account"
+	AttributeKeyRecipeID    = "recipe-id"
+	AttributeKeyReason      = "reason"
+	AttributeKeyRedeemer    = "redeemer"
+	AttributeKeyUses        = "uses"
+	AttributeKeyPeriods     = "periods"
+	AttributeKeyExpiresAt   = "expires-at"
+	AttributeKeyFrozen      = "frozen"
+	AttributeKeyNSFW        = "nsfw"
+	AttributeKeyHidden      = "hidden"
+	AttributeKeyActions     = "actions"
+	AttributeKeyPaused      = "paused"
+	AttributeKeyBurned      = "burned"
+	AttributeKeyAddress     = "address"
+	AttributeKeyDiscount    = "discount"
 )
@@ -0,0 +1,50 @@
+package types
+
+import (
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func NewFeeExemption(address sdk.AccAddress, discount sdk.Dec, expiresAt *time.Time) FeeExemption {
+	return FeeExemption{
+		Address:   address.String(),
+		Discount:  discount,
+		ExpiresAt: expiresAt,
+	}
+}
+
+// ValidateFeeDiscount checks the discount is a share between zero and one
+func ValidateFeeDiscount(discount sdk.Dec) error {
+	if discount.IsNil() || discount.IsNegative() || discount.GT(sdk.OneDec()) {
+		return errorsmod.Wrapf(ErrInvalidFeeExemption, "invalid discount %s, must be between 0 and 1", discount)
+	}
+	return nil
+}
+
+// IsActive returns true if the exemption has not expired at the given time
+func (e FeeExemption) IsActive(now time.Time) bool {
+	return e.ExpiresAt == nil || now.Before(*e.ExpiresAt)
+}
+
+// Apply returns the fee left to pay after the discount. The waived amount
+// is rounded down.
+func (e FeeExemption) Apply(fee sdk.Coin) sdk.Coin {
+	waived := sdk.NewDecFromInt(fee.Amount).Mul(e.Discount).TruncateInt()
+	return sdk.NewCoin(fee.Denom, fee.Amount.Sub(waived))
+}
+
+// Validate checks the stateless consistency of a stored fee exemption
+func (e FeeExemption) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(e.Address); err != nil {
+		return errorsmod.Wrapf(ErrInvalidFeeExemption, "invalid address %s", e.Address)
+	}
+	if err := ValidateFeeDiscount(e.Discount); err != nil {
+		return err
+	}
+	if !e.Discount.IsPositive() {
+		return errorsmod.Wrapf(ErrInvalidFeeExemption, "exemption of %s has no discount", e.Address)
+	}
+	return nil
+}
@@ -0,0 +1,461 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: OmniFlix/onft/v1beta1/fee_exemption.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// FeeExemption is a discount on the denom creation fee granted to an address
+// by governance
+type FeeExemption struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// discount is the share of the creation fee waived, one waives it fully
+	Discount github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=discount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"discount"`
+	// expires_at ends the exemption, it never expires when unset
+	ExpiresAt *time.Time `protobuf:"bytes,3,opt,name=expires_at,json=expiresAt,proto3,stdtime" json:"expires_at,omitempty" yaml:"expires_at"`
+}
+
+func (m *FeeExemption) Reset()         { *m = FeeExemption{} }
+func (m *FeeExemption) String() string { return proto.CompactTextString(m) }
+func (*FeeExemption) ProtoMessage()    {}
+func (*FeeExemption) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9e20347536bfc214, []int{0}
+}
+func (m *FeeExemption) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeExemption) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeExemption.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeeExemption) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeExemption.Merge(m, src)
+}
+func (m *FeeExemption) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeExemption) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeExemption.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeExemption proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*FeeExemption)(nil), "OmniFlix.onft.v1beta1.FeeExemption")
+}
+
+func init() {
+	proto.RegisterFile("OmniFlix/onft/v1beta1/fee_exemption.proto", fileDescriptor_9e20347536bfc214)
+}
+
+var fileDescriptor_9e20347536bfc214 = []byte{
+	// 313 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x90, 0x3d, 0x4f, 0x02, 0x31,
+	0x18, 0xc7, 0xaf, 0x6a, 0x54, 0xaa, 0x8b, 0x17, 0x4d, 0x4e, 0x86, 0x96, 0x30, 0x18, 0x1c, 0x6c,
+	0x83, 0x6e, 0xc4, 0x45, 0xa2, 0x0c, 0x2e, 0x26, 0x84, 0xc9, 0x85, 0xdc, 0xcb, 0xc3, 0xd9, 0x48,
+	0xef, 0xb9, 0xd0, 0x62, 0xe0, 0x5b, 0xf0, 0x11, 0xfc, 0x38, 0x8c, 0x8c, 0xc6, 0x01, 0x15, 0x16,
+	0x67, 0x3f, 0x81, 0xe1, 0xb8, 0xf3, 0x65, 0x6a, 0x9f, 0xf6, 0xf7, 0xcf, 0xff, 0x97, 0x87, 0x9e,
+	0xde, 0xe9, 0x44, 0xb5, 0xfa, 0x6a, 0x24, 0x31, 0xe9, 0x59, 0xf9, 0x54, 0x0f, 0xc0, 0xfa, 0x75,
+	0xd9, 0x03, 0xe8, 0xc2, 0x08, 0x74, 0x6a, 0x15, 0x26, 0x22, 0x1d, 0xa0, 0x45, 0xf7, 0xa8, 0x40,
+	0xc5, 0x0a, 0x15, 0x39, 0x5a, 0x3e, 0x8c, 0x31, 0xc6, 0x8c, 0x90, 0xab, 0xdb, 0x1a, 0x2e, 0xf3,
+	0x18, 0x31, 0xee, 0x83, 0xcc, 0xa6, 0x60, 0xd8, 0x93, 0x56, 0x69, 0x30, 0xd6, 0xd7, 0xe9, 0x1a,
+	0xa8, 0xce, 0x08, 0xdd, 0x6f, 0x01, 0xdc, 0x14, 0x25, 0xae, 0x47, 0x77, 0xfc, 0x28, 0x1a, 0x80,
+	0x31, 0x1e, 0xa9, 0x90, 0x5a, 0xa9, 0x5d, 0x8c, 0xee, 0x2d, 0xdd, 0x8d, 0x94, 0x09, 0x71, 0x98,
+	0x58, 0x6f, 0x63, 0xf5, 0xd5, 0x14, 0xd3, 0x39, 0x77, 0x5e, 0xe7, 0xfc, 0x24, 0x56, 0xf6, 0x61,
+	0x18, 0x88, 0x10, 0xb5, 0x0c, 0xd1, 0x68, 0x34, 0xf9, 0x71, 0x66, 0xa2, 0x47, 0x69, 0xc7, 0x29,
+	0x18, 0x71, 0x0d, 0x61, 0xfb, 0x27, 0xef, 0x76, 0x28, 0x85, 0x51, 0xaa, 0x06, 0x60, 0xba, 0xbe,
+	0xf5, 0x36, 0x2b, 0xa4, 0xb6, 0x77, 0x5e, 0x16, 0x6b, 0x59, 0x51, 0xc8, 0x8a, 0x4e, 0x21, 0xdb,
+	0x3c, 0xfe, 0x9a, 0xf3, 0x83, 0xb1, 0xaf, 0xfb, 0x8d, 0xea, 0x6f, 0xae, 0x3a, 0x79, 0xe3, 0xa4,
+	0x5d, 0xca, 0x1f, 0xae, 0x6c, 0x63, 0xeb, 0xf3, 0x99, 0x93, 0xe6, 0xe5, 0xf4, 0x83, 0x39, 0xd3,
+	0x05, 0x23, 0xb3, 0x05, 0x23, 0xef, 0x0b, 0x46, 0x26, 0x4b, 0xe6, 0xcc, 0x96, 0xcc, 0x79, 0x59,
+	0x32, 0xe7, 0x9e, 0xfd, 0x71, 0xfd, 0xbf, 0xf4, 0xcc, 0x33, 0xd8, 0xce, 0xda, 0x2f, 0xbe, 0x07,
+	0x00, 0xde, 0x94, 0x73, 0x2e, 0x92, 0x01, 0x00, 0x00,
+}
+
+func (this *FeeExemption) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*FeeExemption)
+	if !ok {
+		that2, ok := that.(FeeExemption)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Address != that1.Address {
+		return false
+	}
+	if !this.Discount.Equal(that1.Discount) {
+		return false
+	}
+	if that1.ExpiresAt == nil {
+		if this.ExpiresAt != nil {
+			return false
+		}
+	} else if !this.ExpiresAt.Equal(*that1.ExpiresAt) {
+		return false
+	}
+	return true
+}
+func (m *FeeExemption) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeExemption) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeExemption) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExpiresAt != nil {
+		n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.ExpiresAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExpiresAt):])
+		if err1 != nil {
+			return 0, err1
+		}
+		i -= n1
+		i = encodeVarintFeeExemption(dAtA, i, uint64(n1))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size := m.Discount.Size()
+		i -= size
+		if _, err := m.Discount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeeExemption(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintFeeExemption(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintFeeExemption(dAtA []byte, offset int, v uint64) int {
+	offset -= sovFeeExemption(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *FeeExemption) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovFeeExemption(uint64(l))
+	}
+	l = m.Discount.Size()
+	n += 1 + l + sovFeeExemption(uint64(l))
+	if m.ExpiresAt != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExpiresAt)
+		n += 1 + l + sovFeeExemption(uint64(l))
+	}
+	return n
+}
+
+func sovFeeExemption(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozFeeExemption(x uint64) (n int) {
+	return sovFeeExemption(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *FeeExemption) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeeExemption
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeExemption: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeExemption: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeExemption
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeeExemption
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeeExemption
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Discount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeExemption
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeeExemption
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeeExemption
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Discount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeExemption
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeeExemption
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeeExemption
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExpiresAt == nil {
+				m.ExpiresAt = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.ExpiresAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeeExemption(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeeExemption
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipFeeExemption(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowFeeExemption
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowFeeExemption
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowFeeExemption
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthFeeExemption
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupFeeExemption
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthFeeExemption
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthFeeExemption        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowFeeExemption          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupFeeExemption = fmt.Errorf("proto: unexpected end of group")
+)
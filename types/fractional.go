@@ -0,0 +1,91 @@
+package types
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SharesDenom returns the bank denom of the shares of a fractionalized oNFT
+func SharesDenom(denomID, onftID string) string {
+	return fmt.Sprintf("onft/%s/%s", denomID, onftID)
+}
+
+// ValidateFractionalizeTerms checks that the number of shares is positive, the
+// buyout threshold is a share in (0, 1] and the buyout price is positive.
+func ValidateFractionalizeTerms(denomID, onftID string, shares sdk.Int, threshold sdk.Dec, price sdk.Coin) error {
+	if err := sdk.ValidateDenom(SharesDenom(denomID, onftID)); err != nil {
+		return errorsmod.Wrapf(ErrInvalidFractionalization, "invalid shares denom: %s", err)
+	}
+	if shares.IsNil() || !shares.IsPositive() {
+		return errorsmod.Wrapf(ErrInvalidFractionalization, "invalid shares %s, must be positive", shares)
+	}
+	if threshold.IsNil() || !threshold.IsPositive() || threshold.GT(sdk.OneDec()) {
+		return errorsmod.Wrapf(ErrInvalidFractionalization, "invalid buyout threshold %s, must be in (0, 1]", threshold)
+	}
+	if !price.IsValid() || !price.IsPositive() {
+		return errorsmod.Wrapf(ErrInvalidFractionalization, "invalid buyout price %s, must be positive", price)
+	}
+	return nil
+}
+
+// IsBoughtOut returns true if a holder bought the oNFT out of the shares
+func (f Fractionalization) IsBoughtOut() bool {
+	return f.Buyer != ""
+}
+
+// CanBuyout returns true if holding shares is enough to start a buyout
+func (f Fractionalization) CanBuyout(held sdk.Int) bool {
+	return sdk.NewDecFromInt(held).GTE(f.BuyoutThreshold.MulInt(f.TotalShares))
+}
+
+// BuyoutCost returns the part of the buyout price owed for the shares not
+// held by the buyer, rounded up.
+func (f Fractionalization) BuyoutCost(held sdk.Int) sdk.Coin {
+	remaining := f.TotalShares.Sub(held)
+	amount := f.BuyoutPrice.Amount.Mul(remaining).Add(f.TotalShares).SubRaw(1).Quo(f.TotalShares)
+	return sdk.NewCoin(f.BuyoutPrice.Denom, amount)
+}
+
+// ProceedsFor returns the part of the buyout proceeds paid for shares. The
+// holder of the last outstanding shares receives whatever is left.
+func (f Fractionalization) ProceedsFor(shares sdk.Int) sdk.Coin {
+	if shares.GTE(f.OutstandingShares) {
+		return f.BuyoutProceeds
+	}
+	amount := f.BuyoutProceeds.Amount.Mul(shares).Quo(f.OutstandingShares)
+	return sdk.NewCoin(f.BuyoutProceeds.Denom, amount)
+}
+
+// Validate checks the stateless consistency of a stored fractionalization
+func (f Fractionalization) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(f.Owner); err != nil {
+		return err
+	}
+	if err := ValidateDenomID(f.DenomId); err != nil {
+		return err
+	}
+	if err := ValidateONFTID(f.OnftId); err != nil {
+		return err
+	}
+	if f.SharesDenom != SharesDenom(f.DenomId, f.OnftId) {
+		return errorsmod.Wrapf(ErrInvalidFractionalization, "invalid shares denom %s", f.SharesDenom)
+	}
+	if err := ValidateFractionalizeTerms(f.DenomId, f.OnftId, f.TotalShares, f.BuyoutThreshold, f.BuyoutPrice); err != nil {
+		return err
+	}
+	if !f.IsBoughtOut() {
+		return nil
+	}
+	if _, err := sdk.AccAddressFromBech32(f.Buyer); err != nil {
+		return err
+	}
+	if f.OutstandingShares.IsNil() || !f.OutstandingShares.IsPositive() || f.OutstandingShares.GT(f.TotalShares) {
+		return errorsmod.Wrapf(ErrInvalidFractionalization, "invalid outstanding shares %s", f.OutstandingShares)
+	}
+	if !f.BuyoutProceeds.IsValid() || f.BuyoutProceeds.Denom != f.BuyoutPrice.Denom {
+		return errorsmod.Wrapf(ErrInvalidFractionalization, "invalid buyout proceeds %s", f.BuyoutProceeds)
+	}
+	return nil
+}
@@ -0,0 +1,799 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: OmniFlix/onft/v1beta1/fractional.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Fractionalization locks an oNFT in the module account against total_shares
+// coins of shares_denom. Holding all shares redeems the oNFT, holding at least
+// buyout_threshold of the shares lets a holder buy the remaining shares out at
+// buyout_price for the whole oNFT. After a buyout the remaining holders redeem
+// their shares for buyout_proceeds.
+type Fractionalization struct {
+	DenomId           string                                 `protobuf:"bytes,1,opt,name=denom_id,json=denomId,proto3" json:"denom_id,omitempty" yaml:"denom_id"`
+	OnftId            string                                 `protobuf:"bytes,2,opt,name=onft_id,json=onftId,proto3" json:"onft_id,omitempty" yaml:"onft_id"`
+	Owner             string                                 `protobuf:"bytes,3,opt,name=owner,proto3" json:"owner,omitempty"`
+	SharesDenom       string                                 `protobuf:"bytes,4,opt,name=shares_denom,json=sharesDenom,proto3" json:"shares_denom,omitempty" yaml:"shares_denom"`
+	TotalShares       github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=total_shares,json=totalShares,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"total_shares" yaml:"total_shares"`
+	BuyoutThreshold   github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=buyout_threshold,json=buyoutThreshold,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"buyout_threshold" yaml:"buyout_threshold"`
+	BuyoutPrice       types.Coin                             `protobuf:"bytes,7,opt,name=buyout_price,json=buyoutPrice,proto3" json:"buyout_price" yaml:"buyout_price"`
+	Buyer             string                                 `protobuf:"bytes,8,opt,name=buyer,proto3" json:"buyer,omitempty"`
+	OutstandingShares github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,9,opt,name=outstanding_shares,json=outstandingShares,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"outstanding_shares" yaml:"outstanding_shares"`
+	BuyoutProceeds    types.Coin                             `protobuf:"bytes,10,opt,name=buyout_proceeds,json=buyoutProceeds,proto3" json:"buyout_proceeds" yaml:"buyout_proceeds"`
+}
+
+func (m *Fractionalization) Reset()         { *m = Fractionalization{} }
+func (m *Fractionalization) String() string { return proto.CompactTextString(m) }
+func (*Fractionalization) ProtoMessage()    {}
+func (*Fractionalization) Descriptor() ([]byte, []int) {
+	return fileDescriptor_141dbdb4a29f9b75, []int{0}
+}
+func (m *Fractionalization) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Fractionalization) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Fractionalization.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Fractionalization) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Fractionalization.Merge(m, src)
+}
+func (m *Fractionalization) XXX_Size() int {
+	return m.Size()
+}
+func (m *Fractionalization) XXX_DiscardUnknown() {
+	xxx_messageInfo_Fractionalization.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Fractionalization proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*Fractionalization)(nil), "OmniFlix.onft.v1beta1.Fractionalization")
+}
+
+func init() {
+	proto.RegisterFile("OmniFlix/onft/v1beta1/fractional.proto", fileDescriptor_141dbdb4a29f9b75)
+}
+
+var fileDescriptor_141dbdb4a29f9b75 = []byte{
+	// 509 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0xcf, 0x6f, 0xd3, 0x30,
+	0x14, 0x6e, 0x60, 0x6d, 0x37, 0xb7, 0x5a, 0x99, 0x37, 0x58, 0x36, 0x24, 0x67, 0xf2, 0x61, 0x9a,
+	0x84, 0x48, 0x34, 0xb8, 0x55, 0x9c, 0xca, 0x98, 0x54, 0x71, 0x00, 0x05, 0x2e, 0x70, 0xa9, 0xf2,
+	0xc3, 0x6b, 0x2d, 0x12, 0xbb, 0x8a, 0x1d, 0xa0, 0xfb, 0x2b, 0xf8, 0x13, 0xf8, 0x73, 0x7a, 0xdc,
+	0x11, 0x71, 0x88, 0xa0, 0xbd, 0x70, 0xee, 0x8d, 0x1b, 0xb2, 0x9d, 0x74, 0x61, 0x1c, 0xd0, 0x4e,
+	0x79, 0xdf, 0x7b, 0x9f, 0xbf, 0xe7, 0xef, 0xbd, 0x18, 0x1c, 0xbf, 0x4a, 0x19, 0x3d, 0x4f, 0xe8,
+	0x67, 0x8f, 0xb3, 0x0b, 0xe9, 0x7d, 0x3c, 0x0d, 0x89, 0x0c, 0x4e, 0xbd, 0x8b, 0x2c, 0x88, 0x24,
+	0xe5, 0x2c, 0x48, 0xdc, 0x69, 0xc6, 0x25, 0x87, 0xf7, 0x2b, 0x9e, 0xab, 0x78, 0x6e, 0xc9, 0x3b,
+	0xdc, 0x1b, 0xf3, 0x31, 0xd7, 0x0c, 0x4f, 0x45, 0x86, 0x7c, 0x88, 0x22, 0x2e, 0x52, 0x2e, 0xbc,
+	0x30, 0x10, 0x64, 0x2d, 0x19, 0x71, 0xca, 0x4c, 0x1d, 0xff, 0x6e, 0x82, 0x9d, 0xf3, 0x75, 0x07,
+	0x7a, 0x19, 0xa8, 0x00, 0xba, 0x60, 0x33, 0x26, 0x8c, 0xa7, 0x23, 0x1a, 0xdb, 0xd6, 0x91, 0x75,
+	0xb2, 0x35, 0xd8, 0x5d, 0x15, 0x4e, 0x6f, 0x16, 0xa4, 0x49, 0x1f, 0x57, 0x15, 0xec, 0xb7, 0x75,
+	0x38, 0x8c, 0xe1, 0x23, 0xd0, 0x56, 0x77, 0x51, 0xf4, 0x3b, 0x9a, 0x0e, 0x57, 0x85, 0xb3, 0x6d,
+	0xe8, 0x65, 0x01, 0xfb, 0x2d, 0x15, 0x0d, 0x63, 0xb8, 0x07, 0x9a, 0xfc, 0x13, 0x23, 0x99, 0x7d,
+	0x57, 0x51, 0x7d, 0x03, 0x60, 0x1f, 0x74, 0xc5, 0x24, 0xc8, 0x88, 0x18, 0x69, 0x51, 0x7b, 0x43,
+	0xeb, 0xec, 0xaf, 0x0a, 0x67, 0xd7, 0xe8, 0xd4, 0xab, 0xd8, 0xef, 0x18, 0x78, 0xa6, 0x10, 0x9c,
+	0x80, 0xae, 0xe4, 0x32, 0x48, 0x46, 0x26, 0x69, 0x37, 0xf5, 0xd9, 0x17, 0xf3, 0xc2, 0x69, 0x7c,
+	0x2f, 0x9c, 0xe3, 0x31, 0x95, 0x93, 0x3c, 0x74, 0x23, 0x9e, 0x7a, 0xe5, 0x34, 0xcc, 0xe7, 0xb1,
+	0x88, 0x3f, 0x78, 0x72, 0x36, 0x25, 0xc2, 0x1d, 0x32, 0x79, 0xdd, 0xa9, 0xae, 0x85, 0xfd, 0x8e,
+	0x86, 0x6f, 0x34, 0x82, 0x12, 0xdc, 0x0b, 0xf3, 0x19, 0xcf, 0xe5, 0x48, 0x4e, 0x32, 0x22, 0x26,
+	0x3c, 0x89, 0xed, 0x96, 0xee, 0x36, 0xbc, 0x45, 0xb7, 0x33, 0x12, 0xad, 0x0a, 0x67, 0xdf, 0x74,
+	0xbb, 0xa9, 0x87, 0xfd, 0x9e, 0x49, 0xbd, 0xad, 0x32, 0xf0, 0x1d, 0xe8, 0x96, 0xac, 0x69, 0x46,
+	0x23, 0x62, 0xb7, 0x8f, 0xac, 0x93, 0xce, 0x93, 0x03, 0xd7, 0x08, 0xbb, 0x6a, 0xb7, 0xd5, 0x6f,
+	0xe0, 0x3e, 0xe7, 0x94, 0x0d, 0x1e, 0xaa, 0xcb, 0x5c, 0x1b, 0xaa, 0x1f, 0xc6, 0x7e, 0xc7, 0xc0,
+	0xd7, 0x0a, 0xa9, 0x65, 0x84, 0xf9, 0x8c, 0x64, 0xf6, 0xa6, 0x59, 0x86, 0x06, 0xf0, 0x12, 0x40,
+	0x9e, 0x4b, 0x21, 0x03, 0x16, 0x53, 0x36, 0xae, 0xc6, 0xba, 0xa5, 0x8d, 0xbe, 0xbc, 0xf5, 0x58,
+	0x0f, 0xca, 0x1f, 0xe1, 0x1f, 0x45, 0xec, 0xef, 0xd4, 0x92, 0xe5, 0x88, 0x43, 0xd0, 0x5b, 0xdf,
+	0x97, 0x47, 0x84, 0xc4, 0xc2, 0x06, 0xff, 0xf3, 0x8b, 0x4a, 0xbf, 0x0f, 0x6e, 0xf8, 0x35, 0xe7,
+	0xb1, 0xbf, 0x5d, 0x59, 0x36, 0x89, 0xfe, 0xc6, 0xaf, 0xaf, 0x8e, 0x35, 0x78, 0x36, 0xff, 0x89,
+	0x1a, 0xf3, 0x05, 0xb2, 0xae, 0x16, 0xc8, 0xfa, 0xb1, 0x40, 0xd6, 0x97, 0x25, 0x6a, 0x5c, 0x2d,
+	0x51, 0xe3, 0xdb, 0x12, 0x35, 0xde, 0xa3, 0x9a, 0xbf, 0xbf, 0x5f, 0xa6, 0xf6, 0x16, 0xb6, 0xf4,
+	0x03, 0x7a, 0xfa, 0x67, 0x00, 0x1f, 0x35, 0x1d, 0xc3, 0xb7, 0x03, 0x00, 0x00,
+}
+
+func (this *Fractionalization) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Fractionalization)
+	if !ok {
+		that2, ok := that.(Fractionalization)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.DenomId != that1.DenomId {
+		return false
+	}
+	if this.OnftId != that1.OnftId {
+		return false
+	}
+	if this.Owner != that1.Owner {
+		return false
+	}
+	if this.SharesDenom != that1.SharesDenom {
+		return false
+	}
+	if !this.TotalShares.Equal(that1.TotalShares) {
+		return false
+	}
+	if !this.BuyoutThreshold.Equal(that1.BuyoutThreshold) {
+		return false
+	}
+	if !this.BuyoutPrice.Equal(&that1.BuyoutPrice) {
+		return false
+	}
+	if this.Buyer != that1.Buyer {
+		return false
+	}
+	if !this.OutstandingShares.Equal(that1.OutstandingShares) {
+		return false
+	}
+	if !this.BuyoutProceeds.Equal(&that1.BuyoutProceeds) {
+		return false
+	}
+	return true
+}
+func (m *Fractionalization) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Fractionalization) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Fractionalization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.BuyoutProceeds.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintFractional(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	{
+		size := m.OutstandingShares.Size()
+		i -= size
+		if _, err := m.OutstandingShares.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFractional(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	if len(m.Buyer) > 0 {
+		i -= len(m.Buyer)
+		copy(dAtA[i:], m.Buyer)
+		i = encodeVarintFractional(dAtA, i, uint64(len(m.Buyer)))
+		i--
+		dAtA[i] = 0x42
+	}
+	{
+		size, err := m.BuyoutPrice.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintFractional(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.BuyoutThreshold.Size()
+		i -= size
+		if _, err := m.BuyoutThreshold.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFractional(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.TotalShares.Size()
+		i -= size
+		if _, err := m.TotalShares.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFractional(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.SharesDenom) > 0 {
+		i -= len(m.SharesDenom)
+		copy(dAtA[i:], m.SharesDenom)
+		i = encodeVarintFractional(dAtA, i, uint64(len(m.SharesDenom)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintFractional(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.OnftId) > 0 {
+		i -= len(m.OnftId)
+		copy(dAtA[i:], m.OnftId)
+		i = encodeVarintFractional(dAtA, i, uint64(len(m.OnftId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DenomId) > 0 {
+		i -= len(m.DenomId)
+		copy(dAtA[i:], m.DenomId)
+		i = encodeVarintFractional(dAtA, i, uint64(len(m.DenomId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintFractional(dAtA []byte, offset int, v uint64) int {
+	offset -= sovFractional(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Fractionalization) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DenomId)
+	if l > 0 {
+		n += 1 + l + sovFractional(uint64(l))
+	}
+	l = len(m.OnftId)
+	if l > 0 {
+		n += 1 + l + sovFractional(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovFractional(uint64(l))
+	}
+	l = len(m.SharesDenom)
+	if l > 0 {
+		n += 1 + l + sovFractional(uint64(l))
+	}
+	l = m.TotalShares.Size()
+	n += 1 + l + sovFractional(uint64(l))
+	l = m.BuyoutThreshold.Size()
+	n += 1 + l + sovFractional(uint64(l))
+	l = m.BuyoutPrice.Size()
+	n += 1 + l + sovFractional(uint64(l))
+	l = len(m.Buyer)
+	if l > 0 {
+		n += 1 + l + sovFractional(uint64(l))
+	}
+	l = m.OutstandingShares.Size()
+	n += 1 + l + sovFractional(uint64(l))
+	l = m.BuyoutProceeds.Size()
+	n += 1 + l + sovFractional(uint64(l))
+	return n
+}
+
+func sovFractional(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozFractional(x uint64) (n int) {
+	return sovFractional(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Fractionalization) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFractional
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Fractionalization: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Fractionalization: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFractional
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFractional
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFractional
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OnftId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFractional
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFractional
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFractional
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OnftId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFractional
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFractional
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFractional
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SharesDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFractional
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFractional
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFractional
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SharesDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalShares", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFractional
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFractional
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFractional
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalShares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BuyoutThreshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFractional
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFractional
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFractional
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BuyoutThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BuyoutPrice", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFractional
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFractional
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFractional
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BuyoutPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Buyer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFractional
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFractional
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFractional
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Buyer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutstandingShares", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFractional
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFractional
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFractional
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OutstandingShares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BuyoutProceeds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFractional
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFractional
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFractional
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BuyoutProceeds.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFractional(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFractional
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipFractional(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowFractional
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowFractional
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowFractional
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthFractional
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupFractional
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthFractional
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthFractional        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowFractional          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupFractional = fmt.Errorf("proto: unexpected end of group")
+)
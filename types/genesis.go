@@ -37,6 +37,19 @@ func ValidateGenesis(data GenesisState) error {
 		if err := ValidateURI(c.Denom.PreviewURI); err != nil {
 			return err
 		}
+		if err := ValidateRoyaltyReceivers(c.Denom.RoyaltyReceivers); err != nil {
+			return err
+		}
+		if len(c.Denom.Redeemer) > 0 {
+			if _, err := sdk.AccAddressFromBech32(c.Denom.Redeemer); err != nil {
+				return err
+			}
+		}
+		if c.Denom.Subscription != nil {
+			if err := c.Denom.Subscription.Validate(); err != nil {
+				return err
+			}
+		}
 
 		for _, nft := range c.ONFTs {
 			if nft.GetOwner().Empty() {
@@ -59,7 +72,267 @@ func ValidateGenesis(data GenesisState) error {
 			if err := ValidateURI(nft.GetPreviewURI()); err != nil {
 				return err
 			}
+			if nft.IsEdition() != (nft.EditionNumber > 0) {
+				return errorsmod.Wrapf(ErrInvalidONFT, "onft %s has invalid edition info", nft.GetID())
+			}
+			if nft.Uses > nft.MaxUses {
+				return errorsmod.Wrapf(ErrInvalidONFT, "onft %s has more uses than max uses", nft.GetID())
+			}
+			if (c.Denom.Subscription != nil) != (nft.ExpiresAt != nil) {
+				return errorsmod.Wrapf(ErrInvalidSubscription, "onft %s must expire only in a subscription denom", nft.GetID())
+			}
+		}
+	}
+	for _, count := range data.EditionCounts {
+		if err := ValidateDenomID(count.DenomId); err != nil {
+			return err
+		}
+		if err := ValidateONFTID(count.MasterId); err != nil {
+			return err
+		}
+	}
+	claimIDs := make(map[uint64]bool)
+	for _, claim := range data.Claims {
+		if err := claim.Validate(); err != nil {
+			return err
+		}
+		if claimIDs[claim.Id] {
+			return errorsmod.Wrapf(ErrInvalidClaim, "duplicate claim id %d", claim.Id)
+		}
+		if claim.Id >= data.NextClaimId {
+			return errorsmod.Wrapf(ErrInvalidClaim, "claim id %d must be less than next claim id %d", claim.Id, data.NextClaimId)
+		}
+		claimIDs[claim.Id] = true
+	}
+	for _, commitment := range data.ClaimCommitments {
+		if !claimIDs[commitment.ClaimId] {
+			return errorsmod.Wrapf(ErrUnknownClaim, "commitment for unknown claim %d", commitment.ClaimId)
+		}
+		if _, err := sdk.AccAddressFromBech32(commitment.Claimant); err != nil {
+			return err
+		}
+		if err := ValidateSHA256Hash(commitment.Commitment); err != nil {
+			return err
+		}
+	}
+	for _, record := range data.ClaimRecords {
+		if !claimIDs[record.ClaimId] {
+			return errorsmod.Wrapf(ErrUnknownClaim, "record for unknown claim %d", record.ClaimId)
+		}
+		if _, err := sdk.AccAddressFromBech32(record.Claimant); err != nil {
+			return err
+		}
+	}
+	airdropIDs := make(map[uint64]bool)
+	for _, airdrop := range data.Airdrops {
+		if err := airdrop.Validate(); err != nil {
+			return err
+		}
+		if airdropIDs[airdrop.Id] {
+			return errorsmod.Wrapf(ErrInvalidAirdrop, "duplicate airdrop id %d", airdrop.Id)
+		}
+		if airdrop.Id >= data.NextAirdropId {
+			return errorsmod.Wrapf(ErrInvalidAirdrop, "airdrop id %d must be less than next airdrop id %d", airdrop.Id, data.NextAirdropId)
+		}
+		airdropIDs[airdrop.Id] = true
+	}
+	for _, record := range data.AirdropClaimRecords {
+		if !airdropIDs[record.AirdropId] {
+			return errorsmod.Wrapf(ErrUnknownAirdrop, "claim record for unknown airdrop %d", record.AirdropId)
+		}
+		if err := ValidateSHA256Hash(record.LeafHash); err != nil {
+			return err
+		}
+	}
+	swapIDs := make(map[uint64]bool)
+	for _, swap := range data.Swaps {
+		if err := swap.Validate(); err != nil {
+			return err
+		}
+		if swapIDs[swap.Id] {
+			return errorsmod.Wrapf(ErrInvalidSwap, "duplicate swap id %d", swap.Id)
+		}
+		if swap.Id >= data.NextSwapId {
+			return errorsmod.Wrapf(ErrInvalidSwap, "swap id %d must be less than next swap id %d", swap.Id, data.NextSwapId)
+		}
+		swapIDs[swap.Id] = true
+	}
+	listingIDs := make(map[uint64]bool)
+	for _, listing := range data.Listings {
+		if err := listing.Validate(); err != nil {
+			return err
+		}
+		if listingIDs[listing.Id] {
+			return errorsmod.Wrapf(ErrInvalidListing, "duplicate listing id %d", listing.Id)
+		}
+		if listing.Id >= data.NextListingId {
+			return errorsmod.Wrapf(ErrInvalidListing, "listing id %d must be less than next listing id %d", listing.Id, data.NextListingId)
+		}
+		listingIDs[listing.Id] = true
+	}
+	offerIDs := make(map[uint64]bool)
+	for _, offer := range data.Offers {
+		if err := offer.Validate(); err != nil {
+			return err
+		}
+		if offerIDs[offer.Id] {
+			return errorsmod.Wrapf(ErrInvalidOffer, "duplicate offer id %d", offer.Id)
+		}
+		if offer.Id >= data.NextOfferId {
+			return errorsmod.Wrapf(ErrInvalidOffer, "offer id %d must be less than next offer id %d", offer.Id, data.NextOfferId)
+		}
+		offerIDs[offer.Id] = true
+	}
+	auctionIDs := make(map[uint64]bool)
+	for _, auction := range data.Auctions {
+		if err := auction.Validate(); err != nil {
+			return err
+		}
+		if auctionIDs[auction.Id] {
+			return errorsmod.Wrapf(ErrInvalidAuction, "duplicate auction id %d", auction.Id)
+		}
+		if auction.Id >= data.NextAuctionId {
+			return errorsmod.Wrapf(ErrInvalidAuction, "auction id %d must be less than next auction id %d", auction.Id, data.NextAuctionId)
+		}
+		auctionIDs[auction.Id] = true
+	}
+	for _, bid := range data.Bids {
+		if !auctionIDs[bid.AuctionId] {
+			return errorsmod.Wrapf(ErrUnknownAuction, "bid for unknown auction %d", bid.AuctionId)
+		}
+		if err := bid.Validate(); err != nil {
+			return err
+		}
+	}
+	loanIDs := make(map[uint64]bool)
+	for _, loan := range data.Loans {
+		if err := loan.Validate(); err != nil {
+			return err
+		}
+		if loanIDs[loan.Id] {
+			return errorsmod.Wrapf(ErrInvalidLoan, "duplicate loan id %d", loan.Id)
+		}
+		if loan.Id >= data.NextLoanId {
+			return errorsmod.Wrapf(ErrInvalidLoan, "loan id %d must be less than next loan id %d", loan.Id, data.NextLoanId)
+		}
+		loanIDs[loan.Id] = true
+	}
+	fractionalized := make(map[string]bool)
+	for _, fractionalization := range data.Fractionalizations {
+		if err := fractionalization.Validate(); err != nil {
+			return err
+		}
+		if fractionalized[fractionalization.SharesDenom] {
+			return errorsmod.Wrapf(ErrInvalidFractionalization,
+				"duplicate fractionalization of %s/%s", fractionalization.DenomId, fractionalization.OnftId)
+		}
+		fractionalized[fractionalization.SharesDenom] = true
+	}
+	nested := make(map[ONFTRef]bool)
+	for _, nesting := range data.Nestings {
+		if err := nesting.Validate(); err != nil {
+			return err
+		}
+		if nested[nesting.Child] {
+			return errorsmod.Wrapf(ErrInvalidNesting, "onft %s is nested more than once", nesting.Child)
+		}
+		nested[nesting.Child] = true
+	}
+	tokenAccounts := make(map[ONFTRef]bool)
+	for _, ref := range data.TokenAccounts {
+		if err := ref.Validate(); err != nil {
+			return err
+		}
+		if tokenAccounts[ref] {
+			return errorsmod.Wrapf(ErrInvalidTokenAccount, "duplicate token account of %s", ref)
+		}
+		tokenAccounts[ref] = true
+	}
+	recipeIDs := make(map[uint64]bool)
+	for _, recipe := range data.Recipes {
+		if err := recipe.Validate(); err != nil {
+			return err
+		}
+		if recipeIDs[recipe.Id] {
+			return errorsmod.Wrapf(ErrInvalidRecipe, "duplicate recipe id %d", recipe.Id)
+		}
+		if recipe.Id >= data.NextRecipeId {
+			return errorsmod.Wrapf(ErrInvalidRecipe, "recipe id %d must be less than next recipe id %d", recipe.Id, data.NextRecipeId)
+		}
+		recipeIDs[recipe.Id] = true
+	}
+	commitments := make(map[string]MetadataCommitment)
+	for _, commitment := range data.MetadataCommitments {
+		if err := commitment.Validate(); err != nil {
+			return err
+		}
+		if _, ok := commitments[commitment.DenomId]; ok {
+			return errorsmod.Wrapf(ErrInvalidReveal, "duplicate metadata commitment of denom %s", commitment.DenomId)
+		}
+		commitments[commitment.DenomId] = commitment
+	}
+	unrevealed := make(map[ONFTRef]bool)
+	unrevealedCount := make(map[string]uint64)
+	for _, ref := range data.UnrevealedOnfts {
+		if err := ref.Validate(); err != nil {
+			return err
+		}
+		if _, ok := commitments[ref.DenomId]; !ok {
+			return errorsmod.Wrapf(ErrUnknownCommitment, "unrevealed onft %s without metadata commitment", ref)
+		}
+		if unrevealed[ref] {
+			return errorsmod.Wrapf(ErrInvalidReveal, "duplicate unrevealed onft %s", ref)
+		}
+		unrevealed[ref] = true
+		unrevealedCount[ref.DenomId]++
+	}
+	for _, commitment := range data.MetadataCommitments {
+		if commitment.Unrevealed != unrevealedCount[commitment.DenomId] {
+			return errorsmod.Wrapf(ErrInvalidReveal, "denom %s has %d unrevealed onfts, commitment counts %d",
+				commitment.DenomId, unrevealedCount[commitment.DenomId], commitment.Unrevealed)
+		}
+	}
+	revocations := make(map[ONFTRef]bool)
+	for _, revocation := range data.Revocations {
+		if err := revocation.Validate(); err != nil {
+			return err
+		}
+		ref := NewONFTRef(revocation.DenomId, revocation.OnftId)
+		if revocations[ref] {
+			return errorsmod.Wrapf(ErrInvalidCredential, "duplicate revocation of %s", ref)
+		}
+		revocations[ref] = true
+	}
+	renewals := make(map[string]bool)
+	for _, renewal := range data.Renewals {
+		if err := renewal.Validate(); err != nil {
+			return err
+		}
+		key := string(KeyRenewal(renewal.DenomId, renewal.OnftId, renewal.ExpiresAt))
+		if renewals[key] {
+			return errorsmod.Wrapf(ErrInvalidSubscription, "duplicate renewal of %s until %s", renewal.OnftId, renewal.ExpiresAt)
+		}
+		renewals[key] = true
+	}
+	moderated := make(map[string]bool)
+	for _, status := range data.ModerationStatuses {
+		if err := status.Validate(); err != nil {
+			return err
+		}
+		if moderated[status.DenomId] {
+			return errorsmod.Wrapf(ErrInvalidModeration, "duplicate moderation status of denom %s", status.DenomId)
+		}
+		moderated[status.DenomId] = true
+	}
+	exempted := make(map[string]bool)
+	for _, exemption := range data.FeeExemptions {
+		if err := exemption.Validate(); err != nil {
+			return err
+		}
+		if exempted[exemption.Address] {
+			return errorsmod.Wrapf(ErrInvalidFeeExemption, "duplicate fee exemption of %s", exemption.Address)
 		}
+		exempted[exemption.Address] = true
 	}
 	if err := data.Params.ValidateBasic(); err != nil {
 		return err
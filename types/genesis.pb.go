@@ -25,8 +25,38 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // GenesisState defines the nft module's genesis state.
 type GenesisState struct {
-	Collections []Collection `protobuf:"bytes,1,rep,name=collections,proto3" json:"collections"`
-	Params      Params       `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+	Collections         []Collection         `protobuf:"bytes,1,rep,name=collections,proto3" json:"collections"`
+	Params              Params               `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+	EditionCounts       []EditionCount       `protobuf:"bytes,3,rep,name=edition_counts,json=editionCounts,proto3" json:"edition_counts"`
+	Claims              []Claim              `protobuf:"bytes,4,rep,name=claims,proto3" json:"claims"`
+	ClaimCommitments    []ClaimCommitment    `protobuf:"bytes,5,rep,name=claim_commitments,json=claimCommitments,proto3" json:"claim_commitments"`
+	ClaimRecords        []ClaimRecord        `protobuf:"bytes,6,rep,name=claim_records,json=claimRecords,proto3" json:"claim_records"`
+	NextClaimId         uint64               `protobuf:"varint,7,opt,name=next_claim_id,json=nextClaimId,proto3" json:"next_claim_id,omitempty"`
+	Airdrops            []MintAirdrop        `protobuf:"bytes,8,rep,name=airdrops,proto3" json:"airdrops"`
+	AirdropClaimRecords []AirdropClaimRecord `protobuf:"bytes,9,rep,name=airdrop_claim_records,json=airdropClaimRecords,proto3" json:"airdrop_claim_records"`
+	NextAirdropId       uint64               `protobuf:"varint,10,opt,name=next_airdrop_id,json=nextAirdropId,proto3" json:"next_airdrop_id,omitempty"`
+	Swaps               []Swap               `protobuf:"bytes,11,rep,name=swaps,proto3" json:"swaps"`
+	NextSwapId          uint64               `protobuf:"varint,12,opt,name=next_swap_id,json=nextSwapId,proto3" json:"next_swap_id,omitempty"`
+	Listings            []Listing            `protobuf:"bytes,13,rep,name=listings,proto3" json:"listings"`
+	NextListingId       uint64               `protobuf:"varint,14,opt,name=next_listing_id,json=nextListingId,proto3" json:"next_listing_id,omitempty"`
+	Offers              []Offer              `protobuf:"bytes,15,rep,name=offers,proto3" json:"offers"`
+	NextOfferId         uint64               `protobuf:"varint,16,opt,name=next_offer_id,json=nextOfferId,proto3" json:"next_offer_id,omitempty"`
+	Auctions            []Auction            `protobuf:"bytes,17,rep,name=auctions,proto3" json:"auctions"`
+	Bids                []Bid                `protobuf:"bytes,18,rep,name=bids,proto3" json:"bids"`
+	NextAuctionId       uint64               `protobuf:"varint,19,opt,name=next_auction_id,json=nextAuctionId,proto3" json:"next_auction_id,omitempty"`
+	Loans               []Loan               `protobuf:"bytes,20,rep,name=loans,proto3" json:"loans"`
+	NextLoanId          uint64               `protobuf:"varint,21,opt,name=next_loan_id,json=nextLoanId,proto3" json:"next_loan_id,omitempty"`
+	Fractionalizations  []Fractionalization  `protobuf:"bytes,22,rep,name=fractionalizations,proto3" json:"fractionalizations"`
+	Nestings            []Nesting            `protobuf:"bytes,23,rep,name=nestings,proto3" json:"nestings"`
+	TokenAccounts       []ONFTRef            `protobuf:"bytes,24,rep,name=token_accounts,json=tokenAccounts,proto3" json:"token_accounts"`
+	Recipes             []Recipe             `protobuf:"bytes,25,rep,name=recipes,proto3" json:"recipes"`
+	NextRecipeId        uint64               `protobuf:"varint,26,opt,name=next_recipe_id,json=nextRecipeId,proto3" json:"next_recipe_id,omitempty"`
+	MetadataCommitments []MetadataCommitment `protobuf:"bytes,27,rep,name=metadata_commitments,json=metadataCommitments,proto3" json:"metadata_commitments"`
+	UnrevealedOnfts     []ONFTRef            `protobuf:"bytes,28,rep,name=unrevealed_onfts,json=unrevealedOnfts,proto3" json:"unrevealed_onfts"`
+	Revocations         []Revocation         `protobuf:"bytes,29,rep,name=revocations,proto3" json:"revocations"`
+	Renewals            []Renewal            `protobuf:"bytes,30,rep,name=renewals,proto3" json:"renewals"`
+	ModerationStatuses  []ModerationStatus   `protobuf:"bytes,31,rep,name=moderation_statuses,json=moderationStatuses,proto3" json:"moderation_statuses"`
+	FeeExemptions       []FeeExemption       `protobuf:"bytes,32,rep,name=fee_exemptions,json=feeExemptions,proto3" json:"fee_exemptions"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -76,8 +106,280 @@ func (m *GenesisState) GetParams() Params {
 	return Params{}
 }
 
+func (m *GenesisState) GetEditionCounts() []EditionCount {
+	if m != nil {
+		return m.EditionCounts
+	}
+	return nil
+}
+
+func (m *GenesisState) GetClaims() []Claim {
+	if m != nil {
+		return m.Claims
+	}
+	return nil
+}
+
+func (m *GenesisState) GetClaimCommitments() []ClaimCommitment {
+	if m != nil {
+		return m.ClaimCommitments
+	}
+	return nil
+}
+
+func (m *GenesisState) GetClaimRecords() []ClaimRecord {
+	if m != nil {
+		return m.ClaimRecords
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNextClaimId() uint64 {
+	if m != nil {
+		return m.NextClaimId
+	}
+	return 0
+}
+
+func (m *GenesisState) GetAirdrops() []MintAirdrop {
+	if m != nil {
+		return m.Airdrops
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAirdropClaimRecords() []AirdropClaimRecord {
+	if m != nil {
+		return m.AirdropClaimRecords
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNextAirdropId() uint64 {
+	if m != nil {
+		return m.NextAirdropId
+	}
+	return 0
+}
+
+func (m *GenesisState) GetSwaps() []Swap {
+	if m != nil {
+		return m.Swaps
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNextSwapId() uint64 {
+	if m != nil {
+		return m.NextSwapId
+	}
+	return 0
+}
+
+func (m *GenesisState) GetListings() []Listing {
+	if m != nil {
+		return m.Listings
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNextListingId() uint64 {
+	if m != nil {
+		return m.NextListingId
+	}
+	return 0
+}
+
+func (m *GenesisState) GetOffers() []Offer {
+	if m != nil {
+		return m.Offers
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNextOfferId() uint64 {
+	if m != nil {
+		return m.NextOfferId
+	}
+	return 0
+}
+
+func (m *GenesisState) GetAuctions() []Auction {
+	if m != nil {
+		return m.Auctions
+	}
+	return nil
+}
+
+func (m *GenesisState) GetBids() []Bid {
+	if m != nil {
+		return m.Bids
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNextAuctionId() uint64 {
+	if m != nil {
+		return m.NextAuctionId
+	}
+	return 0
+}
+
+func (m *GenesisState) GetLoans() []Loan {
+	if m != nil {
+		return m.Loans
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNextLoanId() uint64 {
+	if m != nil {
+		return m.NextLoanId
+	}
+	return 0
+}
+
+func (m *GenesisState) GetFractionalizations() []Fractionalization {
+	if m != nil {
+		return m.Fractionalizations
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNestings() []Nesting {
+	if m != nil {
+		return m.Nestings
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTokenAccounts() []ONFTRef {
+	if m != nil {
+		return m.TokenAccounts
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRecipes() []Recipe {
+	if m != nil {
+		return m.Recipes
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNextRecipeId() uint64 {
+	if m != nil {
+		return m.NextRecipeId
+	}
+	return 0
+}
+
+func (m *GenesisState) GetMetadataCommitments() []MetadataCommitment {
+	if m != nil {
+		return m.MetadataCommitments
+	}
+	return nil
+}
+
+func (m *GenesisState) GetUnrevealedOnfts() []ONFTRef {
+	if m != nil {
+		return m.UnrevealedOnfts
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRevocations() []Revocation {
+	if m != nil {
+		return m.Revocations
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRenewals() []Renewal {
+	if m != nil {
+		return m.Renewals
+	}
+	return nil
+}
+
+func (m *GenesisState) GetModerationStatuses() []ModerationStatus {
+	if m != nil {
+		return m.ModerationStatuses
+	}
+	return nil
+}
+
+func (m *GenesisState) GetFeeExemptions() []FeeExemption {
+	if m != nil {
+		return m.FeeExemptions
+	}
+	return nil
+}
+
+// EditionCount holds the number of editions printed from a master onft.
+type EditionCount struct {
+	DenomId  string `protobuf:"bytes,1,opt,name=denom_id,json=denomId,proto3" json:"denom_id,omitempty"`
+	MasterId string `protobuf:"bytes,2,opt,name=master_id,json=masterId,proto3" json:"master_id,omitempty"`
+	Printed  uint64 `protobuf:"varint,3,opt,name=printed,proto3" json:"printed,omitempty"`
+}
+
+func (m *EditionCount) Reset()         { *m = EditionCount{} }
+func (m *EditionCount) String() string { return proto.CompactTextString(m) }
+func (*EditionCount) ProtoMessage()    {}
+func (*EditionCount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95e11c2b95418a25, []int{1}
+}
+func (m *EditionCount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EditionCount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EditionCount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EditionCount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EditionCount.Merge(m, src)
+}
+func (m *EditionCount) XXX_Size() int {
+	return m.Size()
+}
+func (m *EditionCount) XXX_DiscardUnknown() {
+	xxx_messageInfo_EditionCount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EditionCount proto.InternalMessageInfo
+
+func (m *EditionCount) GetDenomId() string {
+	if m != nil {
+		return m.DenomId
+	}
+	return ""
+}
+
+func (m *EditionCount) GetMasterId() string {
+	if m != nil {
+		return m.MasterId
+	}
+	return ""
+}
+
+func (m *EditionCount) GetPrinted() uint64 {
+	if m != nil {
+		return m.Printed
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "OmniFlix.onft.v1beta1.GenesisState")
+	proto.RegisterType((*EditionCount)(nil), "OmniFlix.onft.v1beta1.EditionCount")
 }
 
 func init() {
@@ -85,22 +387,70 @@ func init() {
 }
 
 var fileDescriptor_95e11c2b95418a25 = []byte{
-	// 233 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0xf6, 0xcf, 0xcd, 0xcb,
-	0x74, 0xcb, 0xc9, 0xac, 0xd0, 0xcf, 0xcf, 0x4b, 0x2b, 0xd1, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49,
-	0x34, 0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17,
-	0x12, 0x85, 0x29, 0xd2, 0x03, 0x29, 0xd2, 0x83, 0x2a, 0x92, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07,
-	0xab, 0xd0, 0x07, 0xb1, 0x20, 0x8a, 0xa5, 0x14, 0xb0, 0x9b, 0x08, 0xd6, 0x09, 0x51, 0xa1, 0x84,
-	0x5d, 0x45, 0x41, 0x62, 0x51, 0x62, 0x2e, 0xd4, 0x4a, 0xa5, 0x69, 0x8c, 0x5c, 0x3c, 0xee, 0x10,
-	0x47, 0x04, 0x97, 0x24, 0x96, 0xa4, 0x0a, 0x79, 0x72, 0x71, 0x27, 0xe7, 0xe7, 0xe4, 0xa4, 0x26,
-	0x97, 0x64, 0xe6, 0xe7, 0x15, 0x4b, 0x30, 0x2a, 0x30, 0x6b, 0x70, 0x1b, 0x29, 0xea, 0x61, 0x75,
-	0x99, 0x9e, 0x33, 0x5c, 0xa5, 0x13, 0xcb, 0x89, 0x7b, 0xf2, 0x0c, 0x41, 0xc8, 0x7a, 0x85, 0xac,
-	0xb9, 0xd8, 0x20, 0x76, 0x49, 0x30, 0x29, 0x30, 0x6a, 0x70, 0x1b, 0xc9, 0xe2, 0x30, 0x25, 0x00,
-	0xac, 0x08, 0x6a, 0x02, 0x54, 0x8b, 0x93, 0xc5, 0x89, 0x47, 0x72, 0x8c, 0x17, 0x1e, 0xc9, 0x31,
-	0x3e, 0x78, 0x24, 0xc7, 0x38, 0xe1, 0xb1, 0x1c, 0xc3, 0x85, 0xc7, 0x72, 0x0c, 0x37, 0x1e, 0xcb,
-	0x31, 0x44, 0xc9, 0xa5, 0x67, 0x96, 0x64, 0x94, 0x26, 0xe9, 0x25, 0xe7, 0xe7, 0xea, 0xa3, 0xfa,
-	0xb0, 0xa4, 0xb2, 0x20, 0xb5, 0x38, 0x89, 0x0d, 0xec, 0x33, 0x63, 0x40, 0x00, 0x00, 0x00, 0xff,
-	0xff, 0x25, 0x06, 0x70, 0x6b, 0x73, 0x01, 0x00, 0x00,
+	// 1006 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x56, 0xdd, 0x6e, 0x1b, 0x45,
+	0x14, 0x8e, 0x9b, 0x34, 0x49, 0x27, 0xbf, 0x9d, 0x24, 0x30, 0x75, 0x5a, 0xd7, 0x75, 0x51, 0xea,
+	0xde, 0xd8, 0x6a, 0x41, 0x02, 0x81, 0x90, 0x48, 0x42, 0x83, 0x2c, 0x9a, 0xba, 0x72, 0xb8, 0x81,
+	0x8b, 0x9a, 0xf1, 0xce, 0xb1, 0x19, 0x75, 0x7f, 0xac, 0x9d, 0x71, 0x12, 0x78, 0x0a, 0x5e, 0x84,
+	0xf7, 0xe8, 0x65, 0x2f, 0xb9, 0x42, 0x28, 0x79, 0x11, 0x34, 0x67, 0x66, 0xd6, 0xeb, 0xc6, 0xbb,
+	0x15, 0x77, 0x3b, 0x67, 0xbf, 0xef, 0x3b, 0x73, 0x7e, 0xf6, 0x9c, 0x25, 0x8f, 0xbb, 0x51, 0x2c,
+	0x4f, 0x42, 0x79, 0xd9, 0x4e, 0xe2, 0xa1, 0x6e, 0x9f, 0x3f, 0x1b, 0x80, 0xe6, 0xcf, 0xda, 0x23,
+	0x88, 0x41, 0x49, 0xd5, 0x1a, 0xa7, 0x89, 0x4e, 0xe8, 0x9e, 0x07, 0xb5, 0x0c, 0xa8, 0xe5, 0x40,
+	0xd5, 0xdd, 0x51, 0x32, 0x4a, 0x10, 0xd1, 0x36, 0x4f, 0x16, 0x5c, 0xad, 0xcf, 0x57, 0x44, 0xa6,
+	0x45, 0x34, 0xe6, 0x23, 0xc6, 0x3c, 0xe5, 0x91, 0x73, 0x59, 0x7d, 0x34, 0x1f, 0x13, 0x84, 0x5c,
+	0x46, 0x0e, 0x52, 0x70, 0x75, 0x2e, 0x53, 0x91, 0x26, 0xe3, 0xf2, 0xdb, 0xa8, 0x0b, 0xee, 0x11,
+	0x4f, 0xe6, 0x23, 0x22, 0x9e, 0xbe, 0x05, 0x3d, 0x0e, 0x79, 0x00, 0x1f, 0xf1, 0x37, 0x09, 0xb4,
+	0x4c, 0xe2, 0x72, 0x7f, 0x61, 0xc2, 0x3d, 0xe2, 0x60, 0x3e, 0x62, 0x98, 0x72, 0xd4, 0xe1, 0x61,
+	0xb9, 0xbb, 0x18, 0x94, 0x96, 0xf1, 0xa8, 0x3c, 0x95, 0x29, 0x04, 0x72, 0x0c, 0x1f, 0xc3, 0x9c,
+	0x03, 0x0f, 0xcb, 0x2f, 0x15, 0xa4, 0x20, 0x20, 0xd6, 0x32, 0xc3, 0x35, 0x0b, 0xd2, 0x39, 0x19,
+	0xa8, 0x20, 0x95, 0xe3, 0x5c, 0x22, 0x0a, 0x14, 0xa3, 0x44, 0x40, 0xca, 0x73, 0xb8, 0xa7, 0x05,
+	0xe9, 0x00, 0xe8, 0xc3, 0x25, 0x44, 0x39, 0xc9, 0xc6, 0x5f, 0xdb, 0x64, 0xfd, 0x07, 0xdb, 0x98,
+	0x67, 0x9a, 0x6b, 0xa0, 0x1d, 0xb2, 0x16, 0x24, 0x61, 0x08, 0x98, 0x38, 0xc5, 0x2a, 0xf5, 0xc5,
+	0xe6, 0xda, 0xf3, 0x47, 0xad, 0xb9, 0xdd, 0xda, 0x3a, 0xce, 0x90, 0x47, 0x4b, 0xef, 0xfe, 0x79,
+	0xb8, 0xd0, 0xcb, 0x73, 0xe9, 0x37, 0x64, 0xd9, 0xf6, 0x1f, 0xbb, 0x55, 0xaf, 0x34, 0xd7, 0x9e,
+	0x3f, 0x28, 0x50, 0x79, 0x8d, 0x20, 0xa7, 0xe0, 0x28, 0xf4, 0x35, 0xd9, 0x04, 0x21, 0x8d, 0x50,
+	0x3f, 0x48, 0x26, 0xb1, 0x56, 0x6c, 0x11, 0xaf, 0xf2, 0xb8, 0x40, 0xe4, 0x85, 0x05, 0x1f, 0x1b,
+	0xac, 0x93, 0xda, 0x80, 0x9c, 0x4d, 0xd1, 0xaf, 0xc9, 0x32, 0xb6, 0xba, 0x62, 0x4b, 0xa8, 0x74,
+	0xbf, 0x28, 0x28, 0x03, 0xf2, 0xb7, 0xb1, 0x0c, 0xfa, 0x33, 0xb9, 0x8b, 0x4f, 0xfd, 0x20, 0x89,
+	0x22, 0xa9, 0x23, 0x30, 0x17, 0xba, 0x8d, 0x32, 0x07, 0x65, 0x32, 0xc7, 0x19, 0xdc, 0x09, 0x6e,
+	0x07, 0xb3, 0x66, 0x45, 0x4f, 0xc9, 0x86, 0x95, 0x4e, 0x21, 0x48, 0x52, 0xa1, 0xd8, 0x32, 0xca,
+	0x36, 0xca, 0x64, 0x7b, 0x08, 0x75, 0x92, 0xeb, 0xc1, 0xd4, 0xa4, 0x68, 0x83, 0x6c, 0xc4, 0x70,
+	0xa9, 0xfb, 0x56, 0x53, 0x0a, 0xb6, 0x52, 0xaf, 0x34, 0x97, 0x7a, 0x6b, 0xc6, 0x88, 0xdc, 0x8e,
+	0xa0, 0xdf, 0x93, 0x55, 0xf7, 0x45, 0x2b, 0xb6, 0x5a, 0xea, 0xed, 0x54, 0xc6, 0xfa, 0xd0, 0x42,
+	0x9d, 0xb7, 0x8c, 0x49, 0x03, 0xb2, 0xe7, 0x9e, 0xfb, 0xb3, 0x01, 0xdc, 0x41, 0xc9, 0xa7, 0x05,
+	0x92, 0x4e, 0xee, 0x66, 0x1c, 0x3b, 0xfc, 0xc6, 0x1b, 0x45, 0x0f, 0xc8, 0x16, 0x86, 0xe3, 0x3d,
+	0x49, 0xc1, 0x08, 0x06, 0x84, 0x51, 0x3a, 0xad, 0x8e, 0xa0, 0x5f, 0x92, 0xdb, 0x66, 0xfe, 0x28,
+	0xb6, 0x86, 0xce, 0xf7, 0x0b, 0x9c, 0x9f, 0x5d, 0x70, 0x1f, 0x88, 0xc5, 0xd3, 0x3a, 0x59, 0x47,
+	0x07, 0xe6, 0x64, 0xd4, 0xd7, 0x51, 0x9d, 0x18, 0x9b, 0x01, 0x77, 0x04, 0xfd, 0x8e, 0xac, 0x86,
+	0x12, 0x07, 0x84, 0x62, 0x1b, 0xa8, 0x5e, 0x2b, 0x50, 0x7f, 0x69, 0x61, 0x3e, 0x53, 0x9e, 0x95,
+	0x05, 0xe1, 0x0c, 0xc6, 0xcd, 0xe6, 0x34, 0x08, 0xc7, 0xea, 0x08, 0xd3, 0xa1, 0xc9, 0x70, 0x08,
+	0xa9, 0x62, 0x5b, 0xa5, 0x1d, 0xda, 0x35, 0x20, 0xdf, 0xa1, 0x96, 0x91, 0xd5, 0x1d, 0x8f, 0xc6,
+	0xc3, 0xf6, 0xb4, 0xee, 0x88, 0xb7, 0x91, 0xb8, 0xc9, 0xaa, 0xd8, 0xdd, 0xd2, 0x48, 0x0e, 0x27,
+	0xf9, 0xaf, 0x3a, 0x63, 0xd1, 0x2f, 0xc8, 0xd2, 0x40, 0x0a, 0xc5, 0x28, 0xb2, 0xab, 0x05, 0xec,
+	0x23, 0xe9, 0x6b, 0x8a, 0xe8, 0x69, 0x11, 0xad, 0x8c, 0xb9, 0xdd, 0x4e, 0xae, 0x88, 0xd6, 0x6a,
+	0x8b, 0x68, 0x86, 0xba, 0x62, 0xbb, 0xa5, 0x45, 0x7c, 0x99, 0x70, 0x7f, 0x33, 0x8b, 0xcf, 0x8a,
+	0x68, 0x4e, 0x46, 0x7d, 0x6f, 0x5a, 0x44, 0x03, 0xee, 0x08, 0xfa, 0x86, 0xd0, 0xe9, 0x36, 0x90,
+	0x7f, 0x70, 0x9b, 0x84, 0x4f, 0xd0, 0x4f, 0xb3, 0xc0, 0xcf, 0xc9, 0x87, 0x04, 0xe7, 0x74, 0x8e,
+	0x92, 0x49, 0xad, 0xdb, 0x22, 0x8a, 0x7d, 0x5a, 0x9a, 0xda, 0x57, 0x30, 0xd3, 0x24, 0x9e, 0x45,
+	0x7f, 0x24, 0x9b, 0x3a, 0x79, 0x0b, 0x71, 0x9f, 0x07, 0x6e, 0xe0, 0xb1, 0x52, 0x9d, 0xee, 0xab,
+	0x93, 0x9f, 0x7a, 0x30, 0xf4, 0xb3, 0x0e, 0xb9, 0x87, 0x8e, 0x4a, 0xbf, 0x25, 0x2b, 0x76, 0x5f,
+	0x29, 0x76, 0xaf, 0xbe, 0x58, 0x32, 0x7b, 0x7b, 0x88, 0x72, 0x22, 0x9e, 0x43, 0x3f, 0x23, 0x9b,
+	0x98, 0x4f, 0x7b, 0x36, 0x19, 0xad, 0x62, 0x46, 0x31, 0xcb, 0x96, 0xd2, 0x11, 0x74, 0x40, 0x76,
+	0x23, 0xd0, 0x5c, 0x70, 0xcd, 0x67, 0xe6, 0xe2, 0x7e, 0xe9, 0xf7, 0x7f, 0xea, 0x28, 0x37, 0x46,
+	0xe3, 0x4e, 0x74, 0xe3, 0x8d, 0xa2, 0x5d, 0xb2, 0x3d, 0x89, 0xed, 0x5a, 0x05, 0xd1, 0x37, 0x42,
+	0x8a, 0xdd, 0xff, 0x1f, 0x79, 0xd9, 0x9a, 0xb2, 0xbb, 0x86, 0x6c, 0xf6, 0x5b, 0x0a, 0xe7, 0x49,
+	0xe0, 0x3a, 0xe0, 0x41, 0xe9, 0x7e, 0xeb, 0x65, 0x48, 0xbf, 0xdf, 0x72, 0x5c, 0x53, 0xf3, 0x14,
+	0x62, 0xb8, 0xe0, 0xa1, 0x62, 0xb5, 0xd2, 0x3b, 0xf5, 0x2c, 0xcc, 0xd7, 0xdc, 0xb3, 0xe8, 0x1b,
+	0xb2, 0x33, 0x5d, 0xde, 0x7d, 0xa5, 0xb9, 0x9e, 0x28, 0x50, 0xec, 0x21, 0x8a, 0x3d, 0x29, 0x4a,
+	0x60, 0xc6, 0x38, 0x43, 0x82, 0xef, 0xca, 0xe8, 0x03, 0x3b, 0xe0, 0x12, 0x9d, 0x59, 0xfa, 0x8a,
+	0xd5, 0x4b, 0x97, 0xe8, 0x09, 0xc0, 0x0b, 0x8f, 0xf5, 0x8d, 0x35, 0xcc, 0xd9, 0x54, 0xe3, 0x57,
+	0xb2, 0x9e, 0xdf, 0xb4, 0xf4, 0x1e, 0x59, 0x15, 0x10, 0x27, 0xb8, 0x69, 0x2a, 0xf5, 0x4a, 0xf3,
+	0x4e, 0x6f, 0x05, 0xcf, 0x1d, 0x41, 0xf7, 0xc9, 0x9d, 0x88, 0x2b, 0x6d, 0xa7, 0xd1, 0x2d, 0x7c,
+	0xb7, 0x6a, 0x0d, 0x1d, 0x41, 0x19, 0x59, 0x19, 0xa7, 0x32, 0xd6, 0x20, 0xd8, 0x22, 0xb6, 0x96,
+	0x3f, 0x1e, 0x7d, 0xf5, 0xee, 0xaa, 0x56, 0x79, 0x7f, 0x55, 0xab, 0xfc, 0x7b, 0x55, 0xab, 0xfc,
+	0x79, 0x5d, 0x5b, 0x78, 0x7f, 0x5d, 0x5b, 0xf8, 0xfb, 0xba, 0xb6, 0xf0, 0x4b, 0x6d, 0x24, 0xf5,
+	0x6f, 0x93, 0x41, 0x2b, 0x48, 0xa2, 0xf6, 0xec, 0x1f, 0x8e, 0xfe, 0x7d, 0x0c, 0x6a, 0xb0, 0x8c,
+	0xbf, 0x34, 0x9f, 0xff, 0x37, 0x00, 0x35, 0x3e, 0x00, 0x99, 0x80, 0x0b, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -123,68 +473,1685 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if len(m.FeeExemptions) > 0 {
+		for iNdEx := len(m.FeeExemptions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeExemptions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0x82
 		}
-		i -= size
-		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Collections) > 0 {
-		for iNdEx := len(m.Collections) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.ModerationStatuses) > 0 {
+		for iNdEx := len(m.ModerationStatuses) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Collections[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.ModerationStatuses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xfa
+		}
+	}
+	if len(m.Renewals) > 0 {
+		for iNdEx := len(m.Renewals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Renewals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xf2
+		}
+	}
+	if len(m.Revocations) > 0 {
+		for iNdEx := len(m.Revocations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Revocations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xea
+		}
+	}
+	if len(m.UnrevealedOnfts) > 0 {
+		for iNdEx := len(m.UnrevealedOnfts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UnrevealedOnfts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xe2
+		}
+	}
+	if len(m.MetadataCommitments) > 0 {
+		for iNdEx := len(m.MetadataCommitments) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MetadataCommitments[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xda
+		}
+	}
+	if m.NextRecipeId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextRecipeId))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd0
+	}
+	if len(m.Recipes) > 0 {
+		for iNdEx := len(m.Recipes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Recipes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
-				i -= size
-				i = encodeVarintGenesis(dAtA, i, uint64(size))
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xca
+		}
+	}
+	if len(m.TokenAccounts) > 0 {
+		for iNdEx := len(m.TokenAccounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TokenAccounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xc2
+		}
+	}
+	if len(m.Nestings) > 0 {
+		for iNdEx := len(m.Nestings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Nestings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xba
+		}
+	}
+	if len(m.Fractionalizations) > 0 {
+		for iNdEx := len(m.Fractionalizations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Fractionalizations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xb2
+		}
+	}
+	if m.NextLoanId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextLoanId))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa8
+	}
+	if len(m.Loans) > 0 {
+		for iNdEx := len(m.Loans) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Loans[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xa2
+		}
+	}
+	if m.NextAuctionId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextAuctionId))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
+	}
+	if len(m.Bids) > 0 {
+		for iNdEx := len(m.Bids) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Bids[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
+	}
+	if len(m.Auctions) > 0 {
+		for iNdEx := len(m.Auctions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Auctions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if m.NextOfferId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextOfferId))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if len(m.Offers) > 0 {
+		for iNdEx := len(m.Offers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Offers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if m.NextListingId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextListingId))
+		i--
+		dAtA[i] = 0x70
+	}
+	if len(m.Listings) > 0 {
+		for iNdEx := len(m.Listings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Listings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if m.NextSwapId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextSwapId))
+		i--
+		dAtA[i] = 0x60
+	}
+	if len(m.Swaps) > 0 {
+		for iNdEx := len(m.Swaps) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Swaps[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if m.NextAirdropId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextAirdropId))
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.AirdropClaimRecords) > 0 {
+		for iNdEx := len(m.AirdropClaimRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AirdropClaimRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.Airdrops) > 0 {
+		for iNdEx := len(m.Airdrops) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Airdrops[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if m.NextClaimId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextClaimId))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.ClaimRecords) > 0 {
+		for iNdEx := len(m.ClaimRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ClaimRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.ClaimCommitments) > 0 {
+		for iNdEx := len(m.ClaimCommitments) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ClaimCommitments[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Claims) > 0 {
+		for iNdEx := len(m.Claims) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Claims[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.EditionCounts) > 0 {
+		for iNdEx := len(m.EditionCounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EditionCounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Collections) > 0 {
+		for iNdEx := len(m.Collections) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Collections[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EditionCount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EditionCount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EditionCount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Printed != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Printed))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.MasterId) > 0 {
+		i -= len(m.MasterId)
+		copy(dAtA[i:], m.MasterId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.MasterId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DenomId) > 0 {
+		i -= len(m.DenomId)
+		copy(dAtA[i:], m.DenomId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.DenomId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Collections) > 0 {
+		for _, e := range m.Collections {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.EditionCounts) > 0 {
+		for _, e := range m.EditionCounts {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Claims) > 0 {
+		for _, e := range m.Claims {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ClaimCommitments) > 0 {
+		for _, e := range m.ClaimCommitments {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ClaimRecords) > 0 {
+		for _, e := range m.ClaimRecords {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.NextClaimId != 0 {
+		n += 1 + sovGenesis(uint64(m.NextClaimId))
+	}
+	if len(m.Airdrops) > 0 {
+		for _, e := range m.Airdrops {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AirdropClaimRecords) > 0 {
+		for _, e := range m.AirdropClaimRecords {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.NextAirdropId != 0 {
+		n += 1 + sovGenesis(uint64(m.NextAirdropId))
+	}
+	if len(m.Swaps) > 0 {
+		for _, e := range m.Swaps {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.NextSwapId != 0 {
+		n += 1 + sovGenesis(uint64(m.NextSwapId))
+	}
+	if len(m.Listings) > 0 {
+		for _, e := range m.Listings {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.NextListingId != 0 {
+		n += 1 + sovGenesis(uint64(m.NextListingId))
+	}
+	if len(m.Offers) > 0 {
+		for _, e := range m.Offers {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.NextOfferId != 0 {
+		n += 2 + sovGenesis(uint64(m.NextOfferId))
+	}
+	if len(m.Auctions) > 0 {
+		for _, e := range m.Auctions {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Bids) > 0 {
+		for _, e := range m.Bids {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.NextAuctionId != 0 {
+		n += 2 + sovGenesis(uint64(m.NextAuctionId))
+	}
+	if len(m.Loans) > 0 {
+		for _, e := range m.Loans {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.NextLoanId != 0 {
+		n += 2 + sovGenesis(uint64(m.NextLoanId))
+	}
+	if len(m.Fractionalizations) > 0 {
+		for _, e := range m.Fractionalizations {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Nestings) > 0 {
+		for _, e := range m.Nestings {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.TokenAccounts) > 0 {
+		for _, e := range m.TokenAccounts {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Recipes) > 0 {
+		for _, e := range m.Recipes {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.NextRecipeId != 0 {
+		n += 2 + sovGenesis(uint64(m.NextRecipeId))
+	}
+	if len(m.MetadataCommitments) > 0 {
+		for _, e := range m.MetadataCommitments {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.UnrevealedOnfts) > 0 {
+		for _, e := range m.UnrevealedOnfts {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Revocations) > 0 {
+		for _, e := range m.Revocations {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Renewals) > 0 {
+		for _, e := range m.Renewals {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ModerationStatuses) > 0 {
+		for _, e := range m.ModerationStatuses {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.FeeExemptions) > 0 {
+		for _, e := range m.FeeExemptions {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EditionCount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DenomId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.MasterId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Printed != 0 {
+		n += 1 + sovGenesis(uint64(m.Printed))
+	}
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Collections", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Collections = append(m.Collections, Collection{})
+			if err := m.Collections[len(m.Collections)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EditionCounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EditionCounts = append(m.EditionCounts, EditionCount{})
+			if err := m.EditionCounts[len(m.EditionCounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Claims", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Claims = append(m.Claims, Claim{})
+			if err := m.Claims[len(m.Claims)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimCommitments", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClaimCommitments = append(m.ClaimCommitments, ClaimCommitment{})
+			if err := m.ClaimCommitments[len(m.ClaimCommitments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClaimRecords = append(m.ClaimRecords, ClaimRecord{})
+			if err := m.ClaimRecords[len(m.ClaimRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextClaimId", wireType)
+			}
+			m.NextClaimId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextClaimId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Airdrops", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Airdrops = append(m.Airdrops, MintAirdrop{})
+			if err := m.Airdrops[len(m.Airdrops)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AirdropClaimRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AirdropClaimRecords = append(m.AirdropClaimRecords, AirdropClaimRecord{})
+			if err := m.AirdropClaimRecords[len(m.AirdropClaimRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextAirdropId", wireType)
+			}
+			m.NextAirdropId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextAirdropId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Swaps", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Swaps = append(m.Swaps, Swap{})
+			if err := m.Swaps[len(m.Swaps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextSwapId", wireType)
+			}
+			m.NextSwapId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextSwapId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Listings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Listings = append(m.Listings, Listing{})
+			if err := m.Listings[len(m.Listings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextListingId", wireType)
+			}
+			m.NextListingId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextListingId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Offers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Offers = append(m.Offers, Offer{})
+			if err := m.Offers[len(m.Offers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextOfferId", wireType)
+			}
+			m.NextOfferId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextOfferId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Auctions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Auctions = append(m.Auctions, Auction{})
+			if err := m.Auctions[len(m.Auctions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bids", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bids = append(m.Bids, Bid{})
+			if err := m.Bids[len(m.Bids)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextAuctionId", wireType)
+			}
+			m.NextAuctionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextAuctionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Loans", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Loans = append(m.Loans, Loan{})
+			if err := m.Loans[len(m.Loans)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 21:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextLoanId", wireType)
+			}
+			m.NextLoanId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextLoanId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fractionalizations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fractionalizations = append(m.Fractionalizations, Fractionalization{})
+			if err := m.Fractionalizations[len(m.Fractionalizations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nestings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Nestings = append(m.Nestings, Nesting{})
+			if err := m.Nestings[len(m.Nestings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 24:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenAccounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenAccounts = append(m.TokenAccounts, ONFTRef{})
+			if err := m.TokenAccounts[len(m.TokenAccounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 25:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipes = append(m.Recipes, Recipe{})
+			if err := m.Recipes[len(m.Recipes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 26:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextRecipeId", wireType)
+			}
+			m.NextRecipeId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextRecipeId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 27:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MetadataCommitments", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			i--
-			dAtA[i] = 0xa
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MetadataCommitments = append(m.MetadataCommitments, MetadataCommitment{})
+			if err := m.MetadataCommitments[len(m.MetadataCommitments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 28:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnrevealedOnfts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UnrevealedOnfts = append(m.UnrevealedOnfts, ONFTRef{})
+			if err := m.UnrevealedOnfts[len(m.UnrevealedOnfts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 29:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revocations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Revocations = append(m.Revocations, Revocation{})
+			if err := m.Revocations[len(m.Revocations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 30:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Renewals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Renewals = append(m.Renewals, Renewal{})
+			if err := m.Renewals[len(m.Renewals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 31:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModerationStatuses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ModerationStatuses = append(m.ModerationStatuses, ModerationStatus{})
+			if err := m.ModerationStatuses[len(m.ModerationStatuses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 32:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeExemptions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeExemptions = append(m.FeeExemptions, FeeExemption{})
+			if err := m.FeeExemptions[len(m.FeeExemptions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return len(dAtA) - i, nil
-}
 
-func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
-	offset -= sovGenesis(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *GenesisState) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Collections) > 0 {
-		for _, e := range m.Collections {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
-		}
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	l = m.Params.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	return n
-}
-
-func sovGenesis(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozGenesis(x uint64) (n int) {
-	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *GenesisState) Unmarshal(dAtA []byte) error {
+func (m *EditionCount) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -207,17 +2174,17 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+			return fmt.Errorf("proto: EditionCount: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EditionCount: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Collections", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -227,31 +2194,29 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Collections = append(m.Collections, Collection{})
-			if err := m.Collections[len(m.Collections)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.DenomId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MasterId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -261,25 +2226,43 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.MasterId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Printed", wireType)
+			}
+			m.Printed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Printed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
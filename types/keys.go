@@ -3,6 +3,7 @@ package types
 import (
 	"bytes"
 	"errors"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 
@@ -26,6 +27,60 @@ var (
 
 	ParamsKey = []byte{0x07}
 
+	PrefixEdition       = []byte{0x08}
+	PrefixEditionSupply = []byte{0x09}
+
+	PrefixClaim           = []byte{0x0A}
+	PrefixClaimCommitment = []byte{0x0B}
+	PrefixClaimRecord     = []byte{0x0C}
+	NextClaimIDKey        = []byte{0x0D}
+
+	PrefixAirdrop      = []byte{0x0E}
+	PrefixAirdropClaim = []byte{0x0F}
+	NextAirdropIDKey   = []byte{0x10}
+
+	PrefixSwap    = []byte{0x11}
+	NextSwapIDKey = []byte{0x12}
+
+	PrefixListing         = []byte{0x13}
+	PrefixListingByDenom  = []byte{0x14}
+	PrefixListingBySeller = []byte{0x15}
+	PrefixListingByPrice  = []byte{0x16}
+	PrefixListingByONFT   = []byte{0x17}
+	NextListingIDKey      = []byte{0x18}
+	PrefixOffer           = []byte{0x19}
+	NextOfferIDKey        = []byte{0x1A}
+
+	PrefixAuction          = []byte{0x1B}
+	PrefixAuctionByEndTime = []byte{0x1C}
+	PrefixBid              = []byte{0x1D}
+	NextAuctionIDKey       = []byte{0x1E}
+
+	PrefixLoan    = []byte{0x1F}
+	NextLoanIDKey = []byte{0x20}
+
+	PrefixFractionalization = []byte{0x21}
+
+	PrefixNestedParent   = []byte{0x22}
+	PrefixNestedChildren = []byte{0x23}
+
+	PrefixTokenAccount = []byte{0x24}
+
+	PrefixRecipe        = []byte{0x25}
+	PrefixRecipeByDenom = []byte{0x26}
+	NextRecipeIDKey     = []byte{0x27}
+
+	PrefixMetadataCommitment = []byte{0x28}
+	PrefixUnrevealedONFT     = []byte{0x29}
+
+	PrefixRevocation = []byte{0x2A}
+
+	PrefixRenewal  = []byte{0x2B}
+	PrefixExpiring = []byte{0x2C}
+
+	PrefixModerationStatus = []byte{0x2D}
+	PrefixFeeExemption     = []byte{0x2E}
+
 	delimiter = []byte("/")
 )
 
@@ -112,6 +167,353 @@ func KeyDenomSymbol(symbol string) []byte {
 	return append(key, []byte(symbol)...)
 }
 
+func KeyEdition(denomID, masterID string, editionNumber uint64) []byte {
+	key := append(PrefixEdition, delimiter...)
+	if len(denomID) > 0 {
+		key = append(key, []byte(denomID)...)
+		key = append(key, delimiter...)
+	}
+	if len(denomID) > 0 && len(masterID) > 0 {
+		key = append(key, []byte(masterID)...)
+		key = append(key, delimiter...)
+	}
+	if len(denomID) > 0 && len(masterID) > 0 && editionNumber > 0 {
+		key = append(key, sdk.Uint64ToBigEndian(editionNumber)...)
+	}
+	return key
+}
+
+func KeyEditionSupply(denomID, masterID string) []byte {
+	key := append(PrefixEditionSupply, delimiter...)
+	if len(denomID) > 0 {
+		key = append(key, []byte(denomID)...)
+		key = append(key, delimiter...)
+	}
+	if len(denomID) > 0 && len(masterID) > 0 {
+		key = append(key, []byte(masterID)...)
+	}
+	return key
+}
+
+func KeyClaim(id uint64) []byte {
+	key := append(PrefixClaim, delimiter...)
+	if id > 0 {
+		key = append(key, sdk.Uint64ToBigEndian(id)...)
+	}
+	return key
+}
+
+func KeyClaimCommitment(id uint64, claimant sdk.AccAddress) []byte {
+	key := append(PrefixClaimCommitment, delimiter...)
+	if id > 0 {
+		key = append(key, sdk.Uint64ToBigEndian(id)...)
+		key = append(key, delimiter...)
+	}
+	if id > 0 && claimant != nil {
+		key = append(key, claimant...)
+	}
+	return key
+}
+
+func KeyClaimRecord(id uint64, claimant sdk.AccAddress) []byte {
+	key := append(PrefixClaimRecord, delimiter...)
+	if id > 0 {
+		key = append(key, sdk.Uint64ToBigEndian(id)...)
+		key = append(key, delimiter...)
+	}
+	if id > 0 && claimant != nil {
+		key = append(key, claimant...)
+	}
+	return key
+}
+
+func KeyAirdrop(id uint64) []byte {
+	key := append(PrefixAirdrop, delimiter...)
+	if id > 0 {
+		key = append(key, sdk.Uint64ToBigEndian(id)...)
+	}
+	return key
+}
+
+func KeyAirdropClaim(id uint64, leafHash []byte) []byte {
+	key := append(PrefixAirdropClaim, delimiter...)
+	if id > 0 {
+		key = append(key, sdk.Uint64ToBigEndian(id)...)
+		key = append(key, delimiter...)
+	}
+	if id > 0 && leafHash != nil {
+		key = append(key, leafHash...)
+	}
+	return key
+}
+
+func KeySwap(id uint64) []byte {
+	key := append(PrefixSwap, delimiter...)
+	if id > 0 {
+		key = append(key, sdk.Uint64ToBigEndian(id)...)
+	}
+	return key
+}
+
+func KeyListing(id uint64) []byte {
+	key := append(PrefixListing, delimiter...)
+	if id > 0 {
+		key = append(key, sdk.Uint64ToBigEndian(id)...)
+	}
+	return key
+}
+
+func KeyListingByDenom(denomID string, id uint64) []byte {
+	key := append(PrefixListingByDenom, delimiter...)
+	if len(denomID) > 0 {
+		key = append(key, []byte(denomID)...)
+		key = append(key, delimiter...)
+	}
+	if len(denomID) > 0 && id > 0 {
+		key = append(key, sdk.Uint64ToBigEndian(id)...)
+	}
+	return key
+}
+
+func KeyListingBySeller(seller sdk.AccAddress, id uint64) []byte {
+	key := append(PrefixListingBySeller, delimiter...)
+	if seller != nil {
+		key = append(key, []byte(seller)...)
+		key = append(key, delimiter...)
+	}
+	if seller != nil && id > 0 {
+		key = append(key, sdk.Uint64ToBigEndian(id)...)
+	}
+	return key
+}
+
+// KeyListingByPrice indexes listings by price denom and amount. The amount is
+// encoded as a fixed size big endian integer, so listings iterate in
+// ascending price order.
+func KeyListingByPrice(price sdk.Coin, id uint64) []byte {
+	key := append(PrefixListingByPrice, delimiter...)
+	if len(price.Denom) > 0 {
+		key = append(key, []byte(price.Denom)...)
+		key = append(key, delimiter...)
+	}
+	if len(price.Denom) > 0 && id > 0 {
+		key = append(key, price.Amount.BigInt().FillBytes(make([]byte, 32))...)
+		key = append(key, sdk.Uint64ToBigEndian(id)...)
+	}
+	return key
+}
+
+func KeyListingByONFT(denomID, onftID string) []byte {
+	key := append(PrefixListingByONFT, delimiter...)
+	if len(denomID) > 0 {
+		key = append(key, []byte(denomID)...)
+		key = append(key, delimiter...)
+	}
+	if len(denomID) > 0 && len(onftID) > 0 {
+		key = append(key, []byte(onftID)...)
+	}
+	return key
+}
+
+func KeyOffer(id uint64) []byte {
+	key := append(PrefixOffer, delimiter...)
+	if id > 0 {
+		key = append(key, sdk.Uint64ToBigEndian(id)...)
+	}
+	return key
+}
+
+func KeyAuction(id uint64) []byte {
+	key := append(PrefixAuction, delimiter...)
+	if id > 0 {
+		key = append(key, sdk.Uint64ToBigEndian(id)...)
+	}
+	return key
+}
+
+// KeyAuctionByEndTime indexes active auctions by end time, so auctions that
+// ended iterate first.
+func KeyAuctionByEndTime(endTime time.Time, id uint64) []byte {
+	key := append(PrefixAuctionByEndTime, delimiter...)
+	if !endTime.IsZero() {
+		key = append(key, sdk.FormatTimeBytes(endTime)...)
+	}
+	if !endTime.IsZero() && id > 0 {
+		key = append(key, sdk.Uint64ToBigEndian(id)...)
+	}
+	return key
+}
+
+func KeyBid(auctionID, seq uint64) []byte {
+	key := append(PrefixBid, delimiter...)
+	if auctionID > 0 {
+		key = append(key, sdk.Uint64ToBigEndian(auctionID)...)
+	}
+	if auctionID > 0 && seq > 0 {
+		key = append(key, sdk.Uint64ToBigEndian(seq)...)
+	}
+	return key
+}
+
+func KeyLoan(id uint64) []byte {
+	key := append(PrefixLoan, delimiter...)
+	if id > 0 {
+		key = append(key, sdk.Uint64ToBigEndian(id)...)
+	}
+	return key
+}
+
+func KeyFractionalization(denomID, onftID string) []byte {
+	key := append(PrefixFractionalization, delimiter...)
+	if len(denomID) > 0 {
+		key = append(key, []byte(denomID)...)
+		key = append(key, delimiter...)
+	}
+	if len(denomID) > 0 && len(onftID) > 0 {
+		key = append(key, []byte(onftID)...)
+	}
+	return key
+}
+
+func KeyNestedParent(denomID, onftID string) []byte {
+	key := append(PrefixNestedParent, delimiter...)
+	if len(denomID) > 0 {
+		key = append(key, []byte(denomID)...)
+		key = append(key, delimiter...)
+	}
+	if len(denomID) > 0 && len(onftID) > 0 {
+		key = append(key, []byte(onftID)...)
+	}
+	return key
+}
+
+func KeyNestedChild(parentDenomID, parentONFTID, denomID, onftID string) []byte {
+	key := append(PrefixNestedChildren, delimiter...)
+	if len(parentDenomID) > 0 {
+		key = append(key, []byte(parentDenomID)...)
+		key = append(key, delimiter...)
+	}
+	if len(parentDenomID) > 0 && len(parentONFTID) > 0 {
+		key = append(key, []byte(parentONFTID)...)
+		key = append(key, delimiter...)
+	}
+	if len(parentDenomID) > 0 && len(parentONFTID) > 0 && len(denomID) > 0 {
+		key = append(key, []byte(denomID)...)
+		key = append(key, delimiter...)
+	}
+	if len(parentDenomID) > 0 && len(parentONFTID) > 0 && len(denomID) > 0 && len(onftID) > 0 {
+		key = append(key, []byte(onftID)...)
+	}
+	return key
+}
+
+func KeyTokenAccount(denomID, onftID string) []byte {
+	key := append(PrefixTokenAccount, delimiter...)
+	if len(denomID) > 0 {
+		key = append(key, []byte(denomID)...)
+		key = append(key, delimiter...)
+	}
+	if len(denomID) > 0 && len(onftID) > 0 {
+		key = append(key, []byte(onftID)...)
+	}
+	return key
+}
+
+func KeyRecipe(id uint64) []byte {
+	key := append(PrefixRecipe, delimiter...)
+	if id > 0 {
+		key = append(key, sdk.Uint64ToBigEndian(id)...)
+	}
+	return key
+}
+
+func KeyRecipeByDenom(denomID string, id uint64) []byte {
+	key := append(PrefixRecipeByDenom, delimiter...)
+	if len(denomID) > 0 {
+		key = append(key, []byte(denomID)...)
+		key = append(key, delimiter...)
+	}
+	if len(denomID) > 0 && id > 0 {
+		key = append(key, sdk.Uint64ToBigEndian(id)...)
+	}
+	return key
+}
+
+func KeyMetadataCommitment(denomID string) []byte {
+	key := append(PrefixMetadataCommitment, delimiter...)
+	return append(key, []byte(denomID)...)
+}
+
+func KeyUnrevealedONFT(denomID, onftID string) []byte {
+	key := append(PrefixUnrevealedONFT, delimiter...)
+	if len(denomID) > 0 {
+		key = append(key, []byte(denomID)...)
+		key = append(key, delimiter...)
+	}
+	if len(denomID) > 0 && len(onftID) > 0 {
+		key = append(key, []byte(onftID)...)
+	}
+	return key
+}
+
+func KeyRevocation(denomID, onftID string) []byte {
+	key := append(PrefixRevocation, delimiter...)
+	if len(denomID) > 0 {
+		key = append(key, []byte(denomID)...)
+		key = append(key, delimiter...)
+	}
+	if len(denomID) > 0 && len(onftID) > 0 {
+		key = append(key, []byte(onftID)...)
+	}
+	return key
+}
+
+// KeyRenewal stores the renewals of an onft by the expiry they paid for,
+// which increases with every renewal.
+func KeyRenewal(denomID, onftID string, expiresAt time.Time) []byte {
+	key := append(PrefixRenewal, delimiter...)
+	if len(denomID) > 0 {
+		key = append(key, []byte(denomID)...)
+		key = append(key, delimiter...)
+	}
+	if len(denomID) > 0 && len(onftID) > 0 {
+		key = append(key, []byte(onftID)...)
+		key = append(key, delimiter...)
+	}
+	if len(denomID) > 0 && len(onftID) > 0 && !expiresAt.IsZero() {
+		key = append(key, sdk.FormatTimeBytes(expiresAt)...)
+	}
+	return key
+}
+
+// KeyExpiring indexes subscription onfts by expiry, so expired onfts
+// iterate first.
+func KeyExpiring(expiresAt time.Time, denomID, onftID string) []byte {
+	key := append(PrefixExpiring, delimiter...)
+	if !expiresAt.IsZero() {
+		key = append(key, sdk.FormatTimeBytes(expiresAt)...)
+		key = append(key, delimiter...)
+	}
+	if !expiresAt.IsZero() && len(denomID) > 0 {
+		key = append(key, []byte(denomID)...)
+		key = append(key, delimiter...)
+	}
+	if !expiresAt.IsZero() && len(denomID) > 0 && len(onftID) > 0 {
+		key = append(key, []byte(onftID)...)
+	}
+	return key
+}
+
+func KeyModerationStatus(denomID string) []byte {
+	key := append(PrefixModerationStatus, delimiter...)
+	return append(key, []byte(denomID)...)
+}
+
+func KeyFeeExemption(address sdk.AccAddress) []byte {
+	key := append(PrefixFeeExemption, delimiter...)
+	return append(key, []byte(address.String())...)
+}
+
 func MustMarshalSupply(cdc codec.BinaryCodec, supply uint64) []byte {
 	supplyWrap := gogotypes.UInt64Value{Value: supply}
 	return cdc.MustMarshal(&supplyWrap)
@@ -0,0 +1,77 @@
+package types
+
+import (
+	"strings"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MaxLoanDuration is the max duration of a loan, it keeps the due time of a
+// funded loan within reach so the collateral can be claimed on default
+const MaxLoanDuration = 5 * 365 * 24 * time.Hour
+
+// ValidateLoanTerms checks that the principal is positive, the interest is in
+// the same denom and the duration is positive and at most MaxLoanDuration.
+func ValidateLoanTerms(principal, interest sdk.Coin, duration time.Duration) error {
+	if !principal.IsValid() || !principal.IsPositive() {
+		return errorsmod.Wrapf(ErrInvalidLoan, "invalid principal %s, must be positive", principal)
+	}
+	if !interest.IsValid() || interest.Denom != principal.Denom {
+		return errorsmod.Wrapf(ErrInvalidLoan, "invalid interest %s, must be in %s", interest, principal.Denom)
+	}
+	if duration <= 0 {
+		return errorsmod.Wrapf(ErrInvalidLoan, "invalid duration %s, must be positive", duration)
+	}
+	if duration > MaxLoanDuration {
+		return errorsmod.Wrapf(ErrInvalidLoan, "duration must be at most %s, got %s", MaxLoanDuration, duration)
+	}
+	return nil
+}
+
+// IsOverdue returns true if the loan is active and its due time has passed
+func (l Loan) IsOverdue(blockTime time.Time) bool {
+	return l.Status == LoanStatusActive && !blockTime.Before(l.DueTime)
+}
+
+// RepayAmount returns the principal plus interest owed to the lender
+func (l Loan) RepayAmount() sdk.Coins {
+	return sdk.NewCoins(l.Principal.Add(l.Interest))
+}
+
+// Validate checks the stateless consistency of a stored loan
+func (l Loan) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(l.Borrower); err != nil {
+		return err
+	}
+	if err := ValidateDenomID(l.DenomId); err != nil {
+		return err
+	}
+	if err := ValidateONFTID(l.OnftId); err != nil {
+		return err
+	}
+	switch l.Status {
+	case LoanStatusRequested:
+	case LoanStatusActive:
+		if _, err := sdk.AccAddressFromBech32(l.Lender); err != nil {
+			return err
+		}
+	default:
+		return errorsmod.Wrapf(ErrInvalidLoan, "invalid status %s of loan %d", l.Status, l.Id)
+	}
+	return ValidateLoanTerms(l.Principal, l.Interest, l.Duration)
+}
+
+// ParseLoanStatus parses the short name of a loan status
+func ParseLoanStatus(name string) (LoanStatus, error) {
+	switch strings.ToLower(strings.TrimSpace(name)) {
+	case "":
+		return LoanStatusUnspecified, nil
+	case "requested":
+		return LoanStatusRequested, nil
+	case "active":
+		return LoanStatusActive, nil
+	}
+	return LoanStatusUnspecified, errorsmod.Wrapf(ErrInvalidLoan, "unknown loan status %s", name)
+}
@@ -0,0 +1,844 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: OmniFlix/onft/v1beta1/loan.proto
+
+package types
+
+import (
+	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// LoanStatus is the state of a loan
+type LoanStatus int32
+
+const (
+	LoanStatusUnspecified LoanStatus = 0
+	// LOAN_STATUS_REQUESTED is a loan waiting for a lender
+	LoanStatusRequested LoanStatus = 1
+	// LOAN_STATUS_ACTIVE is a funded loan waiting for repayment
+	LoanStatusActive LoanStatus = 2
+)
+
+var LoanStatus_name = map[int32]string{
+	0: "LOAN_STATUS_UNSPECIFIED",
+	1: "LOAN_STATUS_REQUESTED",
+	2: "LOAN_STATUS_ACTIVE",
+}
+
+var LoanStatus_value = map[string]int32{
+	"LOAN_STATUS_UNSPECIFIED": 0,
+	"LOAN_STATUS_REQUESTED":   1,
+	"LOAN_STATUS_ACTIVE":      2,
+}
+
+func (x LoanStatus) String() string {
+	return proto.EnumName(LoanStatus_name, int32(x))
+}
+
+func (LoanStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_8216c433559efea6, []int{0}
+}
+
+// Loan borrows principal against an oNFT escrowed in the module account. The
+// borrower repays principal plus interest before due_time to get the oNFT
+// back, otherwise the lender can claim the oNFT.
+type Loan struct {
+	Id         uint64        `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	DenomId    string        `protobuf:"bytes,2,opt,name=denom_id,json=denomId,proto3" json:"denom_id,omitempty" yaml:"denom_id"`
+	OnftId     string        `protobuf:"bytes,3,opt,name=onft_id,json=onftId,proto3" json:"onft_id,omitempty" yaml:"onft_id"`
+	Borrower   string        `protobuf:"bytes,4,opt,name=borrower,proto3" json:"borrower,omitempty"`
+	Lender     string        `protobuf:"bytes,5,opt,name=lender,proto3" json:"lender,omitempty"`
+	Principal  types.Coin    `protobuf:"bytes,6,opt,name=principal,proto3" json:"principal"`
+	Interest   types.Coin    `protobuf:"bytes,7,opt,name=interest,proto3" json:"interest"`
+	Duration   time.Duration `protobuf:"bytes,8,opt,name=duration,proto3,stdduration" json:"duration"`
+	Status     LoanStatus    `protobuf:"varint,9,opt,name=status,proto3,enum=OmniFlix.onft.v1beta1.LoanStatus" json:"status,omitempty"`
+	FundedTime time.Time     `protobuf:"bytes,10,opt,name=funded_time,json=fundedTime,proto3,stdtime" json:"funded_time" yaml:"funded_time"`
+	DueTime    time.Time     `protobuf:"bytes,11,opt,name=due_time,json=dueTime,proto3,stdtime" json:"due_time" yaml:"due_time"`
+}
+
+func (m *Loan) Reset()         { *m = Loan{} }
+func (m *Loan) String() string { return proto.CompactTextString(m) }
+func (*Loan) ProtoMessage()    {}
+func (*Loan) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8216c433559efea6, []int{0}
+}
+func (m *Loan) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Loan) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Loan.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Loan) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Loan.Merge(m, src)
+}
+func (m *Loan) XXX_Size() int {
+	return m.Size()
+}
+func (m *Loan) XXX_DiscardUnknown() {
+	xxx_messageInfo_Loan.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Loan proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterEnum("OmniFlix.onft.v1beta1.LoanStatus", LoanStatus_name, LoanStatus_value)
+	proto.RegisterType((*Loan)(nil), "OmniFlix.onft.v1beta1.Loan")
+}
+
+func init() { proto.RegisterFile("OmniFlix/onft/v1beta1/loan.proto", fileDescriptor_8216c433559efea6) }
+
+var fileDescriptor_8216c433559efea6 = []byte{
+	// 615 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0x4d, 0x6f, 0xd3, 0x4c,
+	0x10, 0xc7, 0xed, 0x3c, 0x7e, 0x92, 0x74, 0x2b, 0x95, 0x68, 0xdb, 0x52, 0xd7, 0x48, 0xb6, 0xc9,
+	0x29, 0x02, 0xb4, 0x56, 0x8b, 0x84, 0x44, 0x01, 0xa1, 0xa4, 0x4d, 0xa5, 0x48, 0x55, 0x0b, 0x4e,
+	0xc2, 0x01, 0x0e, 0x91, 0x93, 0xdd, 0x84, 0x95, 0xe2, 0x5d, 0x63, 0xaf, 0x0b, 0xfd, 0x06, 0xa8,
+	0xa7, 0x1e, 0xb9, 0x54, 0x42, 0xe2, 0xca, 0x07, 0xe9, 0xb1, 0x27, 0xc4, 0x29, 0x40, 0x7b, 0xe1,
+	0xdc, 0x4f, 0x80, 0xd6, 0x2f, 0x49, 0x78, 0x91, 0xe0, 0xb6, 0xe3, 0xf9, 0xfd, 0x67, 0x76, 0xfe,
+	0x3b, 0x06, 0xf6, 0x81, 0xcf, 0xe8, 0xee, 0x98, 0xbe, 0x71, 0x38, 0x1b, 0x0a, 0xe7, 0x70, 0xa3,
+	0x4f, 0x84, 0xb7, 0xe1, 0x8c, 0xb9, 0xc7, 0x50, 0x10, 0x72, 0xc1, 0xe1, 0x6a, 0x4e, 0x20, 0x49,
+	0xa0, 0x8c, 0x30, 0x56, 0x46, 0x7c, 0xc4, 0x13, 0xc2, 0x91, 0xa7, 0x14, 0x36, 0xac, 0x11, 0xe7,
+	0xa3, 0x31, 0x71, 0x92, 0xa8, 0x1f, 0x0f, 0x1d, 0x41, 0x7d, 0x12, 0x09, 0xcf, 0x0f, 0x32, 0xc0,
+	0xfc, 0x15, 0xc0, 0x71, 0xe8, 0x09, 0xca, 0x59, 0x9e, 0x1f, 0xf0, 0xc8, 0xe7, 0x91, 0xd3, 0xf7,
+	0x22, 0x32, 0xbd, 0xcd, 0x80, 0xd3, 0x2c, 0x5f, 0xfd, 0xa4, 0x01, 0x6d, 0x8f, 0x7b, 0x0c, 0x2e,
+	0x81, 0x02, 0xc5, 0xba, 0x6a, 0xab, 0x35, 0xcd, 0x2d, 0x50, 0x0c, 0x11, 0x28, 0x63, 0xc2, 0xb8,
+	0xdf, 0xa3, 0x58, 0x2f, 0xd8, 0x6a, 0x6d, 0xa1, 0xb1, 0x7c, 0x35, 0xb1, 0xae, 0x1d, 0x79, 0xfe,
+	0x78, 0xab, 0x9a, 0x67, 0xaa, 0x6e, 0x29, 0x39, 0xb6, 0x30, 0xbc, 0x0d, 0x4a, 0x72, 0x1e, 0x89,
+	0xff, 0x97, 0xe0, 0xf0, 0x6a, 0x62, 0x2d, 0xa5, 0x78, 0x96, 0xa8, 0xba, 0x45, 0x79, 0x6a, 0x61,
+	0x68, 0x80, 0x72, 0x9f, 0x87, 0x21, 0x7f, 0x4d, 0x42, 0x5d, 0x93, 0xb4, 0x3b, 0x8d, 0xe1, 0x75,
+	0x50, 0x1c, 0x13, 0x86, 0x49, 0xa8, 0xff, 0x9f, 0x64, 0xb2, 0x08, 0x3e, 0x02, 0x0b, 0x41, 0x48,
+	0xd9, 0x80, 0x06, 0xde, 0x58, 0x2f, 0xda, 0x6a, 0x6d, 0x71, 0x73, 0x1d, 0xa5, 0xd3, 0x21, 0x39,
+	0x5d, 0xee, 0x24, 0xda, 0xe6, 0x94, 0x35, 0xb4, 0xb3, 0x89, 0xa5, 0xb8, 0x33, 0x05, 0x7c, 0x00,
+	0xca, 0x94, 0x09, 0x12, 0x92, 0x48, 0xe8, 0xa5, 0x7f, 0x53, 0x4f, 0x05, 0xf0, 0x31, 0x28, 0xe7,
+	0xbe, 0xea, 0xe5, 0x4c, 0x9c, 0x1a, 0x8f, 0x72, 0xe3, 0xd1, 0x4e, 0x06, 0x34, 0xca, 0x52, 0xfc,
+	0xee, 0x8b, 0xa5, 0xba, 0x53, 0x11, 0xbc, 0x0f, 0x8a, 0x91, 0xf0, 0x44, 0x1c, 0xe9, 0x0b, 0xb6,
+	0x5a, 0x5b, 0xda, 0xbc, 0x89, 0xfe, 0xb8, 0x05, 0x48, 0x3e, 0x45, 0x3b, 0x01, 0xdd, 0x4c, 0x00,
+	0x5f, 0x80, 0xc5, 0x61, 0xcc, 0x30, 0xc1, 0x3d, 0xf9, 0xf6, 0x3a, 0x48, 0xda, 0x1b, 0xbf, 0xb5,
+	0xef, 0xe4, 0x8b, 0xd1, 0x30, 0x65, 0xff, 0xab, 0x89, 0x05, 0x53, 0xf3, 0xe7, 0xc4, 0xd5, 0x13,
+	0x79, 0x2b, 0x90, 0x7e, 0x91, 0x02, 0xe8, 0xca, 0xc1, 0x48, 0x5a, 0x79, 0xf1, 0xaf, 0x95, 0x6f,
+	0x64, 0x95, 0xf3, 0x2d, 0x88, 0xc9, 0x5c, 0xd9, 0x12, 0x8e, 0x89, 0x44, 0xb7, 0xb4, 0xef, 0xef,
+	0x2d, 0xf5, 0xd6, 0x47, 0x15, 0x80, 0xd9, 0x34, 0xf0, 0x1e, 0x58, 0xdb, 0x3b, 0xa8, 0xef, 0xf7,
+	0xda, 0x9d, 0x7a, 0xa7, 0xdb, 0xee, 0x75, 0xf7, 0xdb, 0x4f, 0x9a, 0xdb, 0xad, 0xdd, 0x56, 0x73,
+	0xa7, 0xa2, 0x18, 0xeb, 0xc7, 0xa7, 0xf6, 0xea, 0x0c, 0xee, 0xb2, 0x28, 0x20, 0x03, 0x3a, 0xa4,
+	0x04, 0xc3, 0x4d, 0xb0, 0x3a, 0xaf, 0x73, 0x9b, 0x4f, 0xbb, 0xcd, 0x76, 0xa7, 0xb9, 0x53, 0x51,
+	0x8d, 0xb5, 0xe3, 0x53, 0x7b, 0x79, 0xce, 0x30, 0xf2, 0x2a, 0x26, 0x91, 0x20, 0x18, 0xde, 0x01,
+	0x70, 0x5e, 0x53, 0xdf, 0xee, 0xb4, 0x9e, 0x35, 0x2b, 0x05, 0x63, 0xe5, 0xf8, 0xd4, 0xae, 0xcc,
+	0x04, 0xf5, 0x81, 0xa0, 0x87, 0xc4, 0xd0, 0xde, 0x7e, 0x30, 0x95, 0xc6, 0xc3, 0xb3, 0x6f, 0xa6,
+	0x72, 0x76, 0x61, 0xaa, 0xe7, 0x17, 0xa6, 0xfa, 0xf5, 0xc2, 0x54, 0x4f, 0x2e, 0x4d, 0xe5, 0xfc,
+	0xd2, 0x54, 0x3e, 0x5f, 0x9a, 0xca, 0x73, 0x73, 0x44, 0xc5, 0xcb, 0xb8, 0x8f, 0x06, 0xdc, 0x77,
+	0x7e, 0xfe, 0xc1, 0xc5, 0x51, 0x40, 0xa2, 0x7e, 0x31, 0x31, 0xeb, 0xee, 0x8f, 0x01, 0x00, 0xb3,
+	0x76, 0xa5, 0xf8, 0xfe, 0x03, 0x00, 0x00,
+}
+
+func (this *Loan) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Loan)
+	if !ok {
+		that2, ok := that.(Loan)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Id != that1.Id {
+		return false
+	}
+	if this.DenomId != that1.DenomId {
+		return false
+	}
+	if this.OnftId != that1.OnftId {
+		return false
+	}
+	if this.Borrower != that1.Borrower {
+		return false
+	}
+	if this.Lender != that1.Lender {
+		return false
+	}
+	if !this.Principal.Equal(&that1.Principal) {
+		return false
+	}
+	if !this.Interest.Equal(&that1.Interest) {
+		return false
+	}
+	if this.Duration != that1.Duration {
+		return false
+	}
+	if this.Status != that1.Status {
+		return false
+	}
+	if !this.FundedTime.Equal(that1.FundedTime) {
+		return false
+	}
+	if !this.DueTime.Equal(that1.DueTime) {
+		return false
+	}
+	return true
+}
+func (m *Loan) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Loan) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Loan) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.DueTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.DueTime):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintLoan(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x5a
+	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.FundedTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.FundedTime):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintLoan(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x52
+	if m.Status != 0 {
+		i = encodeVarintLoan(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x48
+	}
+	n3, err3 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Duration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Duration):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintLoan(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x42
+	{
+		size, err := m.Interest.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintLoan(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size, err := m.Principal.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintLoan(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if len(m.Lender) > 0 {
+		i -= len(m.Lender)
+		copy(dAtA[i:], m.Lender)
+		i = encodeVarintLoan(dAtA, i, uint64(len(m.Lender)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Borrower) > 0 {
+		i -= len(m.Borrower)
+		copy(dAtA[i:], m.Borrower)
+		i = encodeVarintLoan(dAtA, i, uint64(len(m.Borrower)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.OnftId) > 0 {
+		i -= len(m.OnftId)
+		copy(dAtA[i:], m.OnftId)
+		i = encodeVarintLoan(dAtA, i, uint64(len(m.OnftId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.DenomId) > 0 {
+		i -= len(m.DenomId)
+		copy(dAtA[i:], m.DenomId)
+		i = encodeVarintLoan(dAtA, i, uint64(len(m.DenomId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintLoan(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintLoan(dAtA []byte, offset int, v uint64) int {
+	offset -= sovLoan(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Loan) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovLoan(uint64(m.Id))
+	}
+	l = len(m.DenomId)
+	if l > 0 {
+		n += 1 + l + sovLoan(uint64(l))
+	}
+	l = len(m.OnftId)
+	if l > 0 {
+		n += 1 + l + sovLoan(uint64(l))
+	}
+	l = len(m.Borrower)
+	if l > 0 {
+		n += 1 + l + sovLoan(uint64(l))
+	}
+	l = len(m.Lender)
+	if l > 0 {
+		n += 1 + l + sovLoan(uint64(l))
+	}
+	l = m.Principal.Size()
+	n += 1 + l + sovLoan(uint64(l))
+	l = m.Interest.Size()
+	n += 1 + l + sovLoan(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Duration)
+	n += 1 + l + sovLoan(uint64(l))
+	if m.Status != 0 {
+		n += 1 + sovLoan(uint64(m.Status))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.FundedTime)
+	n += 1 + l + sovLoan(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.DueTime)
+	n += 1 + l + sovLoan(uint64(l))
+	return n
+}
+
+func sovLoan(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozLoan(x uint64) (n int) {
+	return sovLoan(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Loan) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLoan
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Loan: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Loan: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLoan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLoan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLoan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLoan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OnftId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLoan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLoan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLoan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OnftId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Borrower", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLoan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLoan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLoan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Borrower = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLoan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLoan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLoan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Lender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Principal", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLoan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLoan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLoan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Principal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Interest", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLoan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLoan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLoan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Interest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLoan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLoan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLoan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Duration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLoan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= LoanStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FundedTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLoan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLoan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLoan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.FundedTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DueTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLoan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLoan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLoan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.DueTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLoan(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLoan
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipLoan(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowLoan
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowLoan
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowLoan
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthLoan
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupLoan
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthLoan
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthLoan        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowLoan          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupLoan = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,43 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/OmniFlix/onft/types"
+)
+
+func TestValidateLoanTerms(t *testing.T) {
+	principal := sdk.NewInt64Coin("uflix", 1000)
+	interest := sdk.NewInt64Coin("uflix", 50)
+
+	tests := []struct {
+		name      string
+		principal sdk.Coin
+		interest  sdk.Coin
+		duration  time.Duration
+		valid     bool
+	}{
+		{"valid", principal, interest, 720 * time.Hour, true},
+		{"zero interest", principal, sdk.NewInt64Coin("uflix", 0), time.Hour, true},
+		{"max duration", principal, interest, types.MaxLoanDuration, true},
+		{"zero principal", sdk.NewInt64Coin("uflix", 0), interest, time.Hour, false},
+		{"interest in another denom", principal, sdk.NewInt64Coin("stake", 50), time.Hour, false},
+		{"zero duration", principal, interest, 0, false},
+		{"negative duration", principal, interest, -time.Hour, false},
+		{"duration above max", principal, interest, types.MaxLoanDuration + time.Nanosecond, false},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := types.ValidateLoanTerms(tc.principal, tc.interest, tc.duration)
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, types.ErrInvalidLoan)
+			}
+		})
+	}
+}
@@ -0,0 +1,61 @@
+package types
+
+import (
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ValidatePrice checks that a sale price is a valid positive coin
+func ValidatePrice(price sdk.Coin) error {
+	if !price.IsValid() || !price.IsPositive() {
+		return errorsmod.Wrapf(ErrInvalidListing, "invalid price %s, must be positive", price)
+	}
+	return nil
+}
+
+// IsExpired returns true if the listing has an expiry that has passed
+func (l Listing) IsExpired(blockTime time.Time) bool {
+	return !l.Expiry.IsZero() && !blockTime.Before(l.Expiry)
+}
+
+// Validate checks the stateless consistency of a stored listing
+func (l Listing) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(l.Seller); err != nil {
+		return err
+	}
+	if err := ValidateDenomID(l.DenomId); err != nil {
+		return err
+	}
+	if err := ValidateONFTID(l.OnftId); err != nil {
+		return err
+	}
+	return ValidatePrice(l.Price)
+}
+
+// IsExpired returns true if the offer has an expiry that has passed
+func (o Offer) IsExpired(blockTime time.Time) bool {
+	return !o.Expiry.IsZero() && !blockTime.Before(o.Expiry)
+}
+
+// IsCollectionOffer returns true if the offer can be accepted for any oNFT of the denom
+func (o Offer) IsCollectionOffer() bool {
+	return len(o.OnftId) == 0
+}
+
+// Validate checks the stateless consistency of a stored offer
+func (o Offer) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(o.Buyer); err != nil {
+		return err
+	}
+	if err := ValidateDenomID(o.DenomId); err != nil {
+		return err
+	}
+	if !o.IsCollectionOffer() {
+		if err := ValidateONFTID(o.OnftId); err != nil {
+			return err
+		}
+	}
+	return ValidatePrice(o.Amount)
+}
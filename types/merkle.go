@@ -0,0 +1,97 @@
+package types
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+)
+
+var (
+	merkleLeafPrefix = []byte{0x00}
+	merkleNodePrefix = []byte{0x01}
+)
+
+// MerkleLeafHash hashes the data of a merkle leaf. Leaves and inner nodes use
+// different prefixes, so an inner node can't be presented as a leaf.
+func MerkleLeafHash(data []byte) []byte {
+	hash := sha256.Sum256(append(append([]byte{}, merkleLeafPrefix...), data...))
+	return hash[:]
+}
+
+// MerkleNodeHash hashes a pair of sibling nodes. The pair is sorted before
+// hashing, so proofs don't need to carry the position of each sibling.
+func MerkleNodeHash(a, b []byte) []byte {
+	if bytes.Compare(a, b) > 0 {
+		a, b = b, a
+	}
+	data := append(append([]byte{}, merkleNodePrefix...), a...)
+	hash := sha256.Sum256(append(data, b...))
+	return hash[:]
+}
+
+// BuildMerkleTree returns the root of the tree over the given leaf hashes and
+// the proof of every leaf, in the order of the leaves. An odd node at the end
+// of a level is promoted to the next level unchanged.
+func BuildMerkleTree(leaves [][]byte) (root []byte, proofs [][][]byte) {
+	if len(leaves) == 0 {
+		return nil, nil
+	}
+	proofs = make([][][]byte, len(leaves))
+	// positions tracks the index of every leaf in the current level
+	positions := make([]int, len(leaves))
+	for i := range positions {
+		positions[i] = i
+	}
+
+	level := leaves
+	for len(level) > 1 {
+		next := make([][]byte, 0, (len(level)+1)/2)
+		for i := 0; i < len(level); i += 2 {
+			if i+1 == len(level) {
+				next = append(next, level[i])
+				continue
+			}
+			next = append(next, MerkleNodeHash(level[i], level[i+1]))
+		}
+		for leaf, pos := range positions {
+			sibling := pos ^ 1
+			if sibling < len(level) {
+				proofs[leaf] = append(proofs[leaf], level[sibling])
+			}
+			positions[leaf] = pos / 2
+		}
+		level = next
+	}
+	return level[0], proofs
+}
+
+// VerifyMerkleProof checks that the leaf hash is part of the tree with the given root
+func VerifyMerkleProof(root, leaf []byte, proof [][]byte) bool {
+	hash := leaf
+	for _, sibling := range proof {
+		hash = MerkleNodeHash(hash, sibling)
+	}
+	return bytes.Equal(hash, root)
+}
+
+// DecodeMerkleProof decodes a hex encoded merkle proof
+func DecodeMerkleProof(proof []string) ([][]byte, error) {
+	decoded := make([][]byte, len(proof))
+	for i, p := range proof {
+		bz, err := hex.DecodeString(p)
+		if err != nil || len(bz) != sha256.Size {
+			return nil, ErrInvalidMerkleProof.Wrapf("invalid proof node %s", p)
+		}
+		decoded[i] = bz
+	}
+	return decoded, nil
+}
+
+// EncodeMerkleProof hex encodes a merkle proof
+func EncodeMerkleProof(proof [][]byte) []string {
+	encoded := make([]string, len(proof))
+	for i, p := range proof {
+		encoded[i] = hex.EncodeToString(p)
+	}
+	return encoded
+}
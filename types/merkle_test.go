@@ -0,0 +1,75 @@
+package types_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/OmniFlix/onft/types"
+)
+
+func merkleLeaves(n int) [][]byte {
+	leaves := make([][]byte, n)
+	for i := range leaves {
+		leaves[i] = types.MerkleLeafHash([]byte(fmt.Sprintf("leaf%d", i)))
+	}
+	return leaves
+}
+
+func TestBuildMerkleTree(t *testing.T) {
+	for _, n := range []int{1, 2, 3, 4, 5, 7, 8, 13} {
+		t.Run(fmt.Sprintf("%d leaves", n), func(t *testing.T) {
+			leaves := merkleLeaves(n)
+			root, proofs := types.BuildMerkleTree(leaves)
+			require.Len(t, proofs, n)
+			for i, leaf := range leaves {
+				require.True(t, types.VerifyMerkleProof(root, leaf, proofs[i]), "leaf %d", i)
+			}
+			other := types.MerkleLeafHash([]byte("other"))
+			require.False(t, types.VerifyMerkleProof(root, other, proofs[0]))
+		})
+	}
+}
+
+func TestBuildMerkleTreeSingleLeaf(t *testing.T) {
+	leaves := merkleLeaves(1)
+	root, proofs := types.BuildMerkleTree(leaves)
+	require.Equal(t, leaves[0], root)
+	require.Empty(t, proofs[0])
+	require.True(t, types.VerifyMerkleProof(root, leaves[0], nil))
+	require.True(t, types.VerifyMerkleProof(root, leaves[0], proofs[0]))
+}
+
+func TestBuildMerkleTreeEmpty(t *testing.T) {
+	root, proofs := types.BuildMerkleTree(nil)
+	require.Nil(t, root)
+	require.Nil(t, proofs)
+}
+
+func TestVerifyMerkleProofRejectsInnerNode(t *testing.T) {
+	leaves := merkleLeaves(4)
+	root, proofs := types.BuildMerkleTree(leaves)
+	// the children of an inner node hashed as leaf data do not hash to the inner node
+	inner := types.MerkleNodeHash(leaves[0], leaves[1])
+	require.True(t, types.VerifyMerkleProof(root, inner, proofs[0][1:]))
+	forged := types.MerkleLeafHash(append(append([]byte{}, leaves[0]...), leaves[1]...))
+	require.NotEqual(t, inner, forged)
+	require.False(t, types.VerifyMerkleProof(root, forged, proofs[0][1:]))
+}
+
+func TestMerkleProofEncoding(t *testing.T) {
+	_, proofs := types.BuildMerkleTree(merkleLeaves(5))
+	decoded, err := types.DecodeMerkleProof(types.EncodeMerkleProof(proofs[4]))
+	require.NoError(t, err)
+	require.Equal(t, proofs[4], decoded)
+
+	decoded, err = types.DecodeMerkleProof(nil)
+	require.NoError(t, err)
+	require.Empty(t, decoded)
+
+	_, err = types.DecodeMerkleProof([]string{"abcd"})
+	require.ErrorIs(t, err, types.ErrInvalidMerkleProof)
+	_, err = types.DecodeMerkleProof([]string{"not hex"})
+	require.ErrorIs(t, err, types.ErrInvalidMerkleProof)
+}
@@ -0,0 +1,42 @@
+package types
+
+import (
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+)
+
+// MaxModerationReasonLen is the max length of the reason of a moderation
+const MaxModerationReasonLen = 256
+
+// ValidateModerationReason checks that a moderation action gives a reason
+func ValidateModerationReason(reason string) error {
+	if len(strings.TrimSpace(reason)) == 0 {
+		return errorsmod.Wrap(ErrInvalidModeration, "reason can not be empty")
+	}
+	if len(reason) > MaxModerationReasonLen {
+		return errorsmod.Wrapf(ErrInvalidModeration, "reason length must be at most %d", MaxModerationReasonLen)
+	}
+	return nil
+}
+
+// IsModerated returns true if any moderation applies to the denom
+func (s ModerationStatus) IsModerated() bool {
+	return s.Frozen || s.Nsfw || s.Hidden
+}
+
+// Validate checks the stateless consistency of a stored moderation status
+func (s ModerationStatus) Validate() error {
+	if err := ValidateDenomID(s.DenomId); err != nil {
+		return err
+	}
+	if !s.IsModerated() {
+		return errorsmod.Wrapf(ErrInvalidModeration, "denom %s is not moderated", s.DenomId)
+	}
+	for _, reason := range []string{s.FreezeReason, s.NsfwReason, s.HiddenReason} {
+		if len(reason) > MaxModerationReasonLen {
+			return errorsmod.Wrapf(ErrInvalidModeration, "reason length must be at most %d", MaxModerationReasonLen)
+		}
+	}
+	return nil
+}
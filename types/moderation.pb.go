@@ -0,0 +1,658 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: OmniFlix/onft/v1beta1/moderation.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// ModerationStatus is the moderation applied to a denom by governance, with
+// the reason given for each action
+type ModerationStatus struct {
+	DenomId string `protobuf:"bytes,1,opt,name=denom_id,json=denomId,proto3" json:"denom_id,omitempty" yaml:"denom_id"`
+	// frozen denoms can not mint or transfer oNFTs
+	Frozen       bool   `protobuf:"varint,2,opt,name=frozen,proto3" json:"frozen,omitempty"`
+	FreezeReason string `protobuf:"bytes,3,opt,name=freeze_reason,json=freezeReason,proto3" json:"freeze_reason,omitempty" yaml:"freeze_reason"`
+	// nsfw marks the denom and all its oNFTs NSFW
+	Nsfw       bool   `protobuf:"varint,4,opt,name=nsfw,proto3" json:"nsfw,omitempty"`
+	NsfwReason string `protobuf:"bytes,5,opt,name=nsfw_reason,json=nsfwReason,proto3" json:"nsfw_reason,omitempty" yaml:"nsfw_reason"`
+	// hidden denoms are left out of denom listings
+	Hidden       bool      `protobuf:"varint,6,opt,name=hidden,proto3" json:"hidden,omitempty"`
+	HiddenReason string    `protobuf:"bytes,7,opt,name=hidden_reason,json=hiddenReason,proto3" json:"hidden_reason,omitempty" yaml:"hidden_reason"`
+	UpdatedAt    time.Time `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3,stdtime" json:"updated_at" yaml:"updated_at"`
+}
+
+func (m *ModerationStatus) Reset()         { *m = ModerationStatus{} }
+func (m *ModerationStatus) String() string { return proto.CompactTextString(m) }
+func (*ModerationStatus) ProtoMessage()    {}
+func (*ModerationStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0772d0f4c49b013d, []int{0}
+}
+func (m *ModerationStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ModerationStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ModerationStatus.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ModerationStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ModerationStatus.Merge(m, src)
+}
+func (m *ModerationStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *ModerationStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_ModerationStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ModerationStatus proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*ModerationStatus)(nil), "OmniFlix.onft.v1beta1.ModerationStatus")
+}
+
+func init() {
+	proto.RegisterFile("OmniFlix/onft/v1beta1/moderation.proto", fileDescriptor_0772d0f4c49b013d)
+}
+
+var fileDescriptor_0772d0f4c49b013d = []byte{
+	// 404 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x92, 0xb1, 0x8e, 0xd3, 0x30,
+	0x1c, 0xc6, 0x63, 0xae, 0xe4, 0x7a, 0xbe, 0x43, 0x80, 0x39, 0xaa, 0xa8, 0x12, 0x76, 0x95, 0x01,
+	0x75, 0x72, 0x74, 0x30, 0x20, 0x9d, 0x60, 0xa0, 0x03, 0x12, 0x03, 0x42, 0x0a, 0x0c, 0x88, 0xa5,
+	0x72, 0xb0, 0x93, 0x46, 0x6a, 0xec, 0x28, 0x71, 0x80, 0xf6, 0x29, 0xfa, 0x02, 0x48, 0x3c, 0x4e,
+	0xc7, 0x8e, 0x4c, 0x01, 0xda, 0x85, 0x39, 0x4f, 0x80, 0x62, 0x27, 0x40, 0x99, 0xf2, 0xff, 0xf2,
+	0x7d, 0xfe, 0xf9, 0xd3, 0x5f, 0x86, 0x0f, 0x5f, 0x67, 0x32, 0x7d, 0xb1, 0x4c, 0x3f, 0x07, 0x4a,
+	0xc6, 0x3a, 0xf8, 0x78, 0x15, 0x09, 0xcd, 0xae, 0x82, 0x4c, 0x71, 0x51, 0x30, 0x9d, 0x2a, 0x49,
+	0xf3, 0x42, 0x69, 0x85, 0xee, 0xf7, 0x39, 0xda, 0xe6, 0x68, 0x97, 0x1b, 0x5f, 0x26, 0x2a, 0x51,
+	0x26, 0x11, 0xb4, 0x93, 0x0d, 0x8f, 0x49, 0xa2, 0x54, 0xb2, 0x14, 0x81, 0x51, 0x51, 0x15, 0x07,
+	0x3a, 0xcd, 0x44, 0xa9, 0x59, 0x96, 0xdb, 0x80, 0xff, 0xe5, 0x04, 0xde, 0x79, 0xf5, 0xe7, 0x8a,
+	0x37, 0x9a, 0xe9, 0xaa, 0x44, 0x14, 0x0e, 0xb9, 0x90, 0x2a, 0x9b, 0xa7, 0xdc, 0x03, 0x13, 0x30,
+	0x3d, 0x9b, 0xdd, 0x6b, 0x6a, 0x72, 0x7b, 0xc5, 0xb2, 0xe5, 0xb5, 0xdf, 0x3b, 0x7e, 0x78, 0x6a,
+	0xc6, 0x97, 0x1c, 0x8d, 0xa0, 0x1b, 0x17, 0x6a, 0x2d, 0xa4, 0x77, 0x63, 0x02, 0xa6, 0xc3, 0xb0,
+	0x53, 0xe8, 0x19, 0xbc, 0x15, 0x17, 0x42, 0xac, 0xc5, 0xbc, 0x10, 0xac, 0x54, 0xd2, 0x3b, 0x31,
+	0x30, 0xaf, 0xa9, 0xc9, 0xa5, 0x85, 0x1d, 0xd9, 0x7e, 0x78, 0x61, 0x75, 0x68, 0x24, 0x42, 0x70,
+	0x20, 0xcb, 0xf8, 0x93, 0x37, 0x30, 0x50, 0x33, 0xa3, 0x27, 0xf0, 0xbc, 0xfd, 0xf6, 0xc0, 0x9b,
+	0x06, 0x38, 0x6a, 0x6a, 0x82, 0x2c, 0xf0, 0x1f, 0xd3, 0x0f, 0x61, 0xab, 0x3a, 0xd8, 0x08, 0xba,
+	0x8b, 0x94, 0x73, 0x21, 0x3d, 0xd7, 0x76, 0xb4, 0xaa, 0xed, 0x68, 0xa7, 0x1e, 0x79, 0xfa, 0x7f,
+	0xc7, 0x23, 0xdb, 0x0f, 0x2f, 0xac, 0xee, 0xb0, 0xef, 0x20, 0xac, 0x72, 0xce, 0xb4, 0xe0, 0x73,
+	0xa6, 0xbd, 0xe1, 0x04, 0x4c, 0xcf, 0x1f, 0x8d, 0xa9, 0xdd, 0x3a, 0xed, 0xb7, 0x4e, 0xdf, 0xf6,
+	0x5b, 0x9f, 0x3d, 0xd8, 0xd6, 0xc4, 0x69, 0x6a, 0x72, 0xd7, 0xb2, 0xff, 0x9e, 0xf5, 0x37, 0xdf,
+	0x09, 0x08, 0xcf, 0xba, 0x1f, 0xcf, 0xf5, 0xf5, 0xe0, 0xd7, 0x57, 0x02, 0x66, 0x4f, 0xb7, 0x3f,
+	0xb1, 0xb3, 0xdd, 0x63, 0xb0, 0xdb, 0x63, 0xf0, 0x63, 0x8f, 0xc1, 0xe6, 0x80, 0x9d, 0xdd, 0x01,
+	0x3b, 0xdf, 0x0e, 0xd8, 0x79, 0x8f, 0x93, 0x54, 0x2f, 0xaa, 0x88, 0x7e, 0x50, 0x59, 0x70, 0xfc,
+	0x7c, 0xf4, 0x2a, 0x17, 0x65, 0xe4, 0x9a, 0x06, 0x8f, 0x7f, 0x0f, 0x00, 0xcb, 0xeb, 0x2e, 0x0e,
+	0x5c, 0x02, 0x00, 0x00,
+}
+
+func (this *ModerationStatus) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ModerationStatus)
+	if !ok {
+		that2, ok := that.(ModerationStatus)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.DenomId != that1.DenomId {
+		return false
+	}
+	if this.Frozen != that1.Frozen {
+		return false
+	}
+	if this.FreezeReason != that1.FreezeReason {
+		return false
+	}
+	if this.Nsfw != that1.Nsfw {
+		return false
+	}
+	if this.NsfwReason != that1.NsfwReason {
+		return false
+	}
+	if this.Hidden != that1.Hidden {
+		return false
+	}
+	if this.HiddenReason != that1.HiddenReason {
+		return false
+	}
+	if !this.UpdatedAt.Equal(that1.UpdatedAt) {
+		return false
+	}
+	return true
+}
+func (m *ModerationStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ModerationStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ModerationStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.UpdatedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.UpdatedAt):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintModeration(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x42
+	if len(m.HiddenReason) > 0 {
+		i -= len(m.HiddenReason)
+		copy(dAtA[i:], m.HiddenReason)
+		i = encodeVarintModeration(dAtA, i, uint64(len(m.HiddenReason)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.Hidden {
+		i--
+		if m.Hidden {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.NsfwReason) > 0 {
+		i -= len(m.NsfwReason)
+		copy(dAtA[i:], m.NsfwReason)
+		i = encodeVarintModeration(dAtA, i, uint64(len(m.NsfwReason)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Nsfw {
+		i--
+		if m.Nsfw {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.FreezeReason) > 0 {
+		i -= len(m.FreezeReason)
+		copy(dAtA[i:], m.FreezeReason)
+		i = encodeVarintModeration(dAtA, i, uint64(len(m.FreezeReason)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Frozen {
+		i--
+		if m.Frozen {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.DenomId) > 0 {
+		i -= len(m.DenomId)
+		copy(dAtA[i:], m.DenomId)
+		i = encodeVarintModeration(dAtA, i, uint64(len(m.DenomId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintModeration(dAtA []byte, offset int, v uint64) int {
+	offset -= sovModeration(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ModerationStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DenomId)
+	if l > 0 {
+		n += 1 + l + sovModeration(uint64(l))
+	}
+	if m.Frozen {
+		n += 2
+	}
+	l = len(m.FreezeReason)
+	if l > 0 {
+		n += 1 + l + sovModeration(uint64(l))
+	}
+	if m.Nsfw {
+		n += 2
+	}
+	l = len(m.NsfwReason)
+	if l > 0 {
+		n += 1 + l + sovModeration(uint64(l))
+	}
+	if m.Hidden {
+		n += 2
+	}
+	l = len(m.HiddenReason)
+	if l > 0 {
+		n += 1 + l + sovModeration(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.UpdatedAt)
+	n += 1 + l + sovModeration(uint64(l))
+	return n
+}
+
+func sovModeration(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozModeration(x uint64) (n int) {
+	return sovModeration(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ModerationStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowModeration
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ModerationStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ModerationStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModeration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModeration
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModeration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Frozen", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModeration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Frozen = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FreezeReason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModeration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModeration
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModeration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FreezeReason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nsfw", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModeration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Nsfw = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NsfwReason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModeration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModeration
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModeration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NsfwReason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hidden", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModeration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Hidden = bool(v != 0)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HiddenReason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModeration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModeration
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModeration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HiddenReason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModeration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthModeration
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthModeration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.UpdatedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipModeration(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthModeration
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipModeration(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowModeration
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowModeration
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowModeration
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthModeration
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupModeration
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthModeration
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthModeration        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowModeration          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupModeration = fmt.Errorf("proto: unexpected end of group")
+)
@@ -2,11 +2,14 @@ package types
 
 import (
 	"strings"
+	"time"
 	"unicode/utf8"
 
 	errorsmod "cosmossdk.io/errors"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	sdktx "github.com/cosmos/cosmos-sdk/types/tx"
 )
 
 const (
@@ -17,6 +20,70 @@ const (
 	TypeMsgMintONFT     = "mint_onft"
 	TypeMsgTransferONFT = "transfer_onft"
 	TypeMsgBurnONFT     = "burn_onft"
+	TypeMsgPrintEdition = "print_edition"
+
+	TypeMsgCreateClaim = "create_claim"
+	TypeMsgCommitClaim = "commit_claim"
+	TypeMsgClaim       = "claim"
+	TypeMsgRefundClaim = "refund_claim"
+
+	TypeMsgCreateMintAirdrop = "create_mint_airdrop"
+	TypeMsgClaimAirdrop      = "claim_airdrop"
+
+	TypeMsgCreateSwap = "create_swap"
+	TypeMsgAcceptSwap = "accept_swap"
+	TypeMsgCancelSwap = "cancel_swap"
+
+	TypeMsgListONFT    = "list_onft"
+	TypeMsgBuyONFT     = "buy_onft"
+	TypeMsgDelistONFT  = "delist_onft"
+	TypeMsgMakeOffer   = "make_offer"
+	TypeMsgAcceptOffer = "accept_offer"
+	TypeMsgCancelOffer = "cancel_offer"
+
+	TypeMsgCreateAuction = "create_auction"
+	TypeMsgPlaceBid      = "place_bid"
+	TypeMsgCancelAuction = "cancel_auction"
+
+	TypeMsgRequestLoan  = "request_loan"
+	TypeMsgFundLoan     = "fund_loan"
+	TypeMsgRepayLoan    = "repay_loan"
+	TypeMsgClaimDefault = "claim_default"
+	TypeMsgCancelLoan   = "cancel_loan"
+
+	TypeMsgFractionalize = "fractionalize"
+	TypeMsgRedeem        = "redeem"
+	TypeMsgBuyout        = "buyout"
+
+	TypeMsgNestONFT   = "nest_onft"
+	TypeMsgUnnestONFT = "unnest_onft"
+
+	TypeMsgCreateTokenAccount      = "create_token_account"
+	TypeMsgExecuteFromTokenAccount = "execute_from_token_account"
+
+	TypeMsgCreateRecipe  = "create_recipe"
+	TypeMsgExecuteRecipe = "execute_recipe"
+	TypeMsgDeleteRecipe  = "delete_recipe"
+
+	TypeMsgRevealONFT  = "reveal_onft"
+	TypeMsgRevealDenom = "reveal_denom"
+
+	TypeMsgRevokeONFT = "revoke_onft"
+
+	TypeMsgRedeemONFT = "redeem_onft"
+
+	TypeMsgRenewONFT = "renew_onft"
+
+	TypeMsgPauseDenom   = "pause_denom"
+	TypeMsgUnpauseDenom = "unpause_denom"
+
+	TypeMsgClawbackONFT = "clawback_onft"
+
+	TypeMsgFreezeDenom        = "freeze_denom"
+	TypeMsgSetDenomNSFW       = "set_denom_nsfw"
+	TypeMsgSetDenomVisibility = "set_denom_visibility"
+
+	TypeMsgSetFeeExemption = "set_fee_exemption"
 )
 
 var (
@@ -29,6 +96,66 @@ var (
 	_ sdk.Msg = &MsgMintONFT{}
 	_ sdk.Msg = &MsgTransferONFT{}
 	_ sdk.Msg = &MsgBurnONFT{}
+	_ sdk.Msg = &MsgPrintEdition{}
+
+	_ sdk.Msg = &MsgCreateClaim{}
+	_ sdk.Msg = &MsgCommitClaim{}
+	_ sdk.Msg = &MsgClaim{}
+	_ sdk.Msg = &MsgRefundClaim{}
+
+	_ sdk.Msg = &MsgCreateMintAirdrop{}
+	_ sdk.Msg = &MsgClaimAirdrop{}
+
+	_ sdk.Msg = &MsgCreateSwap{}
+	_ sdk.Msg = &MsgAcceptSwap{}
+	_ sdk.Msg = &MsgCancelSwap{}
+
+	_ sdk.Msg = &MsgListONFT{}
+	_ sdk.Msg = &MsgBuyONFT{}
+	_ sdk.Msg = &MsgDelistONFT{}
+	_ sdk.Msg = &MsgMakeOffer{}
+	_ sdk.Msg = &MsgAcceptOffer{}
+	_ sdk.Msg = &MsgCancelOffer{}
+
+	_ sdk.Msg = &MsgCreateAuction{}
+	_ sdk.Msg = &MsgPlaceBid{}
+	_ sdk.Msg = &MsgCancelAuction{}
+
+	_ sdk.Msg = &MsgRequestLoan{}
+	_ sdk.Msg = &MsgFundLoan{}
+	_ sdk.Msg = &MsgRepayLoan{}
+	_ sdk.Msg = &MsgClaimDefault{}
+	_ sdk.Msg = &MsgCancelLoan{}
+
+	_ sdk.Msg = &MsgFractionalize{}
+	_ sdk.Msg = &MsgRedeem{}
+	_ sdk.Msg = &MsgBuyout{}
+
+	_ sdk.Msg = &MsgNestONFT{}
+	_ sdk.Msg = &MsgUnnestONFT{}
+
+	_ sdk.Msg                            = &MsgCreateTokenAccount{}
+	_ sdk.Msg                            = &MsgExecuteFromTokenAccount{}
+	_ codectypes.UnpackInterfacesMessage = MsgExecuteFromTokenAccount{}
+
+	_ sdk.Msg = &MsgCreateRecipe{}
+	_ sdk.Msg = &MsgExecuteRecipe{}
+	_ sdk.Msg = &MsgDeleteRecipe{}
+
+	_ sdk.Msg = &MsgRevealONFT{}
+	_ sdk.Msg = &MsgRevealDenom{}
+
+	_ sdk.Msg = &MsgRevokeONFT{}
+
+	_ sdk.Msg = &MsgRedeemONFT{}
+	_ sdk.Msg = &MsgRenewONFT{}
+	_ sdk.Msg = &MsgPauseDenom{}
+	_ sdk.Msg = &MsgUnpauseDenom{}
+	_ sdk.Msg = &MsgClawbackONFT{}
+	_ sdk.Msg = &MsgFreezeDenom{}
+	_ sdk.Msg = &MsgSetDenomNSFW{}
+	_ sdk.Msg = &MsgSetDenomVisibility{}
+	_ sdk.Msg = &MsgSetFeeExemption{}
 )
 
 func NewMsgCreateDenom(symbol, name, schema, description, previewUri, sender string, fee sdk.Coin) *MsgCreateDenom {
@@ -75,6 +202,26 @@ func (msg MsgCreateDenom) ValidateBasic() error {
 	if err := ValidateCreationFee(msg.CreationFee); err != nil {
 		return err
 	}
+	if len(msg.RoyaltyReceivers) > 0 {
+		if err := ValidateRoyaltyReceivers(msg.RoyaltyReceivers); err != nil {
+			return err
+		}
+	}
+	if len(msg.MetadataRoot) > 0 {
+		if err := ValidateMetadataRoot(msg.MetadataRoot); err != nil {
+			return err
+		}
+	}
+	if len(msg.Redeemer) > 0 {
+		if _, err := sdk.AccAddressFromBech32(msg.Redeemer); err != nil {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid redeemer address (%s)", err)
+		}
+	}
+	if msg.Subscription != nil {
+		if err := msg.Subscription.Validate(); err != nil {
+			return err
+		}
+	}
 
 	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
 		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
@@ -131,6 +278,21 @@ func (msg MsgUpdateDenom) ValidateBasic() error {
 	if err := ValidateURI(msg.PreviewURI); err != nil {
 		return err
 	}
+	if len(msg.RoyaltyReceivers) > 0 {
+		if err := ValidateRoyaltyReceivers(msg.RoyaltyReceivers); err != nil {
+			return err
+		}
+	}
+	if len(msg.Redeemer) > 0 {
+		if _, err := sdk.AccAddressFromBech32(msg.Redeemer); err != nil {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid redeemer address (%s)", err)
+		}
+	}
+	if msg.Subscription != nil {
+		if err := msg.Subscription.Validate(); err != nil {
+			return err
+		}
+	}
 
 	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
 		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
@@ -323,6 +485,1823 @@ func (msg MsgBurnONFT) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{from}
 }
 
+func NewMsgPrintEdition(denomId, masterId, sender, recipient string) *MsgPrintEdition {
+	return &MsgPrintEdition{
+		Id:        GenUniqueID(IDPrefix),
+		DenomId:   denomId,
+		MasterId:  masterId,
+		Sender:    sender,
+		Recipient: recipient,
+	}
+}
+
+func (msg MsgPrintEdition) Route() string { return RouterKey }
+
+func (msg MsgPrintEdition) Type() string { return TypeMsgPrintEdition }
+
+func (msg MsgPrintEdition) ValidateBasic() error {
+	if err := ValidateDenomID(msg.DenomId); err != nil {
+		return err
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address; %s", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Recipient); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid recipient address; %s", err)
+	}
+	if err := ValidateONFTID(msg.MasterId); err != nil {
+		return err
+	}
+	return ValidateONFTID(msg.Id)
+}
+
+func (msg MsgPrintEdition) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg MsgPrintEdition) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+func NewMsgCreateClaim(
+	denomId, onftId string, template *ONFTTemplate, hash string, expiry time.Time, maxClaims uint64, sender string,
+) *MsgCreateClaim {
+	return &MsgCreateClaim{
+		DenomId:   denomId,
+		OnftId:    onftId,
+		Template:  template,
+		Hash:      hash,
+		Expiry:    expiry,
+		MaxClaims: maxClaims,
+		Sender:    sender,
+	}
+}
+
+func (msg MsgCreateClaim) Route() string { return RouterKey }
+
+func (msg MsgCreateClaim) Type() string { return TypeMsgCreateClaim }
+
+func (msg MsgCreateClaim) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address; %s", err)
+	}
+	if err := ValidateDenomID(msg.DenomId); err != nil {
+		return err
+	}
+	if err := ValidateSHA256Hash(msg.Hash); err != nil {
+		return err
+	}
+	if msg.Expiry.IsZero() {
+		return errorsmod.Wrap(ErrInvalidClaim, "expiry is required")
+	}
+	if msg.MaxClaims == 0 {
+		return errorsmod.Wrap(ErrInvalidClaim, "max claims must be positive")
+	}
+	if len(msg.OnftId) > 0 {
+		if msg.Template != nil {
+			return errorsmod.Wrap(ErrInvalidClaim, "template can not be used with an existing onft")
+		}
+		if msg.MaxClaims != 1 {
+			return errorsmod.Wrap(ErrInvalidClaim, "max claims must be 1 for an existing onft")
+		}
+		return ValidateONFTID(msg.OnftId)
+	}
+	if msg.Template == nil {
+		return errorsmod.Wrap(ErrInvalidClaim, "either onft id or template is required")
+	}
+	return ValidateONFTTemplate(*msg.Template)
+}
+
+func (msg MsgCreateClaim) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg MsgCreateClaim) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+func NewMsgCommitClaim(claimId uint64, commitment, claimant string) *MsgCommitClaim {
+	return &MsgCommitClaim{
+		ClaimId:    claimId,
+		Commitment: commitment,
+		Claimant:   claimant,
+	}
+}
+
+func (msg MsgCommitClaim) Route() string { return RouterKey }
+
+func (msg MsgCommitClaim) Type() string { return TypeMsgCommitClaim }
+
+func (msg MsgCommitClaim) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Claimant); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid claimant address; %s", err)
+	}
+	if msg.ClaimId == 0 {
+		return errorsmod.Wrap(ErrUnknownClaim, "claim id must be positive")
+	}
+	return ValidateSHA256Hash(msg.Commitment)
+}
+
+func (msg MsgCommitClaim) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg MsgCommitClaim) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.Claimant)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+func NewMsgClaim(claimId uint64, secret, claimant string) *MsgClaim {
+	return &MsgClaim{
+		ClaimId:  claimId,
+		Secret:   secret,
+		Claimant: claimant,
+	}
+}
+
+func (msg MsgClaim) Route() string { return RouterKey }
+
+func (msg MsgClaim) Type() string { return TypeMsgClaim }
+
+func (msg MsgClaim) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Claimant); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid claimant address; %s", err)
+	}
+	if msg.ClaimId == 0 {
+		return errorsmod.Wrap(ErrUnknownClaim, "claim id must be positive")
+	}
+	if len(msg.Secret) == 0 {
+		return errorsmod.Wrap(ErrInvalidSecret, "secret can not be empty")
+	}
+	return nil
+}
+
+func (msg MsgClaim) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg MsgClaim) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.Claimant)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+func NewMsgRefundClaim(claimId uint64, sender string) *MsgRefundClaim {
+	return &MsgRefundClaim{
+		ClaimId: claimId,
+		Sender:  sender,
+	}
+}
+
+func (msg MsgRefundClaim) Route() string { return RouterKey }
+
+func (msg MsgRefundClaim) Type() string { return TypeMsgRefundClaim }
+
+func (msg MsgRefundClaim) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address; %s", err)
+	}
+	if msg.ClaimId == 0 {
+		return errorsmod.Wrap(ErrUnknownClaim, "claim id must be positive")
+	}
+	return nil
+}
+
+func (msg MsgRefundClaim) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg MsgRefundClaim) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+func NewMsgCreateMintAirdrop(
+	denomId, merkleRoot string, template ONFTTemplate, expiry time.Time, sender string,
+) *MsgCreateMintAirdrop {
+	return &MsgCreateMintAirdrop{
+		DenomId:    denomId,
+		MerkleRoot: merkleRoot,
+		Template:   template,
+		Expiry:     expiry,
+		Sender:     sender,
+	}
+}
+
+func (msg MsgCreateMintAirdrop) Route() string { return RouterKey }
+
+func (msg MsgCreateMintAirdrop) Type() string { return TypeMsgCreateMintAirdrop }
+
+func (msg MsgCreateMintAirdrop) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address; %s", err)
+	}
+	if err := ValidateDenomID(msg.DenomId); err != nil {
+		return err
+	}
+	if err := ValidateMerkleRoot(msg.MerkleRoot); err != nil {
+		return err
+	}
+	if msg.Expiry.IsZero() {
+		return errorsmod.Wrap(ErrInvalidAirdrop, "expiry is required")
+	}
+	return ValidateONFTTemplate(msg.Template)
+}
+
+func (msg MsgCreateMintAirdrop) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg MsgCreateMintAirdrop) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+func NewMsgClaimAirdrop(airdropId uint64, onftId string, metadata *Metadata, proof []string, claimant string) *MsgClaimAirdrop {
+	return &MsgClaimAirdrop{
+		AirdropId: airdropId,
+		OnftId:    onftId,
+		Metadata:  metadata,
+		Proof:     proof,
+		Claimant:  claimant,
+	}
+}
+
+func (msg MsgClaimAirdrop) Route() string { return RouterKey }
+
+func (msg MsgClaimAirdrop) Type() string { return TypeMsgClaimAirdrop }
+
+func (msg MsgClaimAirdrop) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Claimant); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid claimant address; %s", err)
+	}
+	if msg.AirdropId == 0 {
+		return errorsmod.Wrap(ErrUnknownAirdrop, "airdrop id must be positive")
+	}
+	if err := ValidateONFTID(msg.OnftId); err != nil {
+		return err
+	}
+	if msg.Metadata != nil {
+		if err := ValidateMetadata(*msg.Metadata); err != nil {
+			return err
+		}
+	}
+	_, err := DecodeMerkleProof(msg.Proof)
+	return err
+}
+
+func (msg MsgClaimAirdrop) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg MsgClaimAirdrop) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.Claimant)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+func NewMsgCreateSwap(
+	offeredONFTs []ONFTRef, offeredCoins sdk.Coins,
+	requestedONFTs []ONFTRef, requestedCoins sdk.Coins,
+	counterparty string, expiry time.Time, sender string,
+) *MsgCreateSwap {
+	return &MsgCreateSwap{
+		OfferedOnfts:   offeredONFTs,
+		OfferedCoins:   offeredCoins,
+		RequestedOnfts: requestedONFTs,
+		RequestedCoins: requestedCoins,
+		Counterparty:   counterparty,
+		Expiry:         expiry,
+		Sender:         sender,
+	}
+}
+
+func (msg MsgCreateSwap) Route() string { return RouterKey }
+
+func (msg MsgCreateSwap) Type() string { return TypeMsgCreateSwap }
+
+func (msg MsgCreateSwap) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address; %s", err)
+	}
+	if len(msg.Counterparty) > 0 {
+		if _, err := sdk.AccAddressFromBech32(msg.Counterparty); err != nil {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid counterparty address; %s", err)
+		}
+		if msg.Counterparty == msg.Sender {
+			return errorsmod.Wrap(ErrInvalidSwap, "counterparty can not be the sender")
+		}
+	}
+	return ValidateSwapAssets(msg.OfferedOnfts, msg.OfferedCoins, msg.RequestedOnfts, msg.RequestedCoins)
+}
+
+func (msg MsgCreateSwap) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg MsgCreateSwap) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+func NewMsgAcceptSwap(id uint64, sender string) *MsgAcceptSwap {
+	return &MsgAcceptSwap{
+		Id:     id,
+		Sender: sender,
+	}
+}
+
+func (msg MsgAcceptSwap) Route() string { return RouterKey }
+
+func (msg MsgAcceptSwap) Type() string { return TypeMsgAcceptSwap }
+
+func (msg MsgAcceptSwap) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address; %s", err)
+	}
+	if msg.Id == 0 {
+		return errorsmod.Wrap(ErrUnknownSwap, "swap id must be positive")
+	}
+	return nil
+}
+
+func (msg MsgAcceptSwap) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg MsgAcceptSwap) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+func NewMsgCancelSwap(id uint64, sender string) *MsgCancelSwap {
+	return &MsgCancelSwap{
+		Id:     id,
+		Sender: sender,
+	}
+}
+
+func (msg MsgCancelSwap) Route() string { return RouterKey }
+
+func (msg MsgCancelSwap) Type() string { return TypeMsgCancelSwap }
+
+func (msg MsgCancelSwap) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address; %s", err)
+	}
+	if msg.Id == 0 {
+		return errorsmod.Wrap(ErrUnknownSwap, "swap id must be positive")
+	}
+	return nil
+}
+
+func (msg MsgCancelSwap) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg MsgCancelSwap) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+func NewMsgListONFT(denomId, onftId string, price sdk.Coin, expiry time.Time, owner string) *MsgListONFT {
+	return &MsgListONFT{
+		DenomId: denomId,
+		OnftId:  onftId,
+		Price:   price,
+		Expiry:  expiry,
+		Owner:   owner,
+	}
+}
+
+func (msg MsgListONFT) Route() string { return RouterKey }
+
+func (msg MsgListONFT) Type() string { return TypeMsgListONFT }
+
+func (msg MsgListONFT) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Owner); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid owner address; %s", err)
+	}
+	if err := ValidateDenomID(msg.DenomId); err != nil {
+		return err
+	}
+	if err := ValidateONFTID(msg.OnftId); err != nil {
+		return err
+	}
+	return ValidatePrice(msg.Price)
+}
+
+func (msg MsgListONFT) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg MsgListONFT) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+func NewMsgBuyONFT(listingId uint64, buyer string) *MsgBuyONFT {
+	return &MsgBuyONFT{
+		ListingId: listingId,
+		Buyer:     buyer,
+	}
+}
+
+func (msg MsgBuyONFT) Route() string { return RouterKey }
+
+func (msg MsgBuyONFT) Type() string { return TypeMsgBuyONFT }
+
+func (msg MsgBuyONFT) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Buyer); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid buyer address; %s", err)
+	}
+	if msg.ListingId == 0 {
+		return errorsmod.Wrap(ErrUnknownListing, "listing id must be positive")
+	}
+	return nil
+}
+
+func (msg MsgBuyONFT) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg MsgBuyONFT) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.Buyer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+func NewMsgDelistONFT(listingId uint64, sender string) *MsgDelistONFT {
+	return &MsgDelistONFT{
+		ListingId: listingId,
+		Sender:    sender,
+	}
+}
+
+func (msg MsgDelistONFT) Route() string { return RouterKey }
+
+func (msg MsgDelistONFT) Type() string { return TypeMsgDelistONFT }
+
+func (msg MsgDelistONFT) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address; %s", err)
+	}
+	if msg.ListingId == 0 {
+		return errorsmod.Wrap(ErrUnknownListing, "listing id must be positive")
+	}
+	return nil
+}
+
+func (msg MsgDelistONFT) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg MsgDelistONFT) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+func NewMsgMakeOffer(denomId, onftId string, amount sdk.Coin, expiry time.Time, buyer string) *MsgMakeOffer {
+	return &MsgMakeOffer{
+		DenomId: denomId,
+		OnftId:  onftId,
+		Amount:  amount,
+		Expiry:  expiry,
+		Buyer:   buyer,
+	}
+}
+
+func (msg MsgMakeOffer) Route() string { return RouterKey }
+
+func (msg MsgMakeOffer) Type() string { return TypeMsgMakeOffer }
+
+func (msg MsgMakeOffer) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Buyer); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid buyer address; %s", err)
+	}
+	if err := ValidateDenomID(msg.DenomId); err != nil {
+		return err
+	}
+	if len(msg.OnftId) > 0 {
+		if err := ValidateONFTID(msg.OnftId); err != nil {
+			return err
+		}
+	}
+	return ValidatePrice(msg.Amount)
+}
+
+func (msg MsgMakeOffer) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg MsgMakeOffer) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.Buyer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+func NewMsgAcceptOffer(offerId uint64, onftId, seller string) *MsgAcceptOffer {
+	return &MsgAcceptOffer{
+		OfferId: offerId,
+		OnftId:  onftId,
+		Seller:  seller,
+	}
+}
+
+func (msg MsgAcceptOffer) Route() string { return RouterKey }
+
+func (msg MsgAcceptOffer) Type() string { return TypeMsgAcceptOffer }
+
+func (msg MsgAcceptOffer) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Seller); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid seller address; %s", err)
+	}
+	if msg.OfferId == 0 {
+		return errorsmod.Wrap(ErrUnknownOffer, "offer id must be positive")
+	}
+	if len(msg.OnftId) > 0 {
+		return ValidateONFTID(msg.OnftId)
+	}
+	return nil
+}
+
+func (msg MsgAcceptOffer) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg MsgAcceptOffer) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.Seller)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+func NewMsgCancelOffer(offerId uint64, sender string) *MsgCancelOffer {
+	return &MsgCancelOffer{
+		OfferId: offerId,
+		Sender:  sender,
+	}
+}
+
+func (msg MsgCancelOffer) Route() string { return RouterKey }
+
+func (msg MsgCancelOffer) Type() string { return TypeMsgCancelOffer }
+
+func (msg MsgCancelOffer) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address; %s", err)
+	}
+	if msg.OfferId == 0 {
+		return errorsmod.Wrap(ErrUnknownOffer, "offer id must be positive")
+	}
+	return nil
+}
+
+func (msg MsgCancelOffer) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg MsgCancelOffer) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+func NewMsgCreateAuction(
+	auctionType AuctionType,
+	denomId, onftId string,
+	startPrice, floorPrice sdk.Coin,
+	minIncrement, decrement sdk.Int,
+	decrementInterval, extensionWindow time.Duration,
+	startTime, endTime time.Time,
+	seller string,
+) *MsgCreateAuction {
+	return &MsgCreateAuction{
+		AuctionType:       auctionType,
+		DenomId:           denomId,
+		OnftId:            onftId,
+		StartPrice:        startPrice,
+		FloorPrice:        floorPrice,
+		MinIncrement:      minIncrement,
+		Decrement:         decrement,
+		DecrementInterval: decrementInterval,
+		ExtensionWindow:   extensionWindow,
+		StartTime:         startTime,
+		EndTime:           endTime,
+		Seller:            seller,
+	}
+}
+
+func (msg MsgCreateAuction) Route() string { return RouterKey }
+
+func (msg MsgCreateAuction) Type() string { return TypeMsgCreateAuction }
+
+func (msg MsgCreateAuction) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Seller); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid seller address; %s", err)
+	}
+	return msg.ToAuction().ValidateTerms()
+}
+
+// ToAuction returns an auction with the terms of the message
+func (msg MsgCreateAuction) ToAuction() Auction {
+	return Auction{
+		AuctionType:       msg.AuctionType,
+		DenomId:           msg.DenomId,
+		OnftId:            msg.OnftId,
+		Seller:            msg.Seller,
+		StartPrice:        msg.StartPrice,
+		FloorPrice:        msg.FloorPrice,
+		MinIncrement:      msg.MinIncrement,
+		Decrement:         msg.Decrement,
+		DecrementInterval: msg.DecrementInterval,
+		ExtensionWindow:   msg.ExtensionWindow,
+		StartTime:         msg.StartTime,
+		EndTime:           msg.EndTime,
+	}
+}
+
+func (msg MsgCreateAuction) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg MsgCreateAuction) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.Seller)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+func NewMsgPlaceBid(auctionId uint64, amount sdk.Coin, bidder string) *MsgPlaceBid {
+	return &MsgPlaceBid{
+		AuctionId: auctionId,
+		Amount:    amount,
+		Bidder:    bidder,
+	}
+}
+
+func (msg MsgPlaceBid) Route() string { return RouterKey }
+
+func (msg MsgPlaceBid) Type() string { return TypeMsgPlaceBid }
+
+func (msg MsgPlaceBid) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Bidder); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid bidder address; %s", err)
+	}
+	if msg.AuctionId == 0 {
+		return errorsmod.Wrap(ErrUnknownAuction, "auction id must be positive")
+	}
+	if !msg.Amount.IsValid() || !msg.Amount.IsPositive() {
+		return errorsmod.Wrapf(ErrInvalidBid, "invalid bid amount %s, must be positive", msg.Amount)
+	}
+	return nil
+}
+
+func (msg MsgPlaceBid) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg MsgPlaceBid) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.Bidder)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+func NewMsgCancelAuction(auctionId uint64, seller string) *MsgCancelAuction {
+	return &MsgCancelAuction{
+		AuctionId: auctionId,
+		Seller:    seller,
+	}
+}
+
+func (msg MsgCancelAuction) Route() string { return RouterKey }
+
+func (msg MsgCancelAuction) Type() string { return TypeMsgCancelAuction }
+
+func (msg MsgCancelAuction) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Seller); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid seller address; %s", err)
+	}
+	if msg.AuctionId == 0 {
+		return errorsmod.Wrap(ErrUnknownAuction, "auction id must be positive")
+	}
+	return nil
+}
+
+func (msg MsgCancelAuction) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg MsgCancelAuction) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.Seller)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+func NewMsgRequestLoan(denomId, onftId string, principal, interest sdk.Coin, duration time.Duration, borrower string) *MsgRequestLoan {
+	return &MsgRequestLoan{
+		DenomId:   denomId,
+		OnftId:    onftId,
+		Principal: principal,
+		Interest:  interest,
+		Duration:  duration,
+		Borrower:  borrower,
+	}
+}
+
+func (msg MsgRequestLoan) Route() string { return RouterKey }
+
+func (msg MsgRequestLoan) Type() string { return TypeMsgRequestLoan }
+
+func (msg MsgRequestLoan) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Borrower); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid borrower address; %s", err)
+	}
+	if err := ValidateDenomID(msg.DenomId); err != nil {
+		return err
+	}
+	if err := ValidateONFTID(msg.OnftId); err != nil {
+		return err
+	}
+	return ValidateLoanTerms(msg.Principal, msg.Interest, msg.Duration)
+}
+
+func (msg MsgRequestLoan) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg MsgRequestLoan) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.Borrower)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+func NewMsgFundLoan(loanId uint64, lender string) *MsgFundLoan {
+	return &MsgFundLoan{
+		LoanId: loanId,
+		Lender: lender,
+	}
+}
+
+func (msg MsgFundLoan) Route() string { return RouterKey }
+
+func (msg MsgFundLoan) Type() string { return TypeMsgFundLoan }
+
+func (msg MsgFundLoan) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Lender); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid lender address; %s", err)
+	}
+	if msg.LoanId == 0 {
+		return errorsmod.Wrap(ErrUnknownLoan, "loan id must be positive")
+	}
+	return nil
+}
+
+func (msg MsgFundLoan) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg MsgFundLoan) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.Lender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+func NewMsgRepayLoan(loanId uint64, borrower string) *MsgRepayLoan {
+	return &MsgRepayLoan{
+		LoanId:   loanId,
+		Borrower: borrower,
+	}
+}
+
+func (msg MsgRepayLoan) Route() string { return RouterKey }
+
+func (msg MsgRepayLoan) Type() string { return TypeMsgRepayLoan }
+
+func (msg MsgRepayLoan) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Borrower); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid borrower address; %s", err)
+	}
+	if msg.LoanId == 0 {
+		return errorsmod.Wrap(ErrUnknownLoan, "loan id must be positive")
+	}
+	return nil
+}
+
+func (msg MsgRepayLoan) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg MsgRepayLoan) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.Borrower)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+func NewMsgClaimDefault(loanId uint64, lender string) *MsgClaimDefault {
+	return &MsgClaimDefault{
+		LoanId: loanId,
+		Lender: lender,
+	}
+}
+
+func (msg MsgClaimDefault) Route() string { return RouterKey }
+
+func (msg MsgClaimDefault) Type() string { return TypeMsgClaimDefault }
+
+func (msg MsgClaimDefault) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Lender); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid lender address; %s", err)
+	}
+	if msg.LoanId == 0 {
+		return errorsmod.Wrap(ErrUnknownLoan, "loan id must be positive")
+	}
+	return nil
+}
+
+func (msg MsgClaimDefault) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg MsgClaimDefault) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.Lender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+func NewMsgCancelLoan(loanId uint64, borrower string) *MsgCancelLoan {
+	return &MsgCancelLoan{
+		LoanId:   loanId,
+		Borrower: borrower,
+	}
+}
+
+func (msg MsgCancelLoan) Route() string { return RouterKey }
+
+func (msg MsgCancelLoan) Type() string { return TypeMsgCancelLoan }
+
+func (msg MsgCancelLoan) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Borrower); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid borrower address; %s", err)
+	}
+	if msg.LoanId == 0 {
+		return errorsmod.Wrap(ErrUnknownLoan, "loan id must be positive")
+	}
+	return nil
+}
+
+func (msg MsgCancelLoan) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg MsgCancelLoan) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.Borrower)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+func NewMsgFractionalize(
+	denomId, onftId string,
+	shares sdk.Int, buyoutThreshold sdk.Dec, buyoutPrice sdk.Coin,
+	owner string,
+) *MsgFractionalize {
+	return &MsgFractionalize{
+		DenomId:         denomId,
+		OnftId:          onftId,
+		Shares:          shares,
+		BuyoutThreshold: buyoutThreshold,
+		BuyoutPrice:     buyoutPrice,
+		Owner:           owner,
+	}
+}
+
+func (msg MsgFractionalize) Route() string { return RouterKey }
+
+func (msg MsgFractionalize) Type() string { return TypeMsgFractionalize }
+
+func (msg MsgFractionalize) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Owner); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid owner address; %s", err)
+	}
+	if err := ValidateDenomID(msg.DenomId); err != nil {
+		return err
+	}
+	if err := ValidateONFTID(msg.OnftId); err != nil {
+		return err
+	}
+	return ValidateFractionalizeTerms(msg.DenomId, msg.OnftId, msg.Shares, msg.BuyoutThreshold, msg.BuyoutPrice)
+}
+
+func (msg MsgFractionalize) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg MsgFractionalize) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+func NewMsgRedeem(denomId, onftId, sender string) *MsgRedeem {
+	return &MsgRedeem{
+		DenomId: denomId,
+		OnftId:  onftId,
+		Sender:  sender,
+	}
+}
+
+func (msg MsgRedeem) Route() string { return RouterKey }
+
+func (msg MsgRedeem) Type() string { return TypeMsgRedeem }
+
+func (msg MsgRedeem) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address; %s", err)
+	}
+	if err := ValidateDenomID(msg.DenomId); err != nil {
+		return err
+	}
+	return ValidateONFTID(msg.OnftId)
+}
+
+func (msg MsgRedeem) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg MsgRedeem) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+func NewMsgBuyout(denomId, onftId, buyer string) *MsgBuyout {
+	return &MsgBuyout{
+		DenomId: denomId,
+		OnftId:  onftId,
+		Buyer:   buyer,
+	}
+}
+
+func (msg MsgBuyout) Route() string { return RouterKey }
+
+func (msg MsgBuyout) Type() string { return TypeMsgBuyout }
+
+func (msg MsgBuyout) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Buyer); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid buyer address; %s", err)
+	}
+	if err := ValidateDenomID(msg.DenomId); err != nil {
+		return err
+	}
+	return ValidateONFTID(msg.OnftId)
+}
+
+func (msg MsgBuyout) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg MsgBuyout) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.Buyer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+func NewMsgNestONFT(denomId, onftId, parentDenomId, parentOnftId, sender string) *MsgNestONFT {
+	return &MsgNestONFT{
+		DenomId:       denomId,
+		OnftId:        onftId,
+		ParentDenomId: parentDenomId,
+		ParentOnftId:  parentOnftId,
+		Sender:        sender,
+	}
+}
+
+func (msg MsgNestONFT) Route() string { return RouterKey }
+
+func (msg MsgNestONFT) Type() string { return TypeMsgNestONFT }
+
+func (msg MsgNestONFT) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address; %s", err)
+	}
+	return NewNesting(
+		NewONFTRef(msg.DenomId, msg.OnftId),
+		NewONFTRef(msg.ParentDenomId, msg.ParentOnftId),
+	).Validate()
+}
+
+func (msg MsgNestONFT) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg MsgNestONFT) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+func NewMsgUnnestONFT(denomId, onftId, sender string) *MsgUnnestONFT {
+	return &MsgUnnestONFT{
+		DenomId: denomId,
+		OnftId:  onftId,
+		Sender:  sender,
+	}
+}
+
+func (msg MsgUnnestONFT) Route() string { return RouterKey }
+
+func (msg MsgUnnestONFT) Type() string { return TypeMsgUnnestONFT }
+
+func (msg MsgUnnestONFT) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address; %s", err)
+	}
+	if err := ValidateDenomID(msg.DenomId); err != nil {
+		return err
+	}
+	return ValidateONFTID(msg.OnftId)
+}
+
+func (msg MsgUnnestONFT) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg MsgUnnestONFT) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+func NewMsgCreateTokenAccount(denomId, onftId, owner string) *MsgCreateTokenAccount {
+	return &MsgCreateTokenAccount{
+		DenomId: denomId,
+		OnftId:  onftId,
+		Owner:   owner,
+	}
+}
+
+func (msg MsgCreateTokenAccount) Route() string { return RouterKey }
+
+func (msg MsgCreateTokenAccount) Type() string { return TypeMsgCreateTokenAccount }
+
+func (msg MsgCreateTokenAccount) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Owner); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid owner address; %s", err)
+	}
+	if err := ValidateDenomID(msg.DenomId); err != nil {
+		return err
+	}
+	return ValidateONFTID(msg.OnftId)
+}
+
+func (msg MsgCreateTokenAccount) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg MsgCreateTokenAccount) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+func NewMsgExecuteFromTokenAccount(denomId, onftId, sender string, msgs []sdk.Msg) (*MsgExecuteFromTokenAccount, error) {
+	anys, err := sdktx.SetMsgs(msgs)
+	if err != nil {
+		return nil, err
+	}
+	return &MsgExecuteFromTokenAccount{
+		DenomId: denomId,
+		OnftId:  onftId,
+		Sender:  sender,
+		Msgs:    anys,
+	}, nil
+}
+
+func (msg MsgExecuteFromTokenAccount) Route() string { return RouterKey }
+
+func (msg MsgExecuteFromTokenAccount) Type() string { return TypeMsgExecuteFromTokenAccount }
+
+func (msg MsgExecuteFromTokenAccount) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address; %s", err)
+	}
+	if err := ValidateDenomID(msg.DenomId); err != nil {
+		return err
+	}
+	if err := ValidateONFTID(msg.OnftId); err != nil {
+		return err
+	}
+	if len(msg.Msgs) == 0 {
+		return errorsmod.Wrap(ErrInvalidTokenAccount, "msgs must not be empty")
+	}
+	msgs, err := msg.GetMessages()
+	if err != nil {
+		return err
+	}
+	for _, m := range msgs {
+		if err := m.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (msg MsgExecuteFromTokenAccount) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg MsgExecuteFromTokenAccount) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+func NewMsgCreateRecipe(
+	outputDenomId string, outputTemplate ONFTTemplate, inputs []RecipeInput, cost sdk.Coins, creator string,
+) *MsgCreateRecipe {
+	return &MsgCreateRecipe{
+		OutputDenomId:  outputDenomId,
+		OutputTemplate: outputTemplate,
+		Inputs:         inputs,
+		Cost:           cost,
+		Creator:        creator,
+	}
+}
+
+func (msg MsgCreateRecipe) Route() string { return RouterKey }
+
+func (msg MsgCreateRecipe) Type() string { return TypeMsgCreateRecipe }
+
+func (msg MsgCreateRecipe) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address; %s", err)
+	}
+	return ValidateRecipe(msg.OutputDenomId, msg.OutputTemplate, msg.Inputs, msg.Cost)
+}
+
+func (msg MsgCreateRecipe) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg MsgCreateRecipe) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+func NewMsgExecuteRecipe(recipeId uint64, inputONFTs []ONFTRef, sender string) *MsgExecuteRecipe {
+	return &MsgExecuteRecipe{
+		RecipeId:   recipeId,
+		InputOnfts: inputONFTs,
+		Sender:     sender,
+	}
+}
+
+func (msg MsgExecuteRecipe) Route() string { return RouterKey }
+
+func (msg MsgExecuteRecipe) Type() string { return TypeMsgExecuteRecipe }
+
+func (msg MsgExecuteRecipe) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address; %s", err)
+	}
+	if msg.RecipeId == 0 {
+		return errorsmod.Wrap(ErrUnknownRecipe, "recipe id must be positive")
+	}
+	if len(msg.InputOnfts) == 0 || len(msg.InputOnfts) > MaxRecipeInputONFTs {
+		return errorsmod.Wrapf(ErrInvalidRecipe, "between 1 and %d input onfts are required", MaxRecipeInputONFTs)
+	}
+	seen := make(map[ONFTRef]bool)
+	for _, ref := range msg.InputOnfts {
+		if err := ref.Validate(); err != nil {
+			return err
+		}
+		if seen[ref] {
+			return errorsmod.Wrapf(ErrInvalidRecipe, "duplicate input onft %s", ref)
+		}
+		seen[ref] = true
+	}
+	return nil
+}
+
+func (msg MsgExecuteRecipe) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg MsgExecuteRecipe) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+func NewMsgDeleteRecipe(recipeId uint64, creator string) *MsgDeleteRecipe {
+	return &MsgDeleteRecipe{
+		RecipeId: recipeId,
+		Creator:  creator,
+	}
+}
+
+func (msg MsgDeleteRecipe) Route() string { return RouterKey }
+
+func (msg MsgDeleteRecipe) Type() string { return TypeMsgDeleteRecipe }
+
+func (msg MsgDeleteRecipe) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address; %s", err)
+	}
+	if msg.RecipeId == 0 {
+		return errorsmod.Wrap(ErrUnknownRecipe, "recipe id must be positive")
+	}
+	return nil
+}
+
+func (msg MsgDeleteRecipe) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg MsgDeleteRecipe) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+func NewMsgRevealONFT(denomId string, onft RevealedONFT, sender string) *MsgRevealONFT {
+	return &MsgRevealONFT{
+		DenomId: denomId,
+		ONFT:    onft,
+		Sender:  sender,
+	}
+}
+
+func (msg MsgRevealONFT) Route() string { return RouterKey }
+
+func (msg MsgRevealONFT) Type() string { return TypeMsgRevealONFT }
+
+func (msg MsgRevealONFT) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address; %s", err)
+	}
+	if err := ValidateDenomID(msg.DenomId); err != nil {
+		return err
+	}
+	return msg.ONFT.Validate()
+}
+
+func (msg MsgRevealONFT) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg MsgRevealONFT) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+func NewMsgRevealDenom(denomId string, onfts []RevealedONFT, sender string) *MsgRevealDenom {
+	return &MsgRevealDenom{
+		DenomId: denomId,
+		ONFTs:   onfts,
+		Sender:  sender,
+	}
+}
+
+func (msg MsgRevealDenom) Route() string { return RouterKey }
+
+func (msg MsgRevealDenom) Type() string { return TypeMsgRevealDenom }
+
+func (msg MsgRevealDenom) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address; %s", err)
+	}
+	if err := ValidateDenomID(msg.DenomId); err != nil {
+		return err
+	}
+	if len(msg.ONFTs) > MaxRevealONFTs {
+		return errorsmod.Wrapf(ErrInvalidReveal, "at most %d onfts can be revealed at once", MaxRevealONFTs)
+	}
+	seen := make(map[string]bool, len(msg.ONFTs))
+	for _, onft := range msg.ONFTs {
+		if err := onft.Validate(); err != nil {
+			return err
+		}
+		if seen[onft.Id] {
+			return errorsmod.Wrapf(ErrInvalidReveal, "duplicate onft %s", onft.Id)
+		}
+		seen[onft.Id] = true
+	}
+	return nil
+}
+
+func (msg MsgRevealDenom) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg MsgRevealDenom) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+func NewMsgRevokeONFT(denomId, id, reason, sender string) *MsgRevokeONFT {
+	return &MsgRevokeONFT{
+		DenomId: denomId,
+		Id:      id,
+		Reason:  reason,
+		Sender:  sender,
+	}
+}
+
+func (msg MsgRevokeONFT) Route() string { return RouterKey }
+
+func (msg MsgRevokeONFT) Type() string { return TypeMsgRevokeONFT }
+
+func (msg MsgRevokeONFT) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address; %s", err)
+	}
+	if err := ValidateDenomID(msg.DenomId); err != nil {
+		return err
+	}
+	if err := ValidateONFTID(msg.Id); err != nil {
+		return err
+	}
+	return ValidateRevokeReason(msg.Reason)
+}
+
+func (msg MsgRevokeONFT) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg MsgRevokeONFT) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+func NewMsgRedeemONFT(denomId, id, redeemer, owner string) *MsgRedeemONFT {
+	return &MsgRedeemONFT{
+		DenomId:  denomId,
+		Id:       id,
+		Redeemer: redeemer,
+		Owner:    owner,
+	}
+}
+
+func (msg MsgRedeemONFT) Route() string { return RouterKey }
+
+func (msg MsgRedeemONFT) Type() string { return TypeMsgRedeemONFT }
+
+func (msg MsgRedeemONFT) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Redeemer); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid redeemer address; %s", err)
+	}
+	if len(msg.Owner) > 0 {
+		if _, err := sdk.AccAddressFromBech32(msg.Owner); err != nil {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid owner address; %s", err)
+		}
+	}
+	if err := ValidateDenomID(msg.DenomId); err != nil {
+		return err
+	}
+	return ValidateONFTID(msg.Id)
+}
+
+func (msg MsgRedeemONFT) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the redeemer, and the owner when it co-signs
+func (msg MsgRedeemONFT) GetSigners() []sdk.AccAddress {
+	redeemer, err := sdk.AccAddressFromBech32(msg.Redeemer)
+	if err != nil {
+		panic(err)
+	}
+	if len(msg.Owner) == 0 || msg.Owner == msg.Redeemer {
+		return []sdk.AccAddress{redeemer}
+	}
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{owner, redeemer}
+}
+
+func NewMsgRenewONFT(denomId, id string, periods uint64, sender string) *MsgRenewONFT {
+	return &MsgRenewONFT{
+		DenomId: denomId,
+		Id:      id,
+		Periods: periods,
+		Sender:  sender,
+	}
+}
+
+func (msg MsgRenewONFT) Route() string { return RouterKey }
+
+func (msg MsgRenewONFT) Type() string { return TypeMsgRenewONFT }
+
+func (msg MsgRenewONFT) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address; %s", err)
+	}
+	if err := ValidateDenomID(msg.DenomId); err != nil {
+		return err
+	}
+	if err := ValidateONFTID(msg.Id); err != nil {
+		return err
+	}
+	return ValidateRenewalPeriods(msg.Periods)
+}
+
+func (msg MsgRenewONFT) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg MsgRenewONFT) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+func NewMsgPauseDenom(denomId string, pause DenomPause, sender string) *MsgPauseDenom {
+	return &MsgPauseDenom{
+		DenomId: denomId,
+		Pause:   pause,
+		Sender:  sender,
+	}
+}
+
+func (msg MsgPauseDenom) Route() string { return RouterKey }
+
+func (msg MsgPauseDenom) Type() string { return TypeMsgPauseDenom }
+
+func (msg MsgPauseDenom) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address; %s", err)
+	}
+	if msg.Pause.IsEmpty() {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "no action selected to pause")
+	}
+	return ValidateDenomID(msg.DenomId)
+}
+
+func (msg MsgPauseDenom) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg MsgPauseDenom) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+func NewMsgUnpauseDenom(denomId string, unpause DenomPause, sender string) *MsgUnpauseDenom {
+	return &MsgUnpauseDenom{
+		DenomId: denomId,
+		Unpause: unpause,
+		Sender:  sender,
+	}
+}
+
+func (msg MsgUnpauseDenom) Route() string { return RouterKey }
+
+func (msg MsgUnpauseDenom) Type() string { return TypeMsgUnpauseDenom }
+
+func (msg MsgUnpauseDenom) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address; %s", err)
+	}
+	if msg.Unpause.IsEmpty() {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "no action selected to unpause")
+	}
+	return ValidateDenomID(msg.DenomId)
+}
+
+func (msg MsgUnpauseDenom) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg MsgUnpauseDenom) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+func NewMsgClawbackONFT(denomId, id, recipient, sender string) *MsgClawbackONFT {
+	return &MsgClawbackONFT{
+		DenomId:   denomId,
+		Id:        id,
+		Recipient: recipient,
+		Sender:    sender,
+	}
+}
+
+func (msg MsgClawbackONFT) Route() string { return RouterKey }
+
+func (msg MsgClawbackONFT) Type() string { return TypeMsgClawbackONFT }
+
+func (msg MsgClawbackONFT) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address; %s", err)
+	}
+	if len(msg.Recipient) > 0 {
+		if _, err := sdk.AccAddressFromBech32(msg.Recipient); err != nil {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid recipient address; %s", err)
+		}
+	}
+	if err := ValidateDenomID(msg.DenomId); err != nil {
+		return err
+	}
+	return ValidateONFTID(msg.Id)
+}
+
+func (msg MsgClawbackONFT) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg MsgClawbackONFT) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+func NewMsgFreezeDenom(authority, denomId string, frozen bool, reason string) *MsgFreezeDenom {
+	return &MsgFreezeDenom{
+		Authority: authority,
+		DenomId:   denomId,
+		Frozen:    frozen,
+		Reason:    reason,
+	}
+}
+
+func (msg MsgFreezeDenom) Route() string { return RouterKey }
+
+func (msg MsgFreezeDenom) Type() string { return TypeMsgFreezeDenom }
+
+func (msg MsgFreezeDenom) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+	if err := ValidateDenomID(msg.DenomId); err != nil {
+		return err
+	}
+	return ValidateModerationReason(msg.Reason)
+}
+
+func (msg MsgFreezeDenom) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg MsgFreezeDenom) GetSigners() []sdk.AccAddress {
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{authority}
+}
+
+func NewMsgSetDenomNSFW(authority, denomId string, nsfw bool, reason string) *MsgSetDenomNSFW {
+	return &MsgSetDenomNSFW{
+		Authority: authority,
+		DenomId:   denomId,
+		Nsfw:      nsfw,
+		Reason:    reason,
+	}
+}
+
+func (msg MsgSetDenomNSFW) Route() string { return RouterKey }
+
+func (msg MsgSetDenomNSFW) Type() string { return TypeMsgSetDenomNSFW }
+
+func (msg MsgSetDenomNSFW) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+	if err := ValidateDenomID(msg.DenomId); err != nil {
+		return err
+	}
+	return ValidateModerationReason(msg.Reason)
+}
+
+func (msg MsgSetDenomNSFW) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg MsgSetDenomNSFW) GetSigners() []sdk.AccAddress {
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{authority}
+}
+
+func NewMsgSetDenomVisibility(authority, denomId string, hidden bool, reason string) *MsgSetDenomVisibility {
+	return &MsgSetDenomVisibility{
+		Authority: authority,
+		DenomId:   denomId,
+		Hidden:    hidden,
+		Reason:    reason,
+	}
+}
+
+func (msg MsgSetDenomVisibility) Route() string { return RouterKey }
+
+func (msg MsgSetDenomVisibility) Type() string { return TypeMsgSetDenomVisibility }
+
+func (msg MsgSetDenomVisibility) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+	if err := ValidateDenomID(msg.DenomId); err != nil {
+		return err
+	}
+	return ValidateModerationReason(msg.Reason)
+}
+
+func (msg MsgSetDenomVisibility) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg MsgSetDenomVisibility) GetSigners() []sdk.AccAddress {
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{authority}
+}
+
+func NewMsgSetFeeExemption(authority, address string, discount sdk.Dec, expiresAt *time.Time) *MsgSetFeeExemption {
+	return &MsgSetFeeExemption{
+		Authority: authority,
+		Address:   address,
+		Discount:  discount,
+		ExpiresAt: expiresAt,
+	}
+}
+
+func (msg MsgSetFeeExemption) Route() string { return RouterKey }
+
+func (msg MsgSetFeeExemption) Type() string { return TypeMsgSetFeeExemption }
+
+func (msg MsgSetFeeExemption) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Address); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid exempted address; %s", err)
+	}
+	return ValidateFeeDiscount(msg.Discount)
+}
+
+func (msg MsgSetFeeExemption) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg MsgSetFeeExemption) GetSigners() []sdk.AccAddress {
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{authority}
+}
+
 // MsgUpdateParams
 
 // GetSignBytes implements the LegacyMsg interface.
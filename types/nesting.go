@@ -0,0 +1,23 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+func NewNesting(child, parent ONFTRef) Nesting {
+	return Nesting{Child: child, Parent: parent}
+}
+
+// Validate checks that both oNFT references are valid and differ
+func (n Nesting) Validate() error {
+	if err := n.Child.Validate(); err != nil {
+		return err
+	}
+	if err := n.Parent.Validate(); err != nil {
+		return err
+	}
+	if n.Child == n.Parent {
+		return errorsmod.Wrapf(ErrInvalidNesting, "onft %s can not be nested under itself", n.Child)
+	}
+	return nil
+}
@@ -79,6 +79,29 @@ func (onft ONFT) GetRoyaltyShare() sdk.Dec {
 	return onft.RoyaltyShare
 }
 
+// IsMasterEdition returns true if editions can be printed from the onft
+func (onft ONFT) IsMasterEdition() bool {
+	return onft.MaxEditions > 0
+}
+
+// IsEdition returns true if the onft is a printed copy of a master onft
+func (onft ONFT) IsEdition() bool {
+	return len(onft.MasterId) > 0
+}
+
+// IsTicket returns true if the onft can be redeemed
+func (onft ONFT) IsTicket() bool {
+	return onft.MaxUses > 0
+}
+
+// RemainingUses returns the number of redemptions left on a ticket
+func (onft ONFT) RemainingUses() uint64 {
+	if onft.Uses >= onft.MaxUses {
+		return 0
+	}
+	return onft.MaxUses - onft.Uses
+}
+
 // ONFT
 
 type ONFTs []exported.ONFTI
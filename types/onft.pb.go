@@ -8,7 +8,7 @@ import (
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
-	github_com_gogo_protobuf_types "github.com/cosmos/gogoproto/types"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
@@ -106,13 +106,24 @@ func (m *IDCollection) XXX_DiscardUnknown() {
 var xxx_messageInfo_IDCollection proto.InternalMessageInfo
 
 type Denom struct {
-	Id          string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Symbol      string `protobuf:"bytes,2,opt,name=symbol,proto3" json:"symbol,omitempty"`
-	Name        string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	Schema      string `protobuf:"bytes,4,opt,name=schema,proto3" json:"schema,omitempty"`
-	Creator     string `protobuf:"bytes,5,opt,name=creator,proto3" json:"creator,omitempty"`
-	Description string `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`
-	PreviewURI  string `protobuf:"bytes,7,opt,name=preview_uri,json=previewUri,proto3" json:"preview_uri,omitempty" yaml:"preview_uri"`
+	Id               string            `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Symbol           string            `protobuf:"bytes,2,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Name             string            `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Schema           string            `protobuf:"bytes,4,opt,name=schema,proto3" json:"schema,omitempty"`
+	Creator          string            `protobuf:"bytes,5,opt,name=creator,proto3" json:"creator,omitempty"`
+	Description      string            `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`
+	PreviewURI       string            `protobuf:"bytes,7,opt,name=preview_uri,json=previewUri,proto3" json:"preview_uri,omitempty" yaml:"preview_uri"`
+	RoyaltyReceivers []WeightedAddress `protobuf:"bytes,8,rep,name=royalty_receivers,json=royaltyReceivers,proto3" json:"royalty_receivers" yaml:"royalty_receivers"`
+	// credential denoms hold non-transferable oNFTs that the issuer can revoke
+	Credential bool `protobuf:"varint,9,opt,name=credential,proto3" json:"credential,omitempty"`
+	// redeemer redeems tickets of the denom, the creator when empty
+	Redeemer string `protobuf:"bytes,10,opt,name=redeemer,proto3" json:"redeemer,omitempty"`
+	// subscription makes the oNFTs of the denom expire unless renewed
+	Subscription *SubscriptionConfig `protobuf:"bytes,11,opt,name=subscription,proto3" json:"subscription,omitempty"`
+	// paused holds the actions the creator paused on the denom
+	Paused DenomPause `protobuf:"bytes,12,opt,name=paused,proto3" json:"paused"`
+	// clawback_enabled lets the creator move or burn any oNFT of the denom
+	ClawbackEnabled bool `protobuf:"varint,13,opt,name=clawback_enabled,json=clawbackEnabled,proto3" json:"clawback_enabled,omitempty" yaml:"clawback_enabled"`
 }
 
 func (m *Denom) Reset()         { *m = Denom{} }
@@ -148,24 +159,111 @@ func (m *Denom) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Denom proto.InternalMessageInfo
 
+// DenomPause is the set of actions paused on a denom by its creator
+type DenomPause struct {
+	Mint     bool `protobuf:"varint,1,opt,name=mint,proto3" json:"mint,omitempty"`
+	Transfer bool `protobuf:"varint,2,opt,name=transfer,proto3" json:"transfer,omitempty"`
+	Edit     bool `protobuf:"varint,3,opt,name=edit,proto3" json:"edit,omitempty"`
+}
+
+func (m *DenomPause) Reset()         { *m = DenomPause{} }
+func (m *DenomPause) String() string { return proto.CompactTextString(m) }
+func (*DenomPause) ProtoMessage()    {}
+func (*DenomPause) Descriptor() ([]byte, []int) {
+	return fileDescriptor_de1608f52787b22e, []int{3}
+}
+func (m *DenomPause) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DenomPause) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DenomPause.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DenomPause) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DenomPause.Merge(m, src)
+}
+func (m *DenomPause) XXX_Size() int {
+	return m.Size()
+}
+func (m *DenomPause) XXX_DiscardUnknown() {
+	xxx_messageInfo_DenomPause.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DenomPause proto.InternalMessageInfo
+
+// WeightedAddress is an address with its share of a payout
+type WeightedAddress struct {
+	Address string                                 `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Weight  github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=weight,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"weight"`
+}
+
+func (m *WeightedAddress) Reset()         { *m = WeightedAddress{} }
+func (m *WeightedAddress) String() string { return proto.CompactTextString(m) }
+func (*WeightedAddress) ProtoMessage()    {}
+func (*WeightedAddress) Descriptor() ([]byte, []int) {
+	return fileDescriptor_de1608f52787b22e, []int{4}
+}
+func (m *WeightedAddress) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WeightedAddress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WeightedAddress.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WeightedAddress) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WeightedAddress.Merge(m, src)
+}
+func (m *WeightedAddress) XXX_Size() int {
+	return m.Size()
+}
+func (m *WeightedAddress) XXX_DiscardUnknown() {
+	xxx_messageInfo_WeightedAddress.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WeightedAddress proto.InternalMessageInfo
+
 // ASSET or ONFT
 type ONFT struct {
-	Id           string                                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Metadata     Metadata                               `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata"`
-	Data         string                                 `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
-	Owner        string                                 `protobuf:"bytes,4,opt,name=owner,proto3" json:"owner,omitempty"`
-	Transferable bool                                   `protobuf:"varint,5,opt,name=transferable,proto3" json:"transferable,omitempty"`
-	Extensible   bool                                   `protobuf:"varint,6,opt,name=extensible,proto3" json:"extensible,omitempty"`
-	CreatedAt    time.Time                              `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3,stdtime" json:"created_at" yaml:"created_at"`
-	Nsfw         bool                                   `protobuf:"varint,8,opt,name=nsfw,proto3" json:"nsfw,omitempty"`
-	RoyaltyShare github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,9,opt,name=royalty_share,json=royaltyShare,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"royalty_share" yaml:"royalty_share"`
+	Id            string                                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Metadata      Metadata                               `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata"`
+	Data          string                                 `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	Owner         string                                 `protobuf:"bytes,4,opt,name=owner,proto3" json:"owner,omitempty"`
+	Transferable  bool                                   `protobuf:"varint,5,opt,name=transferable,proto3" json:"transferable,omitempty"`
+	Extensible    bool                                   `protobuf:"varint,6,opt,name=extensible,proto3" json:"extensible,omitempty"`
+	CreatedAt     time.Time                              `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3,stdtime" json:"created_at" yaml:"created_at"`
+	Nsfw          bool                                   `protobuf:"varint,8,opt,name=nsfw,proto3" json:"nsfw,omitempty"`
+	RoyaltyShare  github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,9,opt,name=royalty_share,json=royaltyShare,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"royalty_share" yaml:"royalty_share"`
+	MaxEditions   uint64                                 `protobuf:"varint,10,opt,name=max_editions,json=maxEditions,proto3" json:"max_editions,omitempty" yaml:"max_editions"`
+	EditionNumber uint64                                 `protobuf:"varint,11,opt,name=edition_number,json=editionNumber,proto3" json:"edition_number,omitempty" yaml:"edition_number"`
+	MasterId      string                                 `protobuf:"bytes,12,opt,name=master_id,json=masterId,proto3" json:"master_id,omitempty" yaml:"master_id"`
+	// max_uses makes the oNFT a redeemable ticket, uses counts its redemptions
+	MaxUses uint64 `protobuf:"varint,13,opt,name=max_uses,json=maxUses,proto3" json:"max_uses,omitempty" yaml:"max_uses"`
+	Uses    uint64 `protobuf:"varint,14,opt,name=uses,proto3" json:"uses,omitempty"`
+	// expires_at is the end of the paid period of a subscription oNFT
+	ExpiresAt *time.Time `protobuf:"bytes,15,opt,name=expires_at,json=expiresAt,proto3,stdtime" json:"expires_at,omitempty" yaml:"expires_at"`
 }
 
 func (m *ONFT) Reset()         { *m = ONFT{} }
 func (m *ONFT) String() string { return proto.CompactTextString(m) }
 func (*ONFT) ProtoMessage()    {}
 func (*ONFT) Descriptor() ([]byte, []int) {
-	return fileDescriptor_de1608f52787b22e, []int{3}
+	return fileDescriptor_de1608f52787b22e, []int{5}
 }
 func (m *ONFT) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -205,7 +303,7 @@ func (m *Metadata) Reset()         { *m = Metadata{} }
 func (m *Metadata) String() string { return proto.CompactTextString(m) }
 func (*Metadata) ProtoMessage()    {}
 func (*Metadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_de1608f52787b22e, []int{4}
+	return fileDescriptor_de1608f52787b22e, []int{6}
 }
 func (m *Metadata) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -243,7 +341,7 @@ func (m *Owner) Reset()         { *m = Owner{} }
 func (m *Owner) String() string { return proto.CompactTextString(m) }
 func (*Owner) ProtoMessage()    {}
 func (*Owner) Descriptor() ([]byte, []int) {
-	return fileDescriptor_de1608f52787b22e, []int{5}
+	return fileDescriptor_de1608f52787b22e, []int{7}
 }
 func (m *Owner) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -276,6 +374,8 @@ func init() {
 	proto.RegisterType((*Collection)(nil), "OmniFlix.onft.v1beta1.Collection")
 	proto.RegisterType((*IDCollection)(nil), "OmniFlix.onft.v1beta1.IDCollection")
 	proto.RegisterType((*Denom)(nil), "OmniFlix.onft.v1beta1.Denom")
+	proto.RegisterType((*DenomPause)(nil), "OmniFlix.onft.v1beta1.DenomPause")
+	proto.RegisterType((*WeightedAddress)(nil), "OmniFlix.onft.v1beta1.WeightedAddress")
 	proto.RegisterType((*ONFT)(nil), "OmniFlix.onft.v1beta1.ONFT")
 	proto.RegisterType((*Metadata)(nil), "OmniFlix.onft.v1beta1.Metadata")
 	proto.RegisterType((*Owner)(nil), "OmniFlix.onft.v1beta1.Owner")
@@ -284,56 +384,78 @@ func init() {
 func init() { proto.RegisterFile("OmniFlix/onft/v1beta1/onft.proto", fileDescriptor_de1608f52787b22e) }
 
 var fileDescriptor_de1608f52787b22e = []byte{
-	// 771 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x54, 0xcf, 0x8f, 0xdb, 0x44,
-	0x14, 0x8e, 0xb3, 0xf9, 0x39, 0xd9, 0xb4, 0x30, 0x2c, 0x95, 0xb5, 0x80, 0x1d, 0xb9, 0x15, 0xea,
-	0x05, 0x47, 0x0d, 0x97, 0xaa, 0x02, 0x89, 0x9a, 0x65, 0xa5, 0x1c, 0xca, 0x22, 0xd3, 0x4a, 0x88,
-	0x4b, 0x34, 0xf1, 0x4c, 0xb2, 0xa3, 0xda, 0x9e, 0xc8, 0x33, 0xdb, 0x6d, 0xfe, 0x09, 0xd4, 0x2b,
-	0x37, 0x8e, 0xfc, 0x29, 0x7b, 0xdc, 0x23, 0xe2, 0x60, 0x20, 0xcb, 0x81, 0x73, 0x24, 0xee, 0x68,
-	0xde, 0x8c, 0x77, 0x1d, 0x60, 0x4f, 0x3d, 0x79, 0xde, 0xf7, 0xbe, 0x37, 0x33, 0xef, 0x7b, 0x9f,
-	0x07, 0x8d, 0x4e, 0xb2, 0x9c, 0x1f, 0xa7, 0xfc, 0xf5, 0x58, 0xe4, 0x0b, 0x35, 0x7e, 0xf5, 0x68,
-	0xce, 0x14, 0x79, 0x04, 0x41, 0xb8, 0x2a, 0x84, 0x12, 0xf8, 0xfd, 0x8a, 0x11, 0x02, 0x68, 0x19,
-	0x87, 0x07, 0x4b, 0xb1, 0x14, 0xc0, 0x18, 0xeb, 0x95, 0x21, 0x1f, 0xfa, 0x4b, 0x21, 0x96, 0x29,
-	0x1b, 0x43, 0x34, 0x3f, 0x5b, 0x8c, 0x15, 0xcf, 0x98, 0x54, 0x24, 0x5b, 0x19, 0x42, 0xf0, 0x83,
-	0x83, 0xd0, 0x97, 0x22, 0x4d, 0x59, 0xa2, 0xb8, 0xc8, 0xf1, 0x63, 0xd4, 0xa6, 0x2c, 0x17, 0x99,
-	0xeb, 0x8c, 0x9c, 0x87, 0x83, 0xc9, 0x87, 0xe1, 0xff, 0x1e, 0x16, 0x1e, 0x69, 0x4e, 0xd4, 0xba,
-	0x28, 0xfd, 0x46, 0x6c, 0x0a, 0xf0, 0x17, 0xa8, 0xad, 0x29, 0xd2, 0x6d, 0x8e, 0xf6, 0x1e, 0x0e,
-	0x26, 0x1f, 0xdc, 0x52, 0x79, 0xf2, 0xf5, 0xf1, 0xf3, 0x68, 0xa8, 0x0b, 0x37, 0xa5, 0xdf, 0xd6,
-	0x91, 0x8c, 0x4d, 0xe1, 0x93, 0xd6, 0x5f, 0x3f, 0xf9, 0x4e, 0xa0, 0xd0, 0xfe, 0xf4, 0xa8, 0x76,
-	0xa3, 0x10, 0xf5, 0xe0, 0x80, 0x19, 0xa7, 0x70, 0xa9, 0x7e, 0xf4, 0xde, 0xb6, 0xf4, 0xef, 0xae,
-	0x49, 0x96, 0x3e, 0x09, 0xaa, 0x4c, 0x10, 0x77, 0x61, 0x39, 0xa5, 0x9a, 0xaf, 0xb7, 0x9b, 0x71,
-	0x6a, 0xae, 0xb2, 0xc3, 0xaf, 0x32, 0x41, 0xdc, 0xd5, 0xcb, 0x29, 0xad, 0x4e, 0xfd, 0xd3, 0x41,
-	0x6d, 0x68, 0x0a, 0xdf, 0x41, 0xcd, 0xea, 0xa4, 0xb8, 0xc9, 0x29, 0xbe, 0x87, 0x3a, 0x72, 0x9d,
-	0xcd, 0x45, 0xea, 0x36, 0x01, 0xb3, 0x11, 0xc6, 0xa8, 0x95, 0x93, 0x8c, 0xb9, 0x7b, 0x80, 0xc2,
-	0x1a, 0xb8, 0xc9, 0x29, 0xcb, 0x88, 0xdb, 0xb2, 0x5c, 0x88, 0xb0, 0x8b, 0xba, 0x49, 0xc1, 0x88,
-	0x12, 0x85, 0xdb, 0x86, 0x44, 0x15, 0xe2, 0x11, 0x1a, 0x50, 0x26, 0x93, 0x82, 0xaf, 0x74, 0xb3,
-	0x6e, 0x07, 0xb2, 0x75, 0x08, 0x7f, 0x85, 0x06, 0xab, 0x82, 0xbd, 0xe2, 0xec, 0x7c, 0x76, 0x56,
-	0x70, 0xb7, 0x0b, 0x12, 0x3c, 0xd8, 0x94, 0x3e, 0xfa, 0xc6, 0xc0, 0x2f, 0xe2, 0xe9, 0xb6, 0xf4,
-	0xb1, 0x69, 0xb0, 0x46, 0x0d, 0x62, 0x64, 0xa3, 0x17, 0x05, 0xb7, 0x6d, 0xfe, 0xbc, 0x87, 0x5a,
-	0x5a, 0xf3, 0xff, 0x74, 0xf9, 0x14, 0xf5, 0x32, 0xa6, 0x08, 0x25, 0x8a, 0x40, 0x9f, 0x83, 0x89,
-	0x7f, 0xcb, 0x00, 0x9f, 0x59, 0x9a, 0x9d, 0xfe, 0x75, 0x99, 0x16, 0x04, 0xca, 0xad, 0x20, 0x80,
-	0x1d, 0xa0, 0xb6, 0x38, 0xcf, 0x59, 0x61, 0xf5, 0x30, 0x01, 0x0e, 0xd0, 0xbe, 0x2a, 0x48, 0x2e,
-	0x17, 0xac, 0x20, 0xf3, 0x94, 0x81, 0x26, 0xbd, 0x78, 0x07, 0xc3, 0x1e, 0x42, 0xec, 0xb5, 0x62,
-	0xb9, 0xe4, 0x9a, 0xd1, 0x01, 0x46, 0x0d, 0xc1, 0xdf, 0x21, 0x04, 0x1a, 0x32, 0x3a, 0x23, 0x0a,
-	0x54, 0x19, 0x4c, 0x0e, 0x43, 0xe3, 0xf6, 0xb0, 0x72, 0x7b, 0xf8, 0xbc, 0x72, 0x7b, 0xf4, 0x91,
-	0xbe, 0xed, 0xb6, 0xf4, 0xdf, 0x35, 0x3a, 0xdd, 0xd4, 0x06, 0x6f, 0x7e, 0xf3, 0x9d, 0xb8, 0x6f,
-	0x81, 0xa7, 0x0a, 0x06, 0x2b, 0x17, 0xe7, 0x6e, 0x0f, 0xce, 0x84, 0x35, 0x7e, 0x89, 0x86, 0x85,
-	0x58, 0x93, 0x54, 0xad, 0x67, 0xf2, 0x94, 0x14, 0xcc, 0xed, 0xc3, 0x18, 0x8e, 0xf5, 0xa6, 0xbf,
-	0x96, 0xfe, 0xc7, 0x4b, 0xae, 0x4e, 0xcf, 0xe6, 0x61, 0x22, 0xb2, 0x71, 0x22, 0x64, 0x26, 0xa4,
-	0xfd, 0x7c, 0x22, 0xe9, 0xcb, 0xb1, 0x5a, 0xaf, 0x98, 0x0c, 0x8f, 0x58, 0xb2, 0x2d, 0xfd, 0x03,
-	0x73, 0xfc, 0xce, 0x66, 0x41, 0xbc, 0x6f, 0xe3, 0x6f, 0x75, 0x68, 0x47, 0xf5, 0xb7, 0x83, 0x7a,
-	0x95, 0xd6, 0xf8, 0xbe, 0x35, 0x9b, 0xf9, 0x01, 0xee, 0x6e, 0x4b, 0x7f, 0x60, 0x36, 0xd2, 0x68,
-	0x60, 0xdd, 0xf7, 0x78, 0xd7, 0x4b, 0x60, 0xd7, 0xe8, 0xde, 0x8d, 0x37, 0x6a, 0xc9, 0x60, 0xd7,
-	0x63, 0x9f, 0xa3, 0x7e, 0xc6, 0x28, 0x27, 0xe0, 0x30, 0x98, 0x5f, 0x34, 0xda, 0x94, 0x7e, 0xef,
-	0x99, 0x06, 0x8d, 0xbf, 0xde, 0x31, 0x7b, 0x5c, 0xd3, 0x02, 0x3d, 0x79, 0x9d, 0x2d, 0xf8, 0xbf,
-	0x2d, 0xda, 0x7a, 0x2b, 0x8b, 0xfe, 0xe8, 0xa0, 0xf6, 0x09, 0xd8, 0xc4, 0x45, 0x5d, 0x42, 0x69,
-	0xc1, 0xa4, 0xb4, 0x46, 0xad, 0x42, 0xbc, 0x42, 0x77, 0x38, 0x9d, 0x25, 0xd7, 0x8f, 0x44, 0xf5,
-	0xe8, 0xdc, 0xbf, 0xc5, 0xb3, 0xf5, 0x07, 0x25, 0x7a, 0x60, 0x1f, 0x9f, 0x61, 0x1d, 0x95, 0x37,
-	0x92, 0x72, 0x9a, 0xc8, 0x20, 0x1e, 0x72, 0x5a, 0xcb, 0x9a, 0xbb, 0x45, 0x9f, 0x5d, 0xfc, 0xe1,
-	0x35, 0x2e, 0x36, 0x9e, 0x73, 0xb9, 0xf1, 0x9c, 0xdf, 0x37, 0x9e, 0xf3, 0xe6, 0xca, 0x6b, 0x5c,
-	0x5e, 0x79, 0x8d, 0x5f, 0xae, 0xbc, 0xc6, 0xf7, 0x5e, 0xcd, 0x05, 0xbb, 0xaf, 0x38, 0x38, 0x60,
-	0xde, 0x01, 0x5b, 0x7e, 0xfa, 0x4f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x21, 0xf5, 0xc0, 0xa9, 0xe3,
-	0x05, 0x00, 0x00,
+	// 1135 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0x41, 0x6f, 0xdb, 0x36,
+	0x14, 0x8e, 0x53, 0xdb, 0x91, 0x69, 0x3b, 0x69, 0xd9, 0xb4, 0x55, 0xd3, 0xcd, 0xf2, 0xd4, 0xa2,
+	0xc8, 0x0e, 0xb3, 0xd1, 0xec, 0x52, 0x14, 0x1b, 0xd6, 0xa8, 0x4d, 0x80, 0x1c, 0xd2, 0x14, 0x6c,
+	0xb2, 0x0d, 0xbb, 0x18, 0xb4, 0xc4, 0x38, 0x44, 0x2c, 0xc9, 0x10, 0xe9, 0x24, 0xfe, 0x13, 0x43,
+	0xaf, 0xbb, 0xed, 0xe7, 0xe4, 0xd8, 0xe3, 0xb0, 0x83, 0xb6, 0x39, 0x97, 0xdd, 0x06, 0x78, 0xd8,
+	0x7d, 0xe0, 0x23, 0x65, 0xcb, 0x69, 0xbd, 0x61, 0x3b, 0x99, 0xef, 0xbd, 0xef, 0x89, 0xe4, 0x7b,
+	0xdf, 0xc7, 0x67, 0xd4, 0x3c, 0x08, 0x23, 0xbe, 0xdb, 0xe7, 0x17, 0xed, 0x38, 0x3a, 0x96, 0xed,
+	0xb3, 0x27, 0x5d, 0x26, 0xe9, 0x13, 0x30, 0x5a, 0x83, 0x24, 0x96, 0x31, 0xbe, 0x93, 0x21, 0x5a,
+	0xe0, 0x34, 0x88, 0x8d, 0xf5, 0x5e, 0xdc, 0x8b, 0x01, 0xd1, 0x56, 0x2b, 0x0d, 0xde, 0x70, 0x7a,
+	0x71, 0xdc, 0xeb, 0xb3, 0x36, 0x58, 0xdd, 0xe1, 0x71, 0x5b, 0xf2, 0x90, 0x09, 0x49, 0xc3, 0x81,
+	0x01, 0x6c, 0x7e, 0x78, 0x3f, 0x31, 0xec, 0x0a, 0x3f, 0xe1, 0x03, 0xc9, 0xe3, 0x48, 0x23, 0xdd,
+	0xef, 0x0b, 0x08, 0xbd, 0x88, 0xfb, 0x7d, 0xe6, 0x2b, 0x27, 0x7e, 0x8a, 0x4a, 0x01, 0x8b, 0xe2,
+	0xd0, 0x2e, 0x34, 0x0b, 0x9b, 0xd5, 0xad, 0x8f, 0x5a, 0x1f, 0x3c, 0x56, 0xeb, 0xa5, 0xc2, 0x78,
+	0xc5, 0xcb, 0xd4, 0x59, 0x22, 0x3a, 0x01, 0x3f, 0x47, 0x25, 0x05, 0x11, 0xf6, 0x72, 0xf3, 0xc6,
+	0x66, 0x75, 0xeb, 0xc1, 0x82, 0xcc, 0x83, 0x57, 0xbb, 0x87, 0x5e, 0x5d, 0x25, 0x8e, 0x53, 0xa7,
+	0xa4, 0x2c, 0x41, 0x74, 0xe2, 0xb3, 0xe2, 0xef, 0x3f, 0x3a, 0x05, 0x57, 0xa2, 0xda, 0xde, 0xcb,
+	0xdc, 0x89, 0x5a, 0xc8, 0x82, 0x0d, 0x3a, 0x3c, 0x80, 0x43, 0x55, 0xbc, 0xdb, 0x93, 0xd4, 0x59,
+	0x1b, 0xd1, 0xb0, 0xff, 0xcc, 0xcd, 0x22, 0x2e, 0x59, 0x81, 0xe5, 0x5e, 0xa0, 0xf0, 0xea, 0x73,
+	0x1d, 0x1e, 0xe8, 0xa3, 0xcc, 0xe1, 0xb3, 0x88, 0x4b, 0x56, 0xd4, 0x72, 0x2f, 0xc8, 0x76, 0xfd,
+	0xa3, 0x88, 0x4a, 0x70, 0x29, 0xbc, 0x8a, 0x96, 0xb3, 0x9d, 0xc8, 0x32, 0x0f, 0xf0, 0x5d, 0x54,
+	0x16, 0xa3, 0xb0, 0x1b, 0xf7, 0xed, 0x65, 0xf0, 0x19, 0x0b, 0x63, 0x54, 0x8c, 0x68, 0xc8, 0xec,
+	0x1b, 0xe0, 0x85, 0x35, 0x60, 0xfd, 0x13, 0x16, 0x52, 0xbb, 0x68, 0xb0, 0x60, 0x61, 0x1b, 0xad,
+	0xf8, 0x09, 0xa3, 0x32, 0x4e, 0xec, 0x12, 0x04, 0x32, 0x13, 0x37, 0x51, 0x35, 0x60, 0xd3, 0x9e,
+	0xd8, 0x65, 0x88, 0xe6, 0x5d, 0x78, 0x07, 0x55, 0x07, 0x09, 0x3b, 0xe3, 0xec, 0xbc, 0x33, 0x4c,
+	0xb8, 0xbd, 0x02, 0x25, 0x78, 0x34, 0x4e, 0x1d, 0xf4, 0x5a, 0xbb, 0x8f, 0xc8, 0xde, 0x24, 0x75,
+	0xb0, 0xbe, 0x60, 0x0e, 0xea, 0x12, 0x64, 0xac, 0xa3, 0x84, 0xe3, 0x21, 0xba, 0x95, 0xc4, 0x23,
+	0xda, 0x97, 0xa3, 0x4e, 0xc2, 0x7c, 0xc6, 0xcf, 0x58, 0x22, 0x6c, 0x0b, 0x5a, 0xf5, 0x78, 0x41,
+	0xab, 0xbe, 0x61, 0xbc, 0x77, 0x22, 0x59, 0xb0, 0x1d, 0x04, 0x09, 0x13, 0xc2, 0x6b, 0xaa, 0xae,
+	0x4d, 0x52, 0xc7, 0xd6, 0x5b, 0xbd, 0xf7, 0x39, 0x97, 0xdc, 0x34, 0x3e, 0x92, 0xb9, 0x70, 0x03,
+	0x21, 0x3f, 0x61, 0x01, 0x8b, 0x24, 0xa7, 0x7d, 0xbb, 0xd2, 0x2c, 0x6c, 0x5a, 0x24, 0xe7, 0xc1,
+	0x1b, 0xc8, 0x52, 0x06, 0x0b, 0x59, 0x62, 0x23, 0xb8, 0xfc, 0xd4, 0xc6, 0xfb, 0xa8, 0x96, 0x27,
+	0xac, 0x5d, 0x05, 0x4a, 0x7e, 0xba, 0xe0, 0xb4, 0x6f, 0x72, 0xd0, 0x17, 0x71, 0x74, 0xcc, 0x7b,
+	0x64, 0x2e, 0x1d, 0x7f, 0x85, 0xca, 0x03, 0x3a, 0x14, 0x2c, 0xb0, 0x6b, 0xf0, 0xa1, 0x4f, 0xfe,
+	0x89, 0xdb, 0xaf, 0x15, 0xd2, 0x10, 0xdc, 0xa4, 0xe1, 0x5d, 0x74, 0xd3, 0xef, 0xd3, 0xf3, 0x2e,
+	0xf5, 0x4f, 0x3b, 0x2c, 0xa2, 0xdd, 0x3e, 0x0b, 0xec, 0xba, 0xba, 0x91, 0xf7, 0x60, 0x92, 0x3a,
+	0xf7, 0x74, 0x55, 0xae, 0x23, 0x5c, 0xb2, 0x96, 0xb9, 0x76, 0xb4, 0xc7, 0x30, 0xee, 0x6b, 0x84,
+	0x66, 0x3b, 0x29, 0x36, 0x85, 0x3c, 0x92, 0xc0, 0x3b, 0x8b, 0xc0, 0x5a, 0xd5, 0x46, 0x26, 0x34,
+	0x12, 0xc7, 0x2c, 0x01, 0xee, 0x59, 0x64, 0x6a, 0x2b, 0x3c, 0x0b, 0xb8, 0x04, 0xf6, 0x59, 0x04,
+	0xd6, 0xe6, 0xbb, 0x23, 0xb4, 0x76, 0xad, 0x71, 0x8a, 0x7e, 0x54, 0x2f, 0x0d, 0xaf, 0x33, 0x13,
+	0xef, 0xa2, 0xf2, 0x39, 0x80, 0x35, 0xb9, 0xbd, 0x96, 0xba, 0xf0, 0xcf, 0xa9, 0xf3, 0xb8, 0xc7,
+	0xe5, 0xc9, 0xb0, 0xdb, 0xf2, 0xe3, 0xb0, 0xed, 0xc7, 0x22, 0x8c, 0x85, 0xf9, 0xf9, 0x4c, 0x04,
+	0xa7, 0x6d, 0x39, 0x1a, 0x30, 0xd1, 0x7a, 0xc9, 0x7c, 0x62, 0xb2, 0xcd, 0xd6, 0x7f, 0x96, 0x50,
+	0x51, 0x29, 0xfa, 0x3d, 0x0d, 0x6d, 0x23, 0x2b, 0x64, 0x92, 0x06, 0x54, 0x52, 0xd8, 0xa8, 0xba,
+	0xe5, 0x2c, 0x28, 0xfe, 0xbe, 0x81, 0x99, 0xd2, 0x4f, 0xd3, 0xd4, 0x85, 0x21, 0xdd, 0xc8, 0x0d,
+	0x7c, 0xeb, 0xa8, 0x14, 0x9f, 0x47, 0x2c, 0x31, 0x6a, 0xd3, 0x06, 0x76, 0x51, 0x2d, 0x2b, 0x93,
+	0xaa, 0x37, 0x28, 0xce, 0x22, 0x73, 0x3e, 0x45, 0x4b, 0x76, 0x21, 0x59, 0x24, 0xb8, 0x42, 0x94,
+	0x35, 0x2d, 0x67, 0x1e, 0xfc, 0x2d, 0xd0, 0x96, 0x4a, 0x16, 0x74, 0xa8, 0x04, 0xcd, 0x55, 0xb7,
+	0x36, 0x5a, 0xfa, 0xd5, 0x6d, 0x65, 0xaf, 0x6e, 0xeb, 0x30, 0x7b, 0x75, 0xbd, 0x8f, 0x8d, 0x34,
+	0x6e, 0x19, 0x12, 0x4c, 0x73, 0xdd, 0xb7, 0xbf, 0x38, 0x05, 0x52, 0x31, 0x8e, 0x6d, 0x09, 0xcf,
+	0x86, 0x38, 0x3e, 0xb7, 0x2d, 0xdd, 0x38, 0xb5, 0xc6, 0xa7, 0xa8, 0x9e, 0x89, 0x49, 0x9c, 0xd0,
+	0x84, 0x81, 0x4e, 0x2a, 0xde, 0xee, 0x7f, 0x6b, 0xc6, 0x24, 0x75, 0xd6, 0xe7, 0x95, 0x09, 0x1f,
+	0x73, 0x49, 0xcd, 0xd8, 0x6f, 0x94, 0x89, 0x9f, 0xa1, 0x5a, 0x48, 0x2f, 0x3a, 0x8a, 0x31, 0x3c,
+	0x8e, 0x04, 0xa8, 0xae, 0xe8, 0xdd, 0x9b, 0xa4, 0xce, 0x6d, 0x9d, 0x9d, 0x8f, 0xba, 0xa4, 0x1a,
+	0xd2, 0x8b, 0x1d, 0x63, 0xe1, 0xe7, 0x68, 0xd5, 0x44, 0x3a, 0xd1, 0x30, 0xec, 0xb2, 0x04, 0x34,
+	0x59, 0xf4, 0xee, 0x4f, 0x52, 0xe7, 0x8e, 0xce, 0x9e, 0x8f, 0xbb, 0xa4, 0x6e, 0x1c, 0xaf, 0xc0,
+	0xc6, 0x4f, 0x50, 0x25, 0xa4, 0x42, 0xb2, 0xa4, 0xc3, 0xb5, 0x0e, 0x2b, 0xde, 0xfa, 0x24, 0x75,
+	0x6e, 0x66, 0x5b, 0x9b, 0x90, 0x4b, 0x2c, 0xbd, 0xd6, 0x0f, 0xba, 0x3a, 0xd2, 0x50, 0x30, 0x01,
+	0x72, 0x2b, 0xe6, 0x1f, 0xf4, 0x2c, 0xe2, 0x92, 0x95, 0x90, 0x5e, 0x1c, 0x09, 0x26, 0x54, 0x85,
+	0x01, 0xbb, 0xaa, 0xb0, 0x04, 0xd6, 0xf8, 0x50, 0xf5, 0x7b, 0xc0, 0x13, 0x26, 0x54, 0x3f, 0xd7,
+	0xfe, 0xb5, 0x9f, 0xf7, 0x67, 0xbd, 0x9c, 0xe5, 0x99, 0x5e, 0x1a, 0xc7, 0x76, 0xc6, 0xfa, 0xbf,
+	0x0a, 0xc8, 0xca, 0x68, 0x8b, 0x1f, 0x9a, 0xa9, 0xa0, 0x27, 0xd5, 0xda, 0x24, 0x75, 0xaa, 0xfa,
+	0x33, 0xca, 0xeb, 0x9a, 0x31, 0xf1, 0x74, 0xfe, 0xd1, 0xd7, 0xd2, 0xbb, 0x3b, 0x7b, 0xc4, 0x73,
+	0x41, 0x77, 0x7e, 0x18, 0x7c, 0x89, 0x2a, 0x21, 0x0b, 0x38, 0x85, 0x51, 0x00, 0x52, 0xf0, 0x9a,
+	0xe3, 0xd4, 0xb1, 0xf6, 0x95, 0x53, 0x0f, 0x82, 0xac, 0x94, 0x19, 0x4c, 0x95, 0x12, 0xa2, 0x09,
+	0xbf, 0x3e, 0x4b, 0x8a, 0xff, 0x6f, 0x96, 0x98, 0x7b, 0xff, 0x50, 0x40, 0xa5, 0x03, 0x50, 0xdc,
+	0xe2, 0xf7, 0x65, 0x80, 0x56, 0x79, 0xd0, 0xf1, 0xa7, 0xd3, 0x3c, 0xfb, 0x77, 0xf0, 0x70, 0x81,
+	0xfc, 0xf3, 0x93, 0xdf, 0x7b, 0x64, 0xfe, 0x25, 0xd4, 0xf3, 0x5e, 0x31, 0x2b, 0x29, 0x0f, 0x7c,
+	0xe1, 0x92, 0x3a, 0x0f, 0x72, 0x51, 0x7d, 0x36, 0xef, 0x8b, 0xcb, 0xdf, 0x1a, 0x4b, 0x97, 0xe3,
+	0x46, 0xe1, 0xdd, 0xb8, 0x51, 0xf8, 0x75, 0xdc, 0x28, 0xbc, 0xbd, 0x6a, 0x2c, 0xbd, 0xbb, 0x6a,
+	0x2c, 0xfd, 0x74, 0xd5, 0x58, 0xfa, 0xae, 0x91, 0x13, 0xd4, 0xfc, 0x1f, 0x25, 0x10, 0x53, 0xb7,
+	0x0c, 0x8c, 0xf8, 0xfc, 0xef, 0x01, 0x00, 0x37, 0xf8, 0x35, 0xeb, 0xb6, 0x09, 0x00, 0x00,
 }
 
 func (this *Collection) Equal(that interface{}) bool {
@@ -440,6 +562,86 @@ func (this *Denom) Equal(that interface{}) bool {
 	if this.PreviewURI != that1.PreviewURI {
 		return false
 	}
+	if len(this.RoyaltyReceivers) != len(that1.RoyaltyReceivers) {
+		return false
+	}
+	for i := range this.RoyaltyReceivers {
+		if !this.RoyaltyReceivers[i].Equal(&that1.RoyaltyReceivers[i]) {
+			return false
+		}
+	}
+	if this.Credential != that1.Credential {
+		return false
+	}
+	if this.Redeemer != that1.Redeemer {
+		return false
+	}
+	if !this.Subscription.Equal(that1.Subscription) {
+		return false
+	}
+	if !this.Paused.Equal(&that1.Paused) {
+		return false
+	}
+	if this.ClawbackEnabled != that1.ClawbackEnabled {
+		return false
+	}
+	return true
+}
+func (this *DenomPause) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DenomPause)
+	if !ok {
+		that2, ok := that.(DenomPause)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Mint != that1.Mint {
+		return false
+	}
+	if this.Transfer != that1.Transfer {
+		return false
+	}
+	if this.Edit != that1.Edit {
+		return false
+	}
+	return true
+}
+func (this *WeightedAddress) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*WeightedAddress)
+	if !ok {
+		that2, ok := that.(WeightedAddress)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Address != that1.Address {
+		return false
+	}
+	if !this.Weight.Equal(that1.Weight) {
+		return false
+	}
 	return true
 }
 func (this *ONFT) Equal(that interface{}) bool {
@@ -488,6 +690,28 @@ func (this *ONFT) Equal(that interface{}) bool {
 	if !this.RoyaltyShare.Equal(that1.RoyaltyShare) {
 		return false
 	}
+	if this.MaxEditions != that1.MaxEditions {
+		return false
+	}
+	if this.EditionNumber != that1.EditionNumber {
+		return false
+	}
+	if this.MasterId != that1.MasterId {
+		return false
+	}
+	if this.MaxUses != that1.MaxUses {
+		return false
+	}
+	if this.Uses != that1.Uses {
+		return false
+	}
+	if that1.ExpiresAt == nil {
+		if this.ExpiresAt != nil {
+			return false
+		}
+	} else if !this.ExpiresAt.Equal(*that1.ExpiresAt) {
+		return false
+	}
 	return true
 }
 func (this *Metadata) Equal(that interface{}) bool {
@@ -661,6 +885,69 @@ func (m *Denom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ClawbackEnabled {
+		i--
+		if m.ClawbackEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x68
+	}
+	{
+		size, err := m.Paused.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintOnft(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
+	if m.Subscription != nil {
+		{
+			size, err := m.Subscription.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintOnft(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.Redeemer) > 0 {
+		i -= len(m.Redeemer)
+		copy(dAtA[i:], m.Redeemer)
+		i = encodeVarintOnft(dAtA, i, uint64(len(m.Redeemer)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.Credential {
+		i--
+		if m.Credential {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.RoyaltyReceivers) > 0 {
+		for iNdEx := len(m.RoyaltyReceivers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RoyaltyReceivers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOnft(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
 	if len(m.PreviewURI) > 0 {
 		i -= len(m.PreviewURI)
 		copy(dAtA[i:], m.PreviewURI)
@@ -713,6 +1000,99 @@ func (m *Denom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *DenomPause) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DenomPause) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomPause) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Edit {
+		i--
+		if m.Edit {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Transfer {
+		i--
+		if m.Transfer {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Mint {
+		i--
+		if m.Mint {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WeightedAddress) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WeightedAddress) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WeightedAddress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Weight.Size()
+		i -= size
+		if _, err := m.Weight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOnft(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintOnft(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ONFT) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -733,6 +1113,43 @@ func (m *ONFT) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ExpiresAt != nil {
+		n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.ExpiresAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExpiresAt):])
+		if err4 != nil {
+			return 0, err4
+		}
+		i -= n4
+		i = encodeVarintOnft(dAtA, i, uint64(n4))
+		i--
+		dAtA[i] = 0x7a
+	}
+	if m.Uses != 0 {
+		i = encodeVarintOnft(dAtA, i, uint64(m.Uses))
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.MaxUses != 0 {
+		i = encodeVarintOnft(dAtA, i, uint64(m.MaxUses))
+		i--
+		dAtA[i] = 0x68
+	}
+	if len(m.MasterId) > 0 {
+		i -= len(m.MasterId)
+		copy(dAtA[i:], m.MasterId)
+		i = encodeVarintOnft(dAtA, i, uint64(len(m.MasterId)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.EditionNumber != 0 {
+		i = encodeVarintOnft(dAtA, i, uint64(m.EditionNumber))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.MaxEditions != 0 {
+		i = encodeVarintOnft(dAtA, i, uint64(m.MaxEditions))
+		i--
+		dAtA[i] = 0x50
+	}
 	{
 		size := m.RoyaltyShare.Size()
 		i -= size
@@ -753,12 +1170,12 @@ func (m *ONFT) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x40
 	}
-	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CreatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CreatedAt):])
-	if err2 != nil {
-		return 0, err2
+	n5, err5 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CreatedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CreatedAt):])
+	if err5 != nil {
+		return 0, err5
 	}
-	i -= n2
-	i = encodeVarintOnft(dAtA, i, uint64(n2))
+	i -= n5
+	i = encodeVarintOnft(dAtA, i, uint64(n5))
 	i--
 	dAtA[i] = 0x3a
 	if m.Extensible {
@@ -991,10 +1408,65 @@ func (m *Denom) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovOnft(uint64(l))
 	}
-	return n
-}
-
-func (m *ONFT) Size() (n int) {
+	if len(m.RoyaltyReceivers) > 0 {
+		for _, e := range m.RoyaltyReceivers {
+			l = e.Size()
+			n += 1 + l + sovOnft(uint64(l))
+		}
+	}
+	if m.Credential {
+		n += 2
+	}
+	l = len(m.Redeemer)
+	if l > 0 {
+		n += 1 + l + sovOnft(uint64(l))
+	}
+	if m.Subscription != nil {
+		l = m.Subscription.Size()
+		n += 1 + l + sovOnft(uint64(l))
+	}
+	l = m.Paused.Size()
+	n += 1 + l + sovOnft(uint64(l))
+	if m.ClawbackEnabled {
+		n += 2
+	}
+	return n
+}
+
+func (m *DenomPause) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Mint {
+		n += 2
+	}
+	if m.Transfer {
+		n += 2
+	}
+	if m.Edit {
+		n += 2
+	}
+	return n
+}
+
+func (m *WeightedAddress) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovOnft(uint64(l))
+	}
+	l = m.Weight.Size()
+	n += 1 + l + sovOnft(uint64(l))
+	return n
+}
+
+func (m *ONFT) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -1020,13 +1492,33 @@ func (m *ONFT) Size() (n int) {
 	if m.Extensible {
 		n += 2
 	}
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.CreatedAt)
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CreatedAt)
 	n += 1 + l + sovOnft(uint64(l))
 	if m.Nsfw {
 		n += 2
 	}
 	l = m.RoyaltyShare.Size()
 	n += 1 + l + sovOnft(uint64(l))
+	if m.MaxEditions != 0 {
+		n += 1 + sovOnft(uint64(m.MaxEditions))
+	}
+	if m.EditionNumber != 0 {
+		n += 1 + sovOnft(uint64(m.EditionNumber))
+	}
+	l = len(m.MasterId)
+	if l > 0 {
+		n += 1 + l + sovOnft(uint64(l))
+	}
+	if m.MaxUses != 0 {
+		n += 1 + sovOnft(uint64(m.MaxUses))
+	}
+	if m.Uses != 0 {
+		n += 1 + sovOnft(uint64(m.Uses))
+	}
+	if m.ExpiresAt != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExpiresAt)
+		n += 1 + l + sovOnft(uint64(l))
+	}
 	return n
 }
 
@@ -1564,59 +2056,63 @@ func (m *Denom) Unmarshal(dAtA []byte) error {
 			}
 			m.PreviewURI = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipOnft(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RoyaltyReceivers", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOnft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthOnft
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOnft
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ONFT) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowOnft
+			m.RoyaltyReceivers = append(m.RoyaltyReceivers, WeightedAddress{})
+			if err := m.RoyaltyReceivers[len(m.RoyaltyReceivers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Credential", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOnft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ONFT: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ONFT: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Credential = bool(v != 0)
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Redeemer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1644,11 +2140,11 @@ func (m *ONFT) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.Redeemer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Subscription", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1675,15 +2171,18 @@ func (m *ONFT) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Subscription == nil {
+				m.Subscription = &SubscriptionConfig{}
+			}
+			if err := m.Subscription.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOnft
@@ -1693,29 +2192,30 @@ func (m *ONFT) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthOnft
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthOnft
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Data = string(dAtA[iNdEx:postIndex])
+			if err := m.Paused.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClawbackEnabled", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOnft
@@ -1725,27 +2225,65 @@ func (m *ONFT) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthOnft
+			m.ClawbackEnabled = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOnft(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthOnft
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DenomPause) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOnft
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DenomPause: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DenomPause: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Transferable", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Mint", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -1762,10 +2300,10 @@ func (m *ONFT) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.Transferable = bool(v != 0)
-		case 6:
+			m.Mint = bool(v != 0)
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Extensible", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Transfer", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -1782,12 +2320,12 @@ func (m *ONFT) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.Extensible = bool(v != 0)
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			m.Transfer = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Edit", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOnft
@@ -1797,33 +2335,388 @@ func (m *ONFT) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthOnft
+			m.Edit = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOnft(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthOnft
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.CreatedAt, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nsfw", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowOnft
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WeightedAddress) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOnft
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WeightedAddress: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WeightedAddress: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOnft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOnft
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOnft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOnft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOnft
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOnft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Weight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOnft(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOnft
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ONFT) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOnft
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ONFT: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ONFT: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOnft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOnft
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOnft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOnft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOnft
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOnft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOnft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOnft
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOnft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOnft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOnft
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOnft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Transferable", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOnft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Transferable = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Extensible", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOnft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Extensible = bool(v != 0)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOnft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOnft
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOnft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.CreatedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nsfw", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOnft
 				}
 				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
@@ -1870,6 +2763,150 @@ func (m *ONFT) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxEditions", wireType)
+			}
+			m.MaxEditions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOnft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxEditions |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EditionNumber", wireType)
+			}
+			m.EditionNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOnft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EditionNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MasterId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOnft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOnft
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOnft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MasterId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxUses", wireType)
+			}
+			m.MaxUses = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOnft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxUses |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Uses", wireType)
+			}
+			m.Uses = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOnft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Uses |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOnft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOnft
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOnft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExpiresAt == nil {
+				m.ExpiresAt = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.ExpiresAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOnft(dAtA[iNdEx:])
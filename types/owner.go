@@ -2,8 +2,11 @@ package types
 
 import (
 	"bytes"
+	"strings"
 
+	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 // NewIDCollection creates a new IDCollection instance
@@ -85,3 +88,28 @@ func (owners Owners) String() string {
 	}
 	return buf.String()
 }
+
+// ParseBoolFilter parses an optional true or false filter
+func ParseBoolFilter(name string) (BoolFilter, error) {
+	switch strings.ToLower(strings.TrimSpace(name)) {
+	case "":
+		return BoolFilterUnspecified, nil
+	case "true":
+		return BoolFilterTrue, nil
+	case "false":
+		return BoolFilterFalse, nil
+	}
+	return BoolFilterUnspecified, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid filter %s, expected true or false", name)
+}
+
+// Matches returns whether value passes the filter.
+func (f BoolFilter) Matches(value bool) bool {
+	switch f {
+	case BoolFilterTrue:
+		return value
+	case BoolFilterFalse:
+		return !value
+	default:
+		return true
+	}
+}
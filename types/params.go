@@ -10,29 +10,183 @@ import (
 // DefaultDenomCreationFee Default period for closing bids for an auction
 var DefaultDenomCreationFee = sdk.NewInt64Coin("uflix", 100_000_000) // 100FLIX
 
-func NewONFTParams(denomCreationFee sdk.Coin) Params {
+// DefaultFeeDistribution sends all fees to the community pool
+var DefaultFeeDistribution = NewFeeDistribution(sdk.OneDec(), sdk.ZeroDec(), sdk.ZeroDec(), "")
+
+const (
+	// DefaultMaxNestingDepth is the default number of levels oNFTs can be nested
+	DefaultMaxNestingDepth uint32 = 5
+	// MaxNestingDepthLimit bounds the max nesting depth governance can set
+	MaxNestingDepthLimit uint32 = 32
+)
+
+func NewONFTParams(
+	denomCreationFees sdk.Coins,
+	platformFeePercentage sdk.Dec,
+	platformFeeReceiver string,
+	maxNestingDepth uint32,
+	mintFee sdk.Coin,
+	transferFee sdk.Coin,
+	feeDistribution FeeDistribution,
+) Params {
 	return Params{
-		DenomCreationFee: denomCreationFee,
+		// the replaced single fee stays zero so params marshal consistently
+		DenomCreationFee:      sdk.Coin{Amount: sdk.ZeroInt()}, //nolint:staticcheck
+		DenomCreationFees:     denomCreationFees,
+		PlatformFeePercentage: platformFeePercentage,
+		PlatformFeeReceiver:   platformFeeReceiver,
+		MaxNestingDepth:       maxNestingDepth,
+		MintFee:               mintFee,
+		TransferFee:           transferFee,
+		FeeDistribution:       feeDistribution,
 	}
 }
 
 // DefaultParams returns default onft parameters
 func DefaultParams() Params {
 	return NewONFTParams(
-		DefaultDenomCreationFee,
+		sdk.NewCoins(DefaultDenomCreationFee),
+		sdk.ZeroDec(),
+		"",
+		DefaultMaxNestingDepth,
+		sdk.NewInt64Coin(DefaultDenomCreationFee.Denom, 0),
+		sdk.NewInt64Coin(DefaultDenomCreationFee.Denom, 0),
+		DefaultFeeDistribution,
 	)
 }
 
 // ValidateBasic performs basic validation on onft parameters.
 func (p Params) ValidateBasic() error {
-	if err := validateDenomCreationFee(p.DenomCreationFee); err != nil {
+	if err := validateDenomCreationFees(p.GetDenomCreationFees()); err != nil {
+		return err
+	}
+	if err := validatePlatformFee(p.GetPlatformFeePercentage(), p.PlatformFeeReceiver); err != nil {
+		return err
+	}
+	if p.MaxNestingDepth > MaxNestingDepthLimit {
+		return errorsmod.Wrapf(ErrInvalidNesting, "max nesting depth %d must not exceed %d", p.MaxNestingDepth, MaxNestingDepthLimit)
+	}
+	if err := validateOptionalFee(p.GetMintFee(), "mint"); err != nil {
+		return err
+	}
+	if err := validateOptionalFee(p.GetTransferFee(), "transfer"); err != nil {
 		return err
 	}
+	return p.GetFeeDistribution().Validate()
+}
+
+// GetDenomCreationFees returns the accepted denom creation fees, params stored
+// before the fee list was introduced accept their single creation fee.
+func (p Params) GetDenomCreationFees() sdk.Coins {
+	fee := p.DenomCreationFee //nolint:staticcheck // read until the v3 migration
+	if len(p.DenomCreationFees) == 0 && !fee.Amount.IsNil() && fee.IsPositive() {
+		return sdk.NewCoins(fee)
+	}
+	return p.DenomCreationFees
+}
+
+// GetMintFee returns the mint fee, params stored before the mint fee was
+// introduced have no fee.
+func (p Params) GetMintFee() sdk.Coin {
+	return optionalFee(p.MintFee, DefaultDenomCreationFee.Denom)
+}
+
+// GetTransferFee returns the transfer fee, params stored before the transfer
+// fee was introduced have no fee.
+func (p Params) GetTransferFee() sdk.Coin {
+	return optionalFee(p.TransferFee, DefaultDenomCreationFee.Denom)
+}
+
+// GetFeeDistribution returns the fee distribution, params stored before the
+// distribution was introduced send all fees to the community pool.
+func (p Params) GetFeeDistribution() FeeDistribution {
+	d := p.FeeDistribution
+	if d.CommunityPool.IsNil() && d.Burn.IsNil() && d.FeeCollector.IsNil() {
+		return DefaultFeeDistribution
+	}
+	return d
+}
+
+func optionalFee(fee sdk.Coin, denom string) sdk.Coin {
+	if fee.Amount.IsNil() {
+		return sdk.NewInt64Coin(denom, 0)
+	}
+	return fee
+}
+
+func validateOptionalFee(fee sdk.Coin, name string) error {
+	if !fee.IsValid() {
+		return errorsmod.Wrapf(ErrInvalidFee, "invalid %s fee %s", name, fee)
+	}
+	return nil
+}
+
+func NewFeeDistribution(communityPool, burn, feeCollector sdk.Dec, feeCollectorAddress string) FeeDistribution {
+	return FeeDistribution{
+		CommunityPool:       communityPool,
+		Burn:                burn,
+		FeeCollector:        feeCollector,
+		FeeCollectorAddress: feeCollectorAddress,
+	}
+}
+
+// Validate checks the shares add up to one and the fee collector is set when
+// it has a share.
+func (d FeeDistribution) Validate() error {
+	total := sdk.ZeroDec()
+	for _, share := range []sdk.Dec{d.CommunityPool, d.Burn, d.FeeCollector} {
+		if share.IsNil() || share.IsNegative() {
+			return errorsmod.Wrapf(ErrInvalidFeeDistribution, "invalid share %s, must not be negative", share)
+		}
+		total = total.Add(share)
+	}
+	if !total.Equal(sdk.OneDec()) {
+		return errorsmod.Wrapf(ErrInvalidFeeDistribution, "shares must add up to 1, got %s", total)
+	}
+	if d.FeeCollector.IsPositive() && len(d.FeeCollectorAddress) == 0 {
+		return errorsmod.Wrap(ErrInvalidFeeDistribution, "fee collector address is required for a fee collector share")
+	}
+	if len(d.FeeCollectorAddress) > 0 {
+		if _, err := sdk.AccAddressFromBech32(d.FeeCollectorAddress); err != nil {
+			return errorsmod.Wrapf(ErrInvalidFeeDistribution, "invalid fee collector address %s", d.FeeCollectorAddress)
+		}
+	}
 	return nil
 }
 
-// ValidateDenomCreationFee performs validation of denom creation fee
+// GetPlatformFeePercentage returns the platform fee percentage, params stored
+// before the platform fee was introduced have no fee.
+func (p Params) GetPlatformFeePercentage() sdk.Dec {
+	if p.PlatformFeePercentage.IsNil() {
+		return sdk.ZeroDec()
+	}
+	return p.PlatformFeePercentage
+}
+
+func validatePlatformFee(percentage sdk.Dec, receiver string) error {
+	if percentage.IsNegative() || percentage.GTE(sdk.OneDec()) {
+		return errorsmod.Wrapf(ErrInvalidPlatformFee, "invalid platform fee percentage %s, must be positive and less than 1", percentage)
+	}
+	if len(receiver) > 0 {
+		if _, err := sdk.AccAddressFromBech32(receiver); err != nil {
+			return errorsmod.Wrapf(ErrInvalidPlatformFee, "invalid platform fee receiver %s", receiver)
+		}
+	}
+	return nil
+}
+
+// validateDenomCreationFees performs validation of the accepted denom creation fees
+func validateDenomCreationFees(fees sdk.Coins) error {
+	if len(fees) == 0 {
+		return errorsmod.Wrap(ErrInvalidDenomCreationFee, "at least one denom creation fee is required")
+	}
+	if err := fees.Validate(); err != nil {
+		return errorsmod.Wrapf(ErrInvalidDenomCreationFee, "invalid fees %s, only accepts positive amounts in distinct denoms; %s", fees, err)
+	}
+	return nil
+}
 
+// validateDenomCreationFee performs validation of the legacy denom creation fee
 func validateDenomCreationFee(i interface{}) error {
 	fee, ok := i.(sdk.Coin)
 	if !ok {
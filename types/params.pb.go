@@ -5,8 +5,8 @@ package types
 
 import (
 	fmt "fmt"
-	_ "github.com/cosmos/cosmos-sdk/types"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
@@ -26,7 +26,29 @@ var _ = math.Inf
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 type Params struct {
-	DenomCreationFee github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,1,opt,name=denom_creation_fee,json=denomCreationFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"denom_creation_fee" yaml:"denom_creation_fee"`
+	// denom_creation_fee is replaced by denom_creation_fees, it is only read by
+	// migrations
+	DenomCreationFee github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,1,opt,name=denom_creation_fee,json=denomCreationFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"denom_creation_fee" yaml:"denom_creation_fee"` // Deprecated: Do not use.
+	// platform_fee_percentage is the share of every marketplace sale paid as
+	// platform fee
+	PlatformFeePercentage github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=platform_fee_percentage,json=platformFeePercentage,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"platform_fee_percentage" yaml:"platform_fee_percentage"`
+	// platform_fee_receiver receives the platform fee, the community pool is
+	// funded when it is empty
+	PlatformFeeReceiver string `protobuf:"bytes,3,opt,name=platform_fee_receiver,json=platformFeeReceiver,proto3" json:"platform_fee_receiver,omitempty" yaml:"platform_fee_receiver"`
+	// max_nesting_depth is the number of levels oNFTs can be nested under a
+	// root oNFT, nesting is disabled when it is zero
+	MaxNestingDepth uint32 `protobuf:"varint,4,opt,name=max_nesting_depth,json=maxNestingDepth,proto3" json:"max_nesting_depth,omitempty" yaml:"max_nesting_depth"`
+	// mint_fee is charged to the signer of every mint, edition print, claim,
+	// airdrop claim and recipe execution
+	MintFee github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,5,opt,name=mint_fee,json=mintFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"mint_fee" yaml:"mint_fee"`
+	// transfer_fee is charged to the previous owner of every oNFT transfer,
+	// except moves in and out of module escrow
+	TransferFee github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,6,opt,name=transfer_fee,json=transferFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"transfer_fee" yaml:"transfer_fee"`
+	// fee_distribution splits the creation, mint and transfer fees
+	FeeDistribution FeeDistribution `protobuf:"bytes,7,opt,name=fee_distribution,json=feeDistribution,proto3" json:"fee_distribution" yaml:"fee_distribution"`
+	// denom_creation_fees lists the accepted denom creation fees, paying any one
+	// of them creates a denom
+	DenomCreationFees github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,8,rep,name=denom_creation_fees,json=denomCreationFees,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"denom_creation_fees" yaml:"denom_creation_fees"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -62,8 +84,51 @@ func (m *Params) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Params proto.InternalMessageInfo
 
+// FeeDistribution holds the shares of a fee going to the community pool, burnt
+// and sent to the fee collector. The shares add up to one.
+type FeeDistribution struct {
+	CommunityPool       github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=community_pool,json=communityPool,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"community_pool" yaml:"community_pool"`
+	Burn                github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=burn,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"burn"`
+	FeeCollector        github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=fee_collector,json=feeCollector,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"fee_collector" yaml:"fee_collector"`
+	FeeCollectorAddress string                                 `protobuf:"bytes,4,opt,name=fee_collector_address,json=feeCollectorAddress,proto3" json:"fee_collector_address,omitempty" yaml:"fee_collector_address"`
+}
+
+func (m *FeeDistribution) Reset()         { *m = FeeDistribution{} }
+func (m *FeeDistribution) String() string { return proto.CompactTextString(m) }
+func (*FeeDistribution) ProtoMessage()    {}
+func (*FeeDistribution) Descriptor() ([]byte, []int) {
+	return fileDescriptor_45b4f6ff6cbc6db3, []int{1}
+}
+func (m *FeeDistribution) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeDistribution) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeDistribution.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeeDistribution) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeDistribution.Merge(m, src)
+}
+func (m *FeeDistribution) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeDistribution) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeDistribution.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeDistribution proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*Params)(nil), "OmniFlix.onft.v1beta1.Params")
+	proto.RegisterType((*FeeDistribution)(nil), "OmniFlix.onft.v1beta1.FeeDistribution")
 }
 
 func init() {
@@ -71,24 +136,48 @@ func init() {
 }
 
 var fileDescriptor_45b4f6ff6cbc6db3 = []byte{
-	// 263 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0xf2, 0xcf, 0xcd, 0xcb,
-	0x74, 0xcb, 0xc9, 0xac, 0xd0, 0xcf, 0xcf, 0x4b, 0x2b, 0xd1, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49,
-	0x34, 0xd4, 0x2f, 0x48, 0x2c, 0x4a, 0xcc, 0x2d, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12,
-	0x85, 0xa9, 0xd1, 0x03, 0xa9, 0xd1, 0x83, 0xaa, 0x91, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0xab,
-	0xd0, 0x07, 0xb1, 0x20, 0x8a, 0xa5, 0xe4, 0x92, 0xf3, 0x8b, 0x73, 0xf3, 0x8b, 0xf5, 0x93, 0x12,
-	0x8b, 0x53, 0xe1, 0xc6, 0x25, 0xe7, 0x67, 0xe6, 0x41, 0xe4, 0x95, 0x16, 0x30, 0x72, 0xb1, 0x05,
-	0x80, 0x4d, 0x17, 0x9a, 0xca, 0xc8, 0x25, 0x94, 0x92, 0x9a, 0x97, 0x9f, 0x1b, 0x9f, 0x5c, 0x94,
-	0x9a, 0x58, 0x92, 0x99, 0x9f, 0x17, 0x9f, 0x96, 0x9a, 0x2a, 0xc1, 0xa8, 0xc0, 0xa8, 0xc1, 0x6d,
-	0x24, 0xa9, 0x07, 0x31, 0x48, 0x0f, 0x64, 0x10, 0xcc, 0x4e, 0x3d, 0xe7, 0xfc, 0xcc, 0x3c, 0x27,
-	0x9f, 0x13, 0xf7, 0xe4, 0x19, 0x6e, 0xdd, 0x93, 0x57, 0x4f, 0xcf, 0x2c, 0xc9, 0x28, 0x4d, 0xd2,
-	0x4b, 0xce, 0xcf, 0xd5, 0x87, 0xda, 0x0a, 0xa1, 0x74, 0x8b, 0x53, 0xb2, 0xf5, 0x4b, 0x2a, 0x0b,
-	0x52, 0x8b, 0xc1, 0x1a, 0x3e, 0xdd, 0x93, 0x97, 0xac, 0x4c, 0xcc, 0xcd, 0xb1, 0x52, 0xc2, 0xb4,
-	0x4d, 0x29, 0x48, 0x00, 0x2c, 0xe8, 0x0c, 0x15, 0x73, 0x4b, 0x4d, 0x75, 0xb2, 0x39, 0xf1, 0x50,
-	0x8e, 0xe1, 0xc4, 0x23, 0x39, 0xc6, 0x0b, 0x8f, 0xe4, 0x18, 0x1f, 0x3c, 0x92, 0x63, 0x9c, 0xf0,
-	0x58, 0x8e, 0xe1, 0xc2, 0x63, 0x39, 0x86, 0x1b, 0x8f, 0xe5, 0x18, 0xa2, 0xe4, 0x90, 0x6c, 0x45,
-	0x0d, 0x3c, 0xb0, 0x8d, 0x49, 0x6c, 0x60, 0x7f, 0x1a, 0x03, 0x02, 0x00, 0x00, 0xff, 0xff, 0x41,
-	0xc7, 0x09, 0xfd, 0x5a, 0x01, 0x00, 0x00,
+	// 645 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x55, 0xcd, 0x4e, 0xdb, 0x4e,
+	0x1c, 0x8c, 0xff, 0xf0, 0xe7, 0x63, 0x81, 0x06, 0x1c, 0x22, 0x0c, 0x42, 0x76, 0xb4, 0x07, 0x9a,
+	0x4b, 0x6d, 0xd1, 0xde, 0xaa, 0x5e, 0x6a, 0x50, 0xda, 0x13, 0x8d, 0x56, 0x3d, 0xf5, 0x62, 0x39,
+	0xce, 0x2f, 0xc1, 0xc2, 0xde, 0xb5, 0x76, 0x37, 0x28, 0xdc, 0x7b, 0xe0, 0xd8, 0x5b, 0xd5, 0x57,
+	0xe8, 0x93, 0x70, 0xe4, 0x58, 0xf5, 0xe0, 0xb6, 0xf0, 0x06, 0x79, 0x82, 0x6a, 0xfd, 0x91, 0x26,
+	0x01, 0x04, 0x39, 0xc5, 0x9e, 0x9d, 0x9d, 0x19, 0xfb, 0xb7, 0x13, 0x23, 0xfc, 0x21, 0xa6, 0x61,
+	0x2b, 0x0a, 0x87, 0x0e, 0xa3, 0x3d, 0xe9, 0x9c, 0x1f, 0x76, 0x40, 0xfa, 0x87, 0x4e, 0xe2, 0x73,
+	0x3f, 0x16, 0x76, 0xc2, 0x99, 0x64, 0x7a, 0xbd, 0xe4, 0xd8, 0x8a, 0x63, 0x17, 0x9c, 0xbd, 0xed,
+	0x3e, 0xeb, 0xb3, 0x8c, 0xe1, 0xa8, 0xab, 0x9c, 0xbc, 0x67, 0x06, 0x4c, 0xc4, 0x4c, 0x38, 0x1d,
+	0x5f, 0xc0, 0x58, 0x2e, 0x60, 0x21, 0xcd, 0xd7, 0x71, 0xba, 0x8c, 0x96, 0xda, 0x99, 0xba, 0xfe,
+	0x55, 0x43, 0x7a, 0x17, 0x28, 0x8b, 0xbd, 0x80, 0x83, 0x2f, 0x43, 0x46, 0xbd, 0x1e, 0x80, 0xa1,
+	0x35, 0xb4, 0xe6, 0xda, 0xcb, 0x5d, 0x3b, 0x17, 0xb2, 0x95, 0x50, 0xe9, 0x69, 0x1f, 0xb1, 0x90,
+	0xba, 0x27, 0x57, 0xa9, 0x55, 0xf9, 0x99, 0x5a, 0xcf, 0xfb, 0xa1, 0x3c, 0x1d, 0x74, 0xec, 0x80,
+	0xc5, 0x4e, 0xe1, 0x9a, 0xff, 0xbc, 0x10, 0xdd, 0x33, 0x47, 0x5e, 0x24, 0x20, 0xb2, 0x0d, 0xa3,
+	0xd4, 0xda, 0xbd, 0xf0, 0xe3, 0xe8, 0x35, 0xbe, 0xeb, 0x86, 0x0d, 0x8d, 0x6c, 0x66, 0xf0, 0x51,
+	0x81, 0xb6, 0x00, 0xf4, 0x4b, 0x0d, 0xed, 0x24, 0x91, 0x2f, 0x7b, 0x8c, 0xc7, 0x8a, 0xe5, 0x25,
+	0xc0, 0x03, 0xa0, 0xd2, 0xef, 0x83, 0xf1, 0x5f, 0x43, 0x6b, 0xae, 0xba, 0xed, 0x22, 0xc3, 0xc1,
+	0x13, 0x32, 0x1c, 0x43, 0x30, 0x4a, 0x2d, 0x33, 0x8f, 0xf0, 0x80, 0x2c, 0x26, 0xf5, 0x72, 0xa5,
+	0x05, 0xd0, 0x1e, 0xe3, 0xfa, 0x47, 0x54, 0x9f, 0xda, 0xc2, 0x21, 0x80, 0xf0, 0x1c, 0xb8, 0xb1,
+	0x90, 0xe5, 0x68, 0x8c, 0x52, 0x6b, 0xff, 0x1e, 0xe5, 0x92, 0x86, 0x49, 0x6d, 0x42, 0x97, 0x14,
+	0xa8, 0xfe, 0x1e, 0x6d, 0xc5, 0xfe, 0xd0, 0xa3, 0x20, 0x64, 0x48, 0xfb, 0x5e, 0x17, 0x12, 0x79,
+	0x6a, 0x2c, 0x36, 0xb4, 0xe6, 0x86, 0xbb, 0x3f, 0x4a, 0x2d, 0x23, 0x57, 0xbc, 0x43, 0xc1, 0xa4,
+	0x1a, 0xfb, 0xc3, 0x93, 0x1c, 0x3a, 0x56, 0x88, 0x3e, 0x44, 0x2b, 0x71, 0x48, 0x65, 0x36, 0xb9,
+	0xff, 0x1f, 0x9b, 0x9c, 0x3b, 0xff, 0xe4, 0xaa, 0x45, 0x94, 0xc2, 0x03, 0x93, 0x65, 0x75, 0xa9,
+	0x86, 0xf4, 0x59, 0x43, 0xeb, 0x92, 0xfb, 0x54, 0xf4, 0x80, 0x67, 0xf6, 0x4b, 0x8f, 0xd9, 0xb7,
+	0xe6, 0xb7, 0xaf, 0xe5, 0xf6, 0x93, 0x3e, 0x98, 0xac, 0x95, 0xb7, 0x2a, 0x06, 0x47, 0x9b, 0xea,
+	0x85, 0x77, 0x43, 0x21, 0x79, 0xd8, 0x19, 0xa8, 0x23, 0x64, 0x2c, 0x67, 0x49, 0x0e, 0xec, 0x7b,
+	0x8b, 0x63, 0xb7, 0x00, 0x8e, 0x27, 0xd8, 0xae, 0xa5, 0x62, 0x8d, 0x52, 0x6b, 0x27, 0xf7, 0x9a,
+	0x55, 0xc3, 0xa4, 0xda, 0x9b, 0xde, 0xa1, 0x7f, 0xd3, 0x50, 0xed, 0xee, 0x59, 0x16, 0xc6, 0x4a,
+	0x63, 0xe1, 0x09, 0xd5, 0x19, 0xa5, 0xd6, 0xde, 0x43, 0x7d, 0x10, 0xf8, 0xfb, 0x2f, 0xab, 0xf9,
+	0xc4, 0xf7, 0x23, 0xc8, 0xd6, 0x6c, 0x75, 0x04, 0xbe, 0x5c, 0x40, 0xd5, 0x99, 0x27, 0xd4, 0x29,
+	0x7a, 0x16, 0xb0, 0x38, 0x1e, 0xd0, 0x50, 0x5e, 0x78, 0x09, 0x63, 0x51, 0x56, 0xf2, 0x55, 0xf7,
+	0xdd, 0xdc, 0x2d, 0xaa, 0xe7, 0xc1, 0xa7, 0xd5, 0x30, 0xd9, 0x18, 0x03, 0x6d, 0xc6, 0x22, 0xdd,
+	0x45, 0x8b, 0x9d, 0x01, 0xa7, 0x45, 0x57, 0xed, 0xf9, 0x5c, 0x48, 0xb6, 0x57, 0x3f, 0x43, 0x1b,
+	0x6a, 0x12, 0x01, 0x8b, 0x22, 0x08, 0x24, 0x2b, 0x0b, 0xd7, 0x9a, 0x3b, 0xf2, 0xf6, 0xbf, 0xb1,
+	0x8e, 0xc5, 0x30, 0x59, 0xef, 0x01, 0x1c, 0x95, 0xb7, 0xaa, 0xe5, 0x53, 0xeb, 0x9e, 0xdf, 0xed,
+	0x72, 0x10, 0xc2, 0x58, 0x9c, 0x6d, 0xf9, 0xbd, 0x34, 0x4c, 0x6a, 0x93, 0x72, 0x6f, 0x73, 0xd4,
+	0x7d, 0x73, 0xf5, 0xc7, 0xac, 0x5c, 0xdd, 0x98, 0xda, 0xf5, 0x8d, 0xa9, 0xfd, 0xbe, 0x31, 0xb5,
+	0x2f, 0xb7, 0x66, 0xe5, 0xfa, 0xd6, 0xac, 0xfc, 0xb8, 0x35, 0x2b, 0x9f, 0xcc, 0x89, 0x27, 0x98,
+	0xfe, 0x0a, 0x64, 0xe9, 0x3b, 0x4b, 0xd9, 0x1f, 0xf6, 0xab, 0xbf, 0x03, 0x00, 0x9b, 0x2a, 0xd5,
+	0x25, 0x23, 0x06, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -111,6 +200,72 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.DenomCreationFees) > 0 {
+		for iNdEx := len(m.DenomCreationFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DenomCreationFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	{
+		size, err := m.FeeDistribution.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.TransferFee.Size()
+		i -= size
+		if _, err := m.TransferFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.MintFee.Size()
+		i -= size
+		if _, err := m.MintFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.MaxNestingDepth != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxNestingDepth))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.PlatformFeeReceiver) > 0 {
+		i -= len(m.PlatformFeeReceiver)
+		copy(dAtA[i:], m.PlatformFeeReceiver)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.PlatformFeeReceiver)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size := m.PlatformFeePercentage.Size()
+		i -= size
+		if _, err := m.PlatformFeePercentage.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
 	{
 		size := m.DenomCreationFee.Size()
 		i -= size
@@ -124,6 +279,66 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *FeeDistribution) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeDistribution) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeDistribution) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FeeCollectorAddress) > 0 {
+		i -= len(m.FeeCollectorAddress)
+		copy(dAtA[i:], m.FeeCollectorAddress)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.FeeCollectorAddress)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size := m.FeeCollector.Size()
+		i -= size
+		if _, err := m.FeeCollector.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Burn.Size()
+		i -= size
+		if _, err := m.Burn.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.CommunityPool.Size()
+		i -= size
+		if _, err := m.CommunityPool.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
 	offset -= sovParams(v)
 	base := offset
@@ -143,6 +358,46 @@ func (m *Params) Size() (n int) {
 	_ = l
 	l = m.DenomCreationFee.Size()
 	n += 1 + l + sovParams(uint64(l))
+	l = m.PlatformFeePercentage.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = len(m.PlatformFeeReceiver)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if m.MaxNestingDepth != 0 {
+		n += 1 + sovParams(uint64(m.MaxNestingDepth))
+	}
+	l = m.MintFee.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.TransferFee.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.FeeDistribution.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if len(m.DenomCreationFees) > 0 {
+		for _, e := range m.DenomCreationFees {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *FeeDistribution) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.CommunityPool.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.Burn.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.FeeCollector.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = len(m.FeeCollectorAddress)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
 	return n
 }
 
@@ -214,6 +469,408 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PlatformFeePercentage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PlatformFeePercentage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PlatformFeeReceiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PlatformFeeReceiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxNestingDepth", wireType)
+			}
+			m.MaxNestingDepth = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxNestingDepth |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MintFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TransferFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeDistribution", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeeDistribution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomCreationFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomCreationFees = append(m.DenomCreationFees, types.Coin{})
+			if err := m.DenomCreationFees[len(m.DenomCreationFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeeDistribution) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeDistribution: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeDistribution: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommunityPool", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CommunityPool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Burn", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Burn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeCollector", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeeCollector.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeCollectorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeCollectorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -0,0 +1,47 @@
+package types
+
+import "strings"
+
+const (
+	PauseActionMint     = "mint"
+	PauseActionTransfer = "transfer"
+	PauseActionEdit     = "edit"
+)
+
+// IsEmpty returns true if no action is selected
+func (p DenomPause) IsEmpty() bool {
+	return !p.Mint && !p.Transfer && !p.Edit
+}
+
+// Add returns the actions paused in p or in other
+func (p DenomPause) Add(other DenomPause) DenomPause {
+	return DenomPause{
+		Mint:     p.Mint || other.Mint,
+		Transfer: p.Transfer || other.Transfer,
+		Edit:     p.Edit || other.Edit,
+	}
+}
+
+// Remove returns the actions paused in p and not selected in other
+func (p DenomPause) Remove(other DenomPause) DenomPause {
+	return DenomPause{
+		Mint:     p.Mint && !other.Mint,
+		Transfer: p.Transfer && !other.Transfer,
+		Edit:     p.Edit && !other.Edit,
+	}
+}
+
+// Actions returns the comma separated names of the selected actions
+func (p DenomPause) Actions() string {
+	var actions []string
+	if p.Mint {
+		actions = append(actions, PauseActionMint)
+	}
+	if p.Transfer {
+		actions = append(actions, PauseActionTransfer)
+	}
+	if p.Edit {
+		actions = append(actions, PauseActionEdit)
+	}
+	return strings.Join(actions, ",")
+}
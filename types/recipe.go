@@ -0,0 +1,151 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// MaxRecipeInputs is the max number of inputs of a recipe
+	MaxRecipeInputs = 10
+	// MaxRecipeInputONFTs is the max number of oNFTs burned by a recipe
+	MaxRecipeInputONFTs = 50
+	// MaxTraitFilters is the max number of trait filters of a recipe input
+	MaxTraitFilters = 10
+)
+
+// RecipeONFTID returns the id of the n-th oNFT minted by a recipe
+func RecipeONFTID(recipeID, n uint64) string {
+	return fmt.Sprintf("recipe%dn%d", recipeID, n)
+}
+
+// ValidateRecipe checks the inputs, output template and cost of a recipe
+func ValidateRecipe(outputDenomID string, template ONFTTemplate, inputs []RecipeInput, cost sdk.Coins) error {
+	if err := ValidateDenomID(outputDenomID); err != nil {
+		return err
+	}
+	if err := ValidateONFTTemplate(template); err != nil {
+		return err
+	}
+	if len(inputs) == 0 || len(inputs) > MaxRecipeInputs {
+		return errorsmod.Wrapf(ErrInvalidRecipe, "recipe must have between 1 and %d inputs", MaxRecipeInputs)
+	}
+	total := 0
+	for _, input := range inputs {
+		if err := input.Validate(); err != nil {
+			return err
+		}
+		total += int(input.Count)
+	}
+	if total > MaxRecipeInputONFTs {
+		return errorsmod.Wrapf(ErrInvalidRecipe, "recipe must burn at most %d onfts", MaxRecipeInputONFTs)
+	}
+	if err := cost.Validate(); err != nil {
+		return errorsmod.Wrapf(ErrInvalidRecipe, "invalid cost %s: %s", cost, err)
+	}
+	return nil
+}
+
+// Validate checks the denom, count and trait filters of a recipe input
+func (i RecipeInput) Validate() error {
+	if err := ValidateDenomID(i.DenomId); err != nil {
+		return err
+	}
+	if i.Count == 0 {
+		return errorsmod.Wrapf(ErrInvalidRecipe, "count of input %s must be positive", i.DenomId)
+	}
+	if len(i.Traits) > MaxTraitFilters {
+		return errorsmod.Wrapf(ErrInvalidRecipe, "input %s has more than %d trait filters", i.DenomId, MaxTraitFilters)
+	}
+	for _, trait := range i.Traits {
+		if len(strings.TrimSpace(trait.Key)) == 0 {
+			return errorsmod.Wrapf(ErrInvalidRecipe, "trait filter of input %s has an empty key", i.DenomId)
+		}
+	}
+	return nil
+}
+
+// Matches returns true if the oNFT is of the input denom and its data
+// matches all trait filters of the input.
+func (i RecipeInput) Matches(denomID string, onft ONFT) bool {
+	if denomID != i.DenomId {
+		return false
+	}
+	if len(i.Traits) == 0 {
+		return true
+	}
+	var traits map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(onft.Data), &traits); err != nil {
+		return false
+	}
+	for _, filter := range i.Traits {
+		raw, ok := traits[filter.Key]
+		if !ok || traitValue(raw) != filter.Value {
+			return false
+		}
+	}
+	return true
+}
+
+// traitValue returns a JSON string unquoted and other JSON values as encoded
+func traitValue(raw json.RawMessage) string {
+	var value string
+	if err := json.Unmarshal(raw, &value); err == nil {
+		return value
+	}
+	return strings.TrimSpace(string(raw))
+}
+
+// InputONFTCount returns the number of oNFTs burned by the recipe
+func (r Recipe) InputONFTCount() int {
+	total := 0
+	for _, input := range r.Inputs {
+		total += int(input.Count)
+	}
+	return total
+}
+
+// Validate checks the stateless consistency of a stored recipe
+func (r Recipe) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(r.Creator); err != nil {
+		return err
+	}
+	return ValidateRecipe(r.OutputDenomId, r.OutputTemplate, r.Inputs, r.Cost)
+}
+
+// ParseRecipeInputs parses comma separated recipe inputs of the form
+// denom-id:count[:key=value;key=value]
+func ParseRecipeInputs(value string) ([]RecipeInput, error) {
+	var inputs []RecipeInput
+	for _, entry := range strings.Split(value, ",") {
+		entry = strings.TrimSpace(entry)
+		if len(entry) == 0 {
+			continue
+		}
+		parts := strings.SplitN(entry, ":", 3)
+		if len(parts) < 2 {
+			return nil, fmt.Errorf("invalid recipe input %s, expected denom-id:count[:key=value;...]", entry)
+		}
+		count, err := strconv.ParseUint(parts[1], 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("invalid count of recipe input %s", entry)
+		}
+		input := RecipeInput{DenomId: strings.ToLower(strings.TrimSpace(parts[0])), Count: uint32(count)}
+		if len(parts) == 3 {
+			for _, filter := range strings.Split(parts[2], ";") {
+				kv := strings.SplitN(filter, "=", 2)
+				if len(kv) != 2 {
+					return nil, fmt.Errorf("invalid trait filter %s, expected key=value", filter)
+				}
+				input.Traits = append(input.Traits, TraitFilter{Key: kv[0], Value: kv[1]})
+			}
+		}
+		inputs = append(inputs, input)
+	}
+	return inputs, nil
+}
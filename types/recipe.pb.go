@@ -0,0 +1,1162 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: OmniFlix/onft/v1beta1/recipe.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// TraitFilter matches oNFTs whose data is a JSON object with value at key
+type TraitFilter struct {
+	Key   string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (m *TraitFilter) Reset()         { *m = TraitFilter{} }
+func (m *TraitFilter) String() string { return proto.CompactTextString(m) }
+func (*TraitFilter) ProtoMessage()    {}
+func (*TraitFilter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_53870343953bf174, []int{0}
+}
+func (m *TraitFilter) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TraitFilter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TraitFilter.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TraitFilter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TraitFilter.Merge(m, src)
+}
+func (m *TraitFilter) XXX_Size() int {
+	return m.Size()
+}
+func (m *TraitFilter) XXX_DiscardUnknown() {
+	xxx_messageInfo_TraitFilter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TraitFilter proto.InternalMessageInfo
+
+// RecipeInput requires count oNFTs of a denom matching all trait filters
+type RecipeInput struct {
+	DenomId string        `protobuf:"bytes,1,opt,name=denom_id,json=denomId,proto3" json:"denom_id,omitempty" yaml:"denom_id"`
+	Count   uint32        `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	Traits  []TraitFilter `protobuf:"bytes,3,rep,name=traits,proto3" json:"traits"`
+}
+
+func (m *RecipeInput) Reset()         { *m = RecipeInput{} }
+func (m *RecipeInput) String() string { return proto.CompactTextString(m) }
+func (*RecipeInput) ProtoMessage()    {}
+func (*RecipeInput) Descriptor() ([]byte, []int) {
+	return fileDescriptor_53870343953bf174, []int{1}
+}
+func (m *RecipeInput) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RecipeInput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RecipeInput.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RecipeInput) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RecipeInput.Merge(m, src)
+}
+func (m *RecipeInput) XXX_Size() int {
+	return m.Size()
+}
+func (m *RecipeInput) XXX_DiscardUnknown() {
+	xxx_messageInfo_RecipeInput.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RecipeInput proto.InternalMessageInfo
+
+// Recipe burns its inputs and mints an oNFT of the output denom from the
+// output template. Recipes are registered by the creator of the output denom,
+// executions pay the cost to the creator.
+type Recipe struct {
+	Id             uint64                                   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Creator        string                                   `protobuf:"bytes,2,opt,name=creator,proto3" json:"creator,omitempty"`
+	OutputDenomId  string                                   `protobuf:"bytes,3,opt,name=output_denom_id,json=outputDenomId,proto3" json:"output_denom_id,omitempty" yaml:"output_denom_id"`
+	OutputTemplate ONFTTemplate                             `protobuf:"bytes,4,opt,name=output_template,json=outputTemplate,proto3" json:"output_template" yaml:"output_template"`
+	Inputs         []RecipeInput                            `protobuf:"bytes,5,rep,name=inputs,proto3" json:"inputs"`
+	Cost           github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,6,rep,name=cost,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"cost"`
+	Executions     uint64                                   `protobuf:"varint,7,opt,name=executions,proto3" json:"executions,omitempty"`
+}
+
+func (m *Recipe) Reset()         { *m = Recipe{} }
+func (m *Recipe) String() string { return proto.CompactTextString(m) }
+func (*Recipe) ProtoMessage()    {}
+func (*Recipe) Descriptor() ([]byte, []int) {
+	return fileDescriptor_53870343953bf174, []int{2}
+}
+func (m *Recipe) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Recipe) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Recipe.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Recipe) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Recipe.Merge(m, src)
+}
+func (m *Recipe) XXX_Size() int {
+	return m.Size()
+}
+func (m *Recipe) XXX_DiscardUnknown() {
+	xxx_messageInfo_Recipe.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Recipe proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*TraitFilter)(nil), "OmniFlix.onft.v1beta1.TraitFilter")
+	proto.RegisterType((*RecipeInput)(nil), "OmniFlix.onft.v1beta1.RecipeInput")
+	proto.RegisterType((*Recipe)(nil), "OmniFlix.onft.v1beta1.Recipe")
+}
+
+func init() {
+	proto.RegisterFile("OmniFlix/onft/v1beta1/recipe.proto", fileDescriptor_53870343953bf174)
+}
+
+var fileDescriptor_53870343953bf174 = []byte{
+	// 499 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x52, 0x3f, 0x6f, 0xd3, 0x4e,
+	0x18, 0xb6, 0x63, 0x37, 0xf9, 0xfd, 0x2e, 0x6a, 0x8b, 0x8e, 0x82, 0x4c, 0x86, 0x73, 0x30, 0x4b,
+	0x16, 0x6c, 0x5a, 0xb6, 0xc2, 0x80, 0x0c, 0x8a, 0xd4, 0x85, 0x4a, 0x56, 0x26, 0x96, 0xc8, 0x39,
+	0x1f, 0xe1, 0x54, 0xdb, 0x67, 0xf9, 0xce, 0x55, 0xf3, 0x2d, 0xd8, 0x59, 0x98, 0x91, 0xf8, 0x1e,
+	0x19, 0x3b, 0x32, 0x05, 0x48, 0x16, 0xe6, 0x7e, 0x02, 0x74, 0x7f, 0x1c, 0x05, 0x28, 0x4c, 0xbe,
+	0x7b, 0xfd, 0x3c, 0xcf, 0xfb, 0x3c, 0xef, 0xbd, 0x20, 0x38, 0x2f, 0x4a, 0x3a, 0xce, 0xe9, 0x55,
+	0xc4, 0xca, 0xb7, 0x22, 0xba, 0x3c, 0x9e, 0x11, 0x91, 0x1e, 0x47, 0x35, 0xc1, 0xb4, 0x22, 0x61,
+	0x55, 0x33, 0xc1, 0xe0, 0xbd, 0x16, 0x13, 0x4a, 0x4c, 0x68, 0x30, 0x83, 0xa3, 0x39, 0x9b, 0x33,
+	0x85, 0x88, 0xe4, 0x49, 0x83, 0x07, 0x08, 0x33, 0x5e, 0x30, 0x1e, 0xcd, 0x52, 0x4e, 0xb6, 0x72,
+	0x98, 0xd1, 0xd2, 0xfc, 0x7f, 0x78, 0x7b, 0x43, 0x9c, 0xa7, 0xb4, 0xd0, 0x90, 0xe0, 0x19, 0xe8,
+	0x4f, 0xea, 0x94, 0x8a, 0x31, 0xcd, 0x05, 0xa9, 0xe1, 0x1d, 0xe0, 0x5c, 0x90, 0x85, 0x67, 0x0f,
+	0xed, 0xd1, 0xff, 0x89, 0x3c, 0xc2, 0x23, 0xb0, 0x77, 0x99, 0xe6, 0x0d, 0xf1, 0x3a, 0xaa, 0xa6,
+	0x2f, 0xa7, 0xee, 0x8f, 0x8f, 0xbe, 0x1d, 0x7c, 0xb0, 0x41, 0x3f, 0x51, 0xee, 0xcf, 0xca, 0xaa,
+	0x11, 0x30, 0x04, 0xff, 0x65, 0xa4, 0x64, 0xc5, 0x94, 0x66, 0x5a, 0x22, 0xbe, 0x7b, 0xb3, 0xf2,
+	0x0f, 0x17, 0x69, 0x91, 0x9f, 0x06, 0xed, 0x9f, 0x20, 0xe9, 0xa9, 0xe3, 0x59, 0x26, 0xb5, 0x31,
+	0x6b, 0x4a, 0xa1, 0xb4, 0xf7, 0x13, 0x7d, 0x81, 0x2f, 0x40, 0x57, 0x48, 0x4b, 0xdc, 0x73, 0x86,
+	0xce, 0xa8, 0x7f, 0x12, 0x84, 0xb7, 0xce, 0x24, 0xdc, 0xf1, 0x1d, 0xbb, 0xcb, 0x95, 0x6f, 0x25,
+	0x86, 0x67, 0xdc, 0x7d, 0x76, 0x40, 0x57, 0xbb, 0x83, 0x07, 0xa0, 0x63, 0x2c, 0xb9, 0x49, 0x87,
+	0x66, 0xd0, 0x03, 0x3d, 0x5c, 0x93, 0x54, 0xb0, 0xda, 0xc4, 0x6a, 0xaf, 0x30, 0x06, 0x87, 0xac,
+	0x11, 0x55, 0x23, 0xa6, 0xdb, 0x24, 0x8e, 0x4a, 0x32, 0xb8, 0x59, 0xf9, 0xf7, 0x75, 0x92, 0xdf,
+	0x00, 0x41, 0xb2, 0xaf, 0x2b, 0xaf, 0x4c, 0xac, 0x7c, 0xab, 0x21, 0x48, 0x51, 0xe5, 0xa9, 0x20,
+	0x9e, 0x3b, 0xb4, 0x47, 0xfd, 0x93, 0x47, 0x7f, 0x49, 0x72, 0xfe, 0x7a, 0x3c, 0x99, 0x18, 0x68,
+	0x8c, 0x64, 0x94, 0x3f, 0x9a, 0xb5, 0x4a, 0x41, 0x72, 0xa0, 0x2b, 0x2d, 0x5e, 0x8e, 0x8b, 0xca,
+	0xe9, 0x73, 0x6f, 0xef, 0x9f, 0xe3, 0xda, 0x79, 0xa8, 0x76, 0x5c, 0x9a, 0x07, 0xa7, 0xc0, 0xc5,
+	0x8c, 0x0b, 0xaf, 0xab, 0xf8, 0x0f, 0x42, 0xbd, 0x55, 0xa1, 0xdc, 0xaa, 0x2d, 0xfb, 0x25, 0xa3,
+	0x65, 0xfc, 0x44, 0xd2, 0x3e, 0x7d, 0xf5, 0x47, 0x73, 0x2a, 0xde, 0x35, 0xb3, 0x10, 0xb3, 0x22,
+	0x32, 0x2b, 0xa8, 0x3f, 0x8f, 0x79, 0x76, 0x11, 0x89, 0x45, 0x45, 0xb8, 0x22, 0xf0, 0x44, 0x09,
+	0x43, 0x04, 0x00, 0xb9, 0x22, 0xb8, 0x11, 0x94, 0x95, 0xdc, 0xeb, 0xa9, 0x67, 0xd8, 0xa9, 0xe8,
+	0xf7, 0x8a, 0x9f, 0x2f, 0xbf, 0x23, 0x6b, 0xb9, 0x46, 0xf6, 0xf5, 0x1a, 0xd9, 0xdf, 0xd6, 0xc8,
+	0x7e, 0xbf, 0x41, 0xd6, 0xf5, 0x06, 0x59, 0x5f, 0x36, 0xc8, 0x7a, 0x83, 0x76, 0x7a, 0xfe, 0xba,
+	0xd6, 0xaa, 0xdf, 0xac, 0xab, 0xf6, 0xf9, 0xe9, 0xcf, 0x01, 0x00, 0x02, 0xc5, 0xba, 0x2c, 0x65,
+	0x03, 0x00, 0x00,
+}
+
+func (this *TraitFilter) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*TraitFilter)
+	if !ok {
+		that2, ok := that.(TraitFilter)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Key != that1.Key {
+		return false
+	}
+	if this.Value != that1.Value {
+		return false
+	}
+	return true
+}
+func (this *RecipeInput) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RecipeInput)
+	if !ok {
+		that2, ok := that.(RecipeInput)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.DenomId != that1.DenomId {
+		return false
+	}
+	if this.Count != that1.Count {
+		return false
+	}
+	if len(this.Traits) != len(that1.Traits) {
+		return false
+	}
+	for i := range this.Traits {
+		if !this.Traits[i].Equal(&that1.Traits[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *Recipe) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Recipe)
+	if !ok {
+		that2, ok := that.(Recipe)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Id != that1.Id {
+		return false
+	}
+	if this.Creator != that1.Creator {
+		return false
+	}
+	if this.OutputDenomId != that1.OutputDenomId {
+		return false
+	}
+	if !this.OutputTemplate.Equal(&that1.OutputTemplate) {
+		return false
+	}
+	if len(this.Inputs) != len(that1.Inputs) {
+		return false
+	}
+	for i := range this.Inputs {
+		if !this.Inputs[i].Equal(&that1.Inputs[i]) {
+			return false
+		}
+	}
+	if len(this.Cost) != len(that1.Cost) {
+		return false
+	}
+	for i := range this.Cost {
+		if !this.Cost[i].Equal(&that1.Cost[i]) {
+			return false
+		}
+	}
+	if this.Executions != that1.Executions {
+		return false
+	}
+	return true
+}
+func (m *TraitFilter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TraitFilter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TraitFilter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintRecipe(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintRecipe(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RecipeInput) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RecipeInput) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RecipeInput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Traits) > 0 {
+		for iNdEx := len(m.Traits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Traits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRecipe(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Count != 0 {
+		i = encodeVarintRecipe(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.DenomId) > 0 {
+		i -= len(m.DenomId)
+		copy(dAtA[i:], m.DenomId)
+		i = encodeVarintRecipe(dAtA, i, uint64(len(m.DenomId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Recipe) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Recipe) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Recipe) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Executions != 0 {
+		i = encodeVarintRecipe(dAtA, i, uint64(m.Executions))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Cost) > 0 {
+		for iNdEx := len(m.Cost) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Cost[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRecipe(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Inputs) > 0 {
+		for iNdEx := len(m.Inputs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Inputs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRecipe(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	{
+		size, err := m.OutputTemplate.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintRecipe(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.OutputDenomId) > 0 {
+		i -= len(m.OutputDenomId)
+		copy(dAtA[i:], m.OutputDenomId)
+		i = encodeVarintRecipe(dAtA, i, uint64(len(m.OutputDenomId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintRecipe(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintRecipe(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintRecipe(dAtA []byte, offset int, v uint64) int {
+	offset -= sovRecipe(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *TraitFilter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovRecipe(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovRecipe(uint64(l))
+	}
+	return n
+}
+
+func (m *RecipeInput) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DenomId)
+	if l > 0 {
+		n += 1 + l + sovRecipe(uint64(l))
+	}
+	if m.Count != 0 {
+		n += 1 + sovRecipe(uint64(m.Count))
+	}
+	if len(m.Traits) > 0 {
+		for _, e := range m.Traits {
+			l = e.Size()
+			n += 1 + l + sovRecipe(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Recipe) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovRecipe(uint64(m.Id))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovRecipe(uint64(l))
+	}
+	l = len(m.OutputDenomId)
+	if l > 0 {
+		n += 1 + l + sovRecipe(uint64(l))
+	}
+	l = m.OutputTemplate.Size()
+	n += 1 + l + sovRecipe(uint64(l))
+	if len(m.Inputs) > 0 {
+		for _, e := range m.Inputs {
+			l = e.Size()
+			n += 1 + l + sovRecipe(uint64(l))
+		}
+	}
+	if len(m.Cost) > 0 {
+		for _, e := range m.Cost {
+			l = e.Size()
+			n += 1 + l + sovRecipe(uint64(l))
+		}
+	}
+	if m.Executions != 0 {
+		n += 1 + sovRecipe(uint64(m.Executions))
+	}
+	return n
+}
+
+func sovRecipe(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozRecipe(x uint64) (n int) {
+	return sovRecipe(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *TraitFilter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRecipe
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TraitFilter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TraitFilter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecipe
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRecipe
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecipe
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecipe
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRecipe
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecipe
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRecipe(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRecipe
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RecipeInput) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRecipe
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RecipeInput: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RecipeInput: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecipe
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRecipe
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecipe
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecipe
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Traits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecipe
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRecipe
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecipe
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Traits = append(m.Traits, TraitFilter{})
+			if err := m.Traits[len(m.Traits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRecipe(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRecipe
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Recipe) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRecipe
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Recipe: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Recipe: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecipe
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecipe
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRecipe
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecipe
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutputDenomId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecipe
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRecipe
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecipe
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OutputDenomId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutputTemplate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecipe
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRecipe
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecipe
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OutputTemplate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Inputs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecipe
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRecipe
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecipe
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Inputs = append(m.Inputs, RecipeInput{})
+			if err := m.Inputs[len(m.Inputs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cost", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecipe
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRecipe
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecipe
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Cost = append(m.Cost, types.Coin{})
+			if err := m.Cost[len(m.Cost)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Executions", wireType)
+			}
+			m.Executions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecipe
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Executions |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRecipe(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRecipe
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipRecipe(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowRecipe
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRecipe
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRecipe
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthRecipe
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupRecipe
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthRecipe
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthRecipe        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowRecipe          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupRecipe = fmt.Errorf("proto: unexpected end of group")
+)
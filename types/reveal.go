@@ -0,0 +1,71 @@
+package types
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+)
+
+// MaxRevealONFTs is the max number of oNFTs revealed by a single MsgRevealDenom
+const MaxRevealONFTs = 100
+
+// RevealLeafHash returns the merkle leaf hash of the final metadata and data of an oNFT
+func RevealLeafHash(onftID string, metadata Metadata, data string) []byte {
+	dataHash := sha256.Sum256([]byte(data))
+	leaf := strings.Join([]string{onftID, AirdropMetadataHash(&metadata), hex.EncodeToString(dataHash[:])}, "/")
+	return MerkleLeafHash([]byte(leaf))
+}
+
+// ValidateMetadataRoot checks that the root is a hex encoded sha256 hash
+func ValidateMetadataRoot(root string) error {
+	bz, err := hex.DecodeString(root)
+	if err != nil || len(bz) != sha256.Size {
+		return errorsmod.Wrapf(ErrInvalidReveal, "invalid metadata root %s", root)
+	}
+	return nil
+}
+
+// Validate checks the stateless consistency of a revealed oNFT
+func (r RevealedONFT) Validate() error {
+	if err := ValidateONFTID(r.Id); err != nil {
+		return err
+	}
+	if err := ValidateMetadata(r.Metadata); err != nil {
+		return err
+	}
+	// the proof of a tree with a single leaf is empty, the root is the leaf
+	_, err := DecodeMerkleProof(r.Proof)
+	return err
+}
+
+// Verify checks the merkle proof of the revealed oNFT against the metadata root
+func (r RevealedONFT) Verify(root string) error {
+	rootBz, err := hex.DecodeString(root)
+	if err != nil {
+		return errorsmod.Wrapf(ErrInvalidReveal, "invalid metadata root %s", root)
+	}
+	proof, err := DecodeMerkleProof(r.Proof)
+	if err != nil {
+		return err
+	}
+	if !VerifyMerkleProof(rootBz, RevealLeafHash(r.Id, r.Metadata, r.Data), proof) {
+		return errorsmod.Wrapf(ErrInvalidMerkleProof, "metadata of onft %s does not match the commitment", r.Id)
+	}
+	return nil
+}
+
+// Validate checks the stateless consistency of a stored metadata commitment
+func (c MetadataCommitment) Validate() error {
+	if err := ValidateDenomID(c.DenomId); err != nil {
+		return err
+	}
+	if err := ValidateMetadataRoot(c.MetadataRoot); err != nil {
+		return err
+	}
+	if c.Revealed && c.Unrevealed > 0 {
+		return errorsmod.Wrapf(ErrInvalidReveal, "revealed denom %s has %d unrevealed onfts", c.DenomId, c.Unrevealed)
+	}
+	return nil
+}
@@ -0,0 +1,805 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: OmniFlix/onft/v1beta1/reveal.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// MetadataCommitment is the commitment of an unrevealed denom to the final
+// metadata of its oNFTs, as the merkle root over the metadata of every oNFT
+type MetadataCommitment struct {
+	DenomId      string `protobuf:"bytes,1,opt,name=denom_id,json=denomId,proto3" json:"denom_id,omitempty" yaml:"denom_id"`
+	MetadataRoot string `protobuf:"bytes,2,opt,name=metadata_root,json=metadataRoot,proto3" json:"metadata_root,omitempty" yaml:"metadata_root"`
+	// unrevealed is the number of minted oNFTs still holding placeholder metadata
+	Unrevealed uint64 `protobuf:"varint,3,opt,name=unrevealed,proto3" json:"unrevealed,omitempty"`
+	Revealed   bool   `protobuf:"varint,4,opt,name=revealed,proto3" json:"revealed,omitempty"`
+}
+
+func (m *MetadataCommitment) Reset()         { *m = MetadataCommitment{} }
+func (m *MetadataCommitment) String() string { return proto.CompactTextString(m) }
+func (*MetadataCommitment) ProtoMessage()    {}
+func (*MetadataCommitment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3870f0e9a02f24aa, []int{0}
+}
+func (m *MetadataCommitment) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MetadataCommitment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MetadataCommitment.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MetadataCommitment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MetadataCommitment.Merge(m, src)
+}
+func (m *MetadataCommitment) XXX_Size() int {
+	return m.Size()
+}
+func (m *MetadataCommitment) XXX_DiscardUnknown() {
+	xxx_messageInfo_MetadataCommitment.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MetadataCommitment proto.InternalMessageInfo
+
+// RevealedONFT holds the final metadata of an oNFT with its merkle proof
+type RevealedONFT struct {
+	Id       string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Metadata Metadata `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata"`
+	Data     string   `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	Proof    []string `protobuf:"bytes,4,rep,name=proof,proto3" json:"proof,omitempty"`
+}
+
+func (m *RevealedONFT) Reset()         { *m = RevealedONFT{} }
+func (m *RevealedONFT) String() string { return proto.CompactTextString(m) }
+func (*RevealedONFT) ProtoMessage()    {}
+func (*RevealedONFT) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3870f0e9a02f24aa, []int{1}
+}
+func (m *RevealedONFT) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RevealedONFT) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RevealedONFT.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RevealedONFT) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RevealedONFT.Merge(m, src)
+}
+func (m *RevealedONFT) XXX_Size() int {
+	return m.Size()
+}
+func (m *RevealedONFT) XXX_DiscardUnknown() {
+	xxx_messageInfo_RevealedONFT.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RevealedONFT proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MetadataCommitment)(nil), "OmniFlix.onft.v1beta1.MetadataCommitment")
+	proto.RegisterType((*RevealedONFT)(nil), "OmniFlix.onft.v1beta1.RevealedONFT")
+}
+
+func init() {
+	proto.RegisterFile("OmniFlix/onft/v1beta1/reveal.proto", fileDescriptor_3870f0e9a02f24aa)
+}
+
+var fileDescriptor_3870f0e9a02f24aa = []byte{
+	// 354 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x91, 0x3f, 0x4f, 0xc2, 0x40,
+	0x18, 0xc6, 0x7b, 0x50, 0xb5, 0x9c, 0xa8, 0xc9, 0x89, 0x49, 0xc3, 0x70, 0x6d, 0x3a, 0x75, 0xba,
+	0x06, 0xdd, 0x88, 0x0e, 0x62, 0x42, 0xe2, 0xa0, 0x24, 0x17, 0x27, 0x17, 0x52, 0xec, 0x81, 0x4d,
+	0xb8, 0xbe, 0xa4, 0x1e, 0x44, 0xbe, 0x85, 0x7e, 0x03, 0xbf, 0x8c, 0x09, 0x23, 0xa3, 0x13, 0x51,
+	0x58, 0x9c, 0xf9, 0x04, 0x86, 0x2b, 0x25, 0x92, 0xb0, 0xbd, 0x7f, 0x7e, 0xcf, 0x7b, 0xcf, 0xbd,
+	0x2f, 0xf6, 0x5a, 0x32, 0x89, 0x9b, 0xfd, 0xf8, 0x35, 0x80, 0xa4, 0xab, 0x82, 0x51, 0xad, 0x23,
+	0x54, 0x58, 0x0b, 0x52, 0x31, 0x12, 0x61, 0x9f, 0x0d, 0x52, 0x50, 0x40, 0xce, 0x72, 0x86, 0xad,
+	0x18, 0xb6, 0x66, 0xaa, 0x95, 0x1e, 0xf4, 0x40, 0x13, 0xc1, 0x2a, 0xca, 0xe0, 0xaa, 0xbb, 0x7b,
+	0xa0, 0x56, 0x6a, 0xc2, 0xfb, 0x44, 0x98, 0xdc, 0x09, 0x15, 0x46, 0xa1, 0x0a, 0x6f, 0x40, 0xca,
+	0x58, 0x49, 0x91, 0x28, 0xc2, 0xb0, 0x15, 0x89, 0x04, 0x64, 0x3b, 0x8e, 0x6c, 0xe4, 0x22, 0xbf,
+	0xd4, 0x38, 0x5d, 0xce, 0x9c, 0x93, 0x71, 0x28, 0xfb, 0x75, 0x2f, 0xef, 0x78, 0xfc, 0x40, 0x87,
+	0xb7, 0x11, 0xb9, 0xc2, 0x47, 0x72, 0x3d, 0xa5, 0x9d, 0x02, 0x28, 0xbb, 0xa0, 0x45, 0xf6, 0x72,
+	0xe6, 0x54, 0x32, 0xd1, 0x56, 0xdb, 0xe3, 0xe5, 0x3c, 0xe7, 0x00, 0x8a, 0x50, 0x8c, 0x87, 0x49,
+	0xf6, 0x4d, 0x11, 0xd9, 0x45, 0x17, 0xf9, 0x26, 0xff, 0x57, 0x21, 0x55, 0x6c, 0x6d, 0xba, 0xa6,
+	0x8b, 0x7c, 0x8b, 0x6f, 0xf2, 0xba, 0xf9, 0xfb, 0xe1, 0x20, 0xef, 0x1d, 0xe1, 0x32, 0x5f, 0x97,
+	0x5a, 0xf7, 0xcd, 0x07, 0x72, 0x8c, 0x0b, 0xb9, 0x77, 0x5e, 0x88, 0x23, 0x72, 0x8d, 0xad, 0xfc,
+	0x49, 0x6d, 0xee, 0xf0, 0xdc, 0x61, 0x3b, 0x57, 0xc9, 0xf2, 0x75, 0x34, 0xcc, 0xc9, 0xcc, 0x31,
+	0xf8, 0x46, 0x46, 0x08, 0x36, 0xb5, 0xbc, 0xa8, 0x87, 0xea, 0x98, 0x54, 0xf0, 0xde, 0x20, 0x05,
+	0xe8, 0xda, 0xa6, 0x5b, 0xf4, 0x4b, 0x3c, 0x4b, 0x32, 0x4f, 0x8d, 0xcb, 0xc9, 0x0f, 0x35, 0x26,
+	0x73, 0x8a, 0xa6, 0x73, 0x8a, 0xbe, 0xe7, 0x14, 0xbd, 0x2d, 0xa8, 0x31, 0x5d, 0x50, 0xe3, 0x6b,
+	0x41, 0x8d, 0x47, 0xda, 0x8b, 0xd5, 0xf3, 0xb0, 0xc3, 0x9e, 0x40, 0x06, 0xdb, 0x67, 0x52, 0xe3,
+	0x81, 0x78, 0xe9, 0xec, 0xeb, 0x03, 0x5d, 0xfc, 0x0d, 0x00, 0xa8, 0x6f, 0x7c, 0xab, 0x15, 0x02,
+	0x00, 0x00,
+}
+
+func (this *MetadataCommitment) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MetadataCommitment)
+	if !ok {
+		that2, ok := that.(MetadataCommitment)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.DenomId != that1.DenomId {
+		return false
+	}
+	if this.MetadataRoot != that1.MetadataRoot {
+		return false
+	}
+	if this.Unrevealed != that1.Unrevealed {
+		return false
+	}
+	if this.Revealed != that1.Revealed {
+		return false
+	}
+	return true
+}
+func (this *RevealedONFT) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RevealedONFT)
+	if !ok {
+		that2, ok := that.(RevealedONFT)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Id != that1.Id {
+		return false
+	}
+	if !this.Metadata.Equal(&that1.Metadata) {
+		return false
+	}
+	if this.Data != that1.Data {
+		return false
+	}
+	if len(this.Proof) != len(that1.Proof) {
+		return false
+	}
+	for i := range this.Proof {
+		if this.Proof[i] != that1.Proof[i] {
+			return false
+		}
+	}
+	return true
+}
+func (m *MetadataCommitment) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MetadataCommitment) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MetadataCommitment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Revealed {
+		i--
+		if m.Revealed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Unrevealed != 0 {
+		i = encodeVarintReveal(dAtA, i, uint64(m.Unrevealed))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.MetadataRoot) > 0 {
+		i -= len(m.MetadataRoot)
+		copy(dAtA[i:], m.MetadataRoot)
+		i = encodeVarintReveal(dAtA, i, uint64(len(m.MetadataRoot)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DenomId) > 0 {
+		i -= len(m.DenomId)
+		copy(dAtA[i:], m.DenomId)
+		i = encodeVarintReveal(dAtA, i, uint64(len(m.DenomId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RevealedONFT) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RevealedONFT) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RevealedONFT) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Proof) > 0 {
+		for iNdEx := len(m.Proof) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Proof[iNdEx])
+			copy(dAtA[i:], m.Proof[iNdEx])
+			i = encodeVarintReveal(dAtA, i, uint64(len(m.Proof[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintReveal(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintReveal(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintReveal(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintReveal(dAtA []byte, offset int, v uint64) int {
+	offset -= sovReveal(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MetadataCommitment) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DenomId)
+	if l > 0 {
+		n += 1 + l + sovReveal(uint64(l))
+	}
+	l = len(m.MetadataRoot)
+	if l > 0 {
+		n += 1 + l + sovReveal(uint64(l))
+	}
+	if m.Unrevealed != 0 {
+		n += 1 + sovReveal(uint64(m.Unrevealed))
+	}
+	if m.Revealed {
+		n += 2
+	}
+	return n
+}
+
+func (m *RevealedONFT) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovReveal(uint64(l))
+	}
+	l = m.Metadata.Size()
+	n += 1 + l + sovReveal(uint64(l))
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovReveal(uint64(l))
+	}
+	if len(m.Proof) > 0 {
+		for _, s := range m.Proof {
+			l = len(s)
+			n += 1 + l + sovReveal(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovReveal(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozReveal(x uint64) (n int) {
+	return sovReveal(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MetadataCommitment) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowReveal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MetadataCommitment: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MetadataCommitment: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReveal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReveal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReveal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MetadataRoot", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReveal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReveal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReveal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MetadataRoot = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unrevealed", wireType)
+			}
+			m.Unrevealed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReveal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Unrevealed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revealed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReveal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Revealed = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipReveal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthReveal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RevealedONFT) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowReveal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RevealedONFT: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RevealedONFT: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReveal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReveal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReveal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReveal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthReveal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthReveal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReveal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReveal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReveal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReveal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReveal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReveal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proof = append(m.Proof, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipReveal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthReveal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipReveal(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowReveal
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowReveal
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowReveal
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthReveal
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupReveal
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthReveal
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthReveal        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowReveal          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupReveal = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,101 @@
+package types
+
+import (
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// MaxRenewalPeriods is the max number of periods paid by a single renewal
+	MaxRenewalPeriods = 120
+	// MaxSubscriptionPeriod is the max length of a period, it keeps the
+	// extension of a renewal for MaxRenewalPeriods within a time.Duration
+	MaxSubscriptionPeriod = 2 * 365 * 24 * time.Hour
+)
+
+func NewSubscriptionConfig(period time.Duration, price sdk.Coin) *SubscriptionConfig {
+	return &SubscriptionConfig{
+		Period: period,
+		Price:  price,
+	}
+}
+
+// Validate checks the period and price of a subscription
+func (c SubscriptionConfig) Validate() error {
+	if c.Period <= 0 {
+		return errorsmod.Wrapf(ErrInvalidSubscription, "period must be positive, got %s", c.Period)
+	}
+	if c.Period > MaxSubscriptionPeriod {
+		return errorsmod.Wrapf(ErrInvalidSubscription, "period must be at most %s, got %s", MaxSubscriptionPeriod, c.Period)
+	}
+	if !c.Price.IsValid() || !c.Price.IsPositive() {
+		return errorsmod.Wrapf(ErrInvalidSubscription, "invalid price %s", c.Price)
+	}
+	return nil
+}
+
+// RenewalPrice returns the price of renewing for the number of periods
+func (c SubscriptionConfig) RenewalPrice(periods uint64) sdk.Coin {
+	return sdk.NewCoin(c.Price.Denom, c.Price.Amount.MulRaw(int64(periods)))
+}
+
+// Extend returns the expiry after renewing from expiresAt for the number of
+// periods. Lapsed subscriptions renew from now.
+func (c SubscriptionConfig) Extend(expiresAt, now time.Time, periods uint64) time.Time {
+	if expiresAt.Before(now) {
+		expiresAt = now
+	}
+	return expiresAt.Add(c.Period * time.Duration(periods))
+}
+
+func ValidateRenewalPeriods(periods uint64) error {
+	if periods == 0 || periods > MaxRenewalPeriods {
+		return errorsmod.Wrapf(ErrInvalidSubscription, "periods must be between 1 and %d", MaxRenewalPeriods)
+	}
+	return nil
+}
+
+// IsExpired returns true if the onft has an expiry that is not after blockTime
+func (onft ONFT) IsExpired(blockTime time.Time) bool {
+	return onft.ExpiresAt != nil && !blockTime.Before(*onft.ExpiresAt)
+}
+
+// NewRenewal creates the record of a renewal payment
+func NewRenewal(denomID, onftID string, payer sdk.AccAddress, periods uint64, amount sdk.Coin,
+	renewedAt, expiresAt time.Time,
+) Renewal {
+	return Renewal{
+		DenomId:   denomID,
+		OnftId:    onftID,
+		Payer:     payer.String(),
+		Periods:   periods,
+		Amount:    amount,
+		RenewedAt: renewedAt,
+		ExpiresAt: expiresAt,
+	}
+}
+
+// Validate checks the stateless consistency of a stored renewal
+func (r Renewal) Validate() error {
+	if err := ValidateDenomID(r.DenomId); err != nil {
+		return err
+	}
+	if err := ValidateONFTID(r.OnftId); err != nil {
+		return err
+	}
+	if _, err := sdk.AccAddressFromBech32(r.Payer); err != nil {
+		return err
+	}
+	if err := ValidateRenewalPeriods(r.Periods); err != nil {
+		return err
+	}
+	if !r.Amount.IsValid() {
+		return errorsmod.Wrapf(ErrInvalidSubscription, "invalid amount %s", r.Amount)
+	}
+	if !r.ExpiresAt.After(r.RenewedAt) {
+		return errorsmod.Wrapf(ErrInvalidSubscription, "renewal of %s must expire after it is paid", r.OnftId)
+	}
+	return nil
+}
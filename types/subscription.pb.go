@@ -0,0 +1,867 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: OmniFlix/onft/v1beta1/subscription.proto
+
+package types
+
+import (
+	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// SubscriptionConfig makes the oNFTs of a denom expire after period, renewed
+// by paying price to the denom creator
+type SubscriptionConfig struct {
+	Period time.Duration `protobuf:"bytes,1,opt,name=period,proto3,stdduration" json:"period"`
+	Price  types.Coin    `protobuf:"bytes,2,opt,name=price,proto3" json:"price"`
+}
+
+func (m *SubscriptionConfig) Reset()         { *m = SubscriptionConfig{} }
+func (m *SubscriptionConfig) String() string { return proto.CompactTextString(m) }
+func (*SubscriptionConfig) ProtoMessage()    {}
+func (*SubscriptionConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6f8ff4ba84406c71, []int{0}
+}
+func (m *SubscriptionConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SubscriptionConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SubscriptionConfig.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SubscriptionConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubscriptionConfig.Merge(m, src)
+}
+func (m *SubscriptionConfig) XXX_Size() int {
+	return m.Size()
+}
+func (m *SubscriptionConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubscriptionConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubscriptionConfig proto.InternalMessageInfo
+
+// Renewal is a payment extending the expiry of a subscription oNFT
+type Renewal struct {
+	DenomId   string     `protobuf:"bytes,1,opt,name=denom_id,json=denomId,proto3" json:"denom_id,omitempty" yaml:"denom_id"`
+	OnftId    string     `protobuf:"bytes,2,opt,name=onft_id,json=onftId,proto3" json:"onft_id,omitempty" yaml:"onft_id"`
+	Payer     string     `protobuf:"bytes,3,opt,name=payer,proto3" json:"payer,omitempty"`
+	Periods   uint64     `protobuf:"varint,4,opt,name=periods,proto3" json:"periods,omitempty"`
+	Amount    types.Coin `protobuf:"bytes,5,opt,name=amount,proto3" json:"amount"`
+	RenewedAt time.Time  `protobuf:"bytes,6,opt,name=renewed_at,json=renewedAt,proto3,stdtime" json:"renewed_at" yaml:"renewed_at"`
+	ExpiresAt time.Time  `protobuf:"bytes,7,opt,name=expires_at,json=expiresAt,proto3,stdtime" json:"expires_at" yaml:"expires_at"`
+}
+
+func (m *Renewal) Reset()         { *m = Renewal{} }
+func (m *Renewal) String() string { return proto.CompactTextString(m) }
+func (*Renewal) ProtoMessage()    {}
+func (*Renewal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6f8ff4ba84406c71, []int{1}
+}
+func (m *Renewal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Renewal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Renewal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Renewal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Renewal.Merge(m, src)
+}
+func (m *Renewal) XXX_Size() int {
+	return m.Size()
+}
+func (m *Renewal) XXX_DiscardUnknown() {
+	xxx_messageInfo_Renewal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Renewal proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*SubscriptionConfig)(nil), "OmniFlix.onft.v1beta1.SubscriptionConfig")
+	proto.RegisterType((*Renewal)(nil), "OmniFlix.onft.v1beta1.Renewal")
+}
+
+func init() {
+	proto.RegisterFile("OmniFlix/onft/v1beta1/subscription.proto", fileDescriptor_6f8ff4ba84406c71)
+}
+
+var fileDescriptor_6f8ff4ba84406c71 = []byte{
+	// 465 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0x3f, 0x6f, 0xd4, 0x30,
+	0x18, 0xc6, 0xe3, 0xf6, 0x2e, 0x69, 0x8d, 0x04, 0xc2, 0x14, 0x29, 0x9c, 0x84, 0x53, 0x65, 0x3a,
+	0x09, 0xc9, 0x51, 0x41, 0x08, 0xa9, 0xb0, 0x34, 0x45, 0x48, 0x9d, 0x90, 0x02, 0x03, 0x62, 0xa9,
+	0x9c, 0xc4, 0x17, 0x2c, 0x5d, 0xe2, 0x28, 0x76, 0xa0, 0xf7, 0x11, 0x58, 0x50, 0x47, 0x46, 0x3e,
+	0xce, 0x8d, 0x1d, 0x99, 0x02, 0xdc, 0x2d, 0xcc, 0xf7, 0x09, 0x90, 0x63, 0x87, 0xe3, 0xcf, 0x02,
+	0x9b, 0xdf, 0xbc, 0xbf, 0xe7, 0xf1, 0xf3, 0xbe, 0x0e, 0x9c, 0x3e, 0x2f, 0x2b, 0xfe, 0x6c, 0xce,
+	0x2f, 0x22, 0x51, 0xcd, 0x54, 0xf4, 0xf6, 0x28, 0x65, 0x8a, 0x1e, 0x45, 0xb2, 0x4d, 0x65, 0xd6,
+	0xf0, 0x5a, 0x71, 0x51, 0x91, 0xba, 0x11, 0x4a, 0xa0, 0xdb, 0x03, 0x49, 0x34, 0x49, 0x2c, 0x39,
+	0x39, 0x28, 0x44, 0x21, 0x7a, 0x22, 0xd2, 0x27, 0x03, 0x4f, 0x70, 0x21, 0x44, 0x31, 0x67, 0x51,
+	0x5f, 0xa5, 0xed, 0x2c, 0xca, 0xdb, 0x86, 0x6e, 0xcd, 0x26, 0xc1, 0x9f, 0x7d, 0xc5, 0x4b, 0x26,
+	0x15, 0x2d, 0xeb, 0xc1, 0x20, 0x13, 0xb2, 0x14, 0x32, 0x4a, 0xa9, 0x64, 0x3f, 0x53, 0x65, 0x82,
+	0x5b, 0x83, 0xf0, 0x03, 0x80, 0xe8, 0xc5, 0x2f, 0x21, 0x4f, 0x45, 0x35, 0xe3, 0x05, 0x7a, 0x0c,
+	0xdd, 0x9a, 0x35, 0x5c, 0xe4, 0x3e, 0x38, 0x04, 0xd3, 0x6b, 0xf7, 0xef, 0x10, 0x73, 0x11, 0x19,
+	0x2e, 0x22, 0x4f, 0x6d, 0x90, 0x78, 0x6f, 0xd9, 0x05, 0xce, 0xc7, 0x2f, 0x01, 0x48, 0xac, 0x04,
+	0x3d, 0x84, 0xe3, 0xba, 0xe1, 0x19, 0xf3, 0x77, 0xac, 0xd6, 0x64, 0x20, 0x3a, 0xc3, 0x30, 0x2f,
+	0x39, 0x15, 0xbc, 0x8a, 0x47, 0x5a, 0x9b, 0x18, 0xfa, 0x78, 0xf4, 0xfd, 0x53, 0x00, 0xc2, 0xf7,
+	0xbb, 0xd0, 0x4b, 0x58, 0xc5, 0xde, 0xd1, 0x39, 0x22, 0x70, 0x2f, 0x67, 0x95, 0x28, 0xcf, 0xb9,
+	0xc9, 0xb1, 0x1f, 0xdf, 0xda, 0x74, 0xc1, 0x8d, 0x05, 0x2d, 0xe7, 0xc7, 0xe1, 0xd0, 0x09, 0x13,
+	0xaf, 0x3f, 0x9e, 0xe5, 0xe8, 0x1e, 0xf4, 0xf4, 0x4e, 0x35, 0xbe, 0xd3, 0xe3, 0x68, 0xd3, 0x05,
+	0xd7, 0x0d, 0x6e, 0x1b, 0x61, 0xe2, 0xea, 0xd3, 0x59, 0x8e, 0x0e, 0xe0, 0xb8, 0xa6, 0x0b, 0xd6,
+	0xf8, 0xbb, 0x1a, 0x4d, 0x4c, 0x81, 0x7c, 0xe8, 0x99, 0x29, 0xa4, 0x3f, 0x3a, 0x04, 0xd3, 0x51,
+	0x32, 0x94, 0xe8, 0x11, 0x74, 0x69, 0x29, 0xda, 0x4a, 0xf9, 0xe3, 0x7f, 0x1b, 0xcb, 0xe2, 0xe8,
+	0x15, 0x84, 0x8d, 0x1e, 0x88, 0xe5, 0xe7, 0x54, 0xf9, 0x6e, 0x2f, 0x9e, 0xfc, 0xb5, 0xcf, 0x97,
+	0xc3, 0xc3, 0xc5, 0x77, 0xb5, 0x7a, 0xd3, 0x05, 0x37, 0x4d, 0xf0, 0xad, 0x36, 0xbc, 0xd4, 0x5b,
+	0xde, 0xb7, 0x1f, 0x4e, 0x7a, 0x67, 0x76, 0x51, 0xf3, 0x86, 0x49, 0xed, 0xec, 0xfd, 0xaf, 0xf3,
+	0x56, 0x6b, 0x9d, 0xed, 0x87, 0x13, 0x65, 0xde, 0x22, 0x7e, 0xb2, 0xfc, 0x86, 0x9d, 0xe5, 0x0a,
+	0x83, 0xab, 0x15, 0x06, 0x5f, 0x57, 0x18, 0x5c, 0xae, 0xb1, 0x73, 0xb5, 0xc6, 0xce, 0xe7, 0x35,
+	0x76, 0x5e, 0xe3, 0x82, 0xab, 0x37, 0x6d, 0x4a, 0x32, 0x51, 0x46, 0xbf, 0xff, 0xfd, 0x6a, 0x51,
+	0x33, 0x99, 0xba, 0x7d, 0x82, 0x07, 0x3f, 0x06, 0x00, 0xe3, 0xe1, 0xbf, 0x37, 0x1b, 0x03, 0x00,
+	0x00,
+}
+
+func (this *SubscriptionConfig) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SubscriptionConfig)
+	if !ok {
+		that2, ok := that.(SubscriptionConfig)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Period != that1.Period {
+		return false
+	}
+	if !this.Price.Equal(&that1.Price) {
+		return false
+	}
+	return true
+}
+func (this *Renewal) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Renewal)
+	if !ok {
+		that2, ok := that.(Renewal)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.DenomId != that1.DenomId {
+		return false
+	}
+	if this.OnftId != that1.OnftId {
+		return false
+	}
+	if this.Payer != that1.Payer {
+		return false
+	}
+	if this.Periods != that1.Periods {
+		return false
+	}
+	if !this.Amount.Equal(&that1.Amount) {
+		return false
+	}
+	if !this.RenewedAt.Equal(that1.RenewedAt) {
+		return false
+	}
+	if !this.ExpiresAt.Equal(that1.ExpiresAt) {
+		return false
+	}
+	return true
+}
+func (m *SubscriptionConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SubscriptionConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SubscriptionConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Price.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintSubscription(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	n2, err2 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Period, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Period):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintSubscription(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *Renewal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Renewal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Renewal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.ExpiresAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ExpiresAt):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintSubscription(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x3a
+	n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.RenewedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.RenewedAt):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintSubscription(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x32
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintSubscription(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.Periods != 0 {
+		i = encodeVarintSubscription(dAtA, i, uint64(m.Periods))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Payer) > 0 {
+		i -= len(m.Payer)
+		copy(dAtA[i:], m.Payer)
+		i = encodeVarintSubscription(dAtA, i, uint64(len(m.Payer)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.OnftId) > 0 {
+		i -= len(m.OnftId)
+		copy(dAtA[i:], m.OnftId)
+		i = encodeVarintSubscription(dAtA, i, uint64(len(m.OnftId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DenomId) > 0 {
+		i -= len(m.DenomId)
+		copy(dAtA[i:], m.DenomId)
+		i = encodeVarintSubscription(dAtA, i, uint64(len(m.DenomId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintSubscription(dAtA []byte, offset int, v uint64) int {
+	offset -= sovSubscription(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *SubscriptionConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Period)
+	n += 1 + l + sovSubscription(uint64(l))
+	l = m.Price.Size()
+	n += 1 + l + sovSubscription(uint64(l))
+	return n
+}
+
+func (m *Renewal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DenomId)
+	if l > 0 {
+		n += 1 + l + sovSubscription(uint64(l))
+	}
+	l = len(m.OnftId)
+	if l > 0 {
+		n += 1 + l + sovSubscription(uint64(l))
+	}
+	l = len(m.Payer)
+	if l > 0 {
+		n += 1 + l + sovSubscription(uint64(l))
+	}
+	if m.Periods != 0 {
+		n += 1 + sovSubscription(uint64(m.Periods))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovSubscription(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.RenewedAt)
+	n += 1 + l + sovSubscription(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ExpiresAt)
+	n += 1 + l + sovSubscription(uint64(l))
+	return n
+}
+
+func sovSubscription(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozSubscription(x uint64) (n int) {
+	return sovSubscription(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *SubscriptionConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSubscription
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SubscriptionConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SubscriptionConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubscription
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSubscription
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubscription
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Period, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubscription
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSubscription
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubscription
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSubscription(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSubscription
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Renewal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSubscription
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Renewal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Renewal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubscription
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubscription
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubscription
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OnftId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubscription
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubscription
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubscription
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OnftId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubscription
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubscription
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubscription
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Periods", wireType)
+			}
+			m.Periods = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubscription
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Periods |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubscription
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSubscription
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubscription
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RenewedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubscription
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSubscription
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubscription
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.RenewedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubscription
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSubscription
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubscription
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.ExpiresAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSubscription(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSubscription
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipSubscription(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowSubscription
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSubscription
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSubscription
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthSubscription
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupSubscription
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthSubscription
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthSubscription        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowSubscription          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupSubscription = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,55 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/OmniFlix/onft/types"
+)
+
+func TestSubscriptionConfigValidate(t *testing.T) {
+	price := sdk.NewInt64Coin("uflix", 100)
+
+	tests := []struct {
+		name   string
+		config types.SubscriptionConfig
+		valid  bool
+	}{
+		{"valid", *types.NewSubscriptionConfig(30*24*time.Hour, price), true},
+		{"max period", *types.NewSubscriptionConfig(types.MaxSubscriptionPeriod, price), true},
+		{"zero period", *types.NewSubscriptionConfig(0, price), false},
+		{"period above max", *types.NewSubscriptionConfig(types.MaxSubscriptionPeriod+time.Nanosecond, price), false},
+		{"zero price", *types.NewSubscriptionConfig(time.Hour, sdk.NewInt64Coin("uflix", 0)), false},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.config.Validate()
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, types.ErrInvalidSubscription)
+			}
+		})
+	}
+}
+
+func TestSubscriptionConfigExtend(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	config := types.NewSubscriptionConfig(types.MaxSubscriptionPeriod, sdk.NewInt64Coin("uflix", 100))
+
+	// the longest renewal of the longest period does not overflow
+	expiresAt := config.Extend(now, now, types.MaxRenewalPeriods)
+	require.Equal(t, now.Add(types.MaxRenewalPeriods*types.MaxSubscriptionPeriod), expiresAt)
+	require.True(t, expiresAt.After(now))
+
+	// a lapsed subscription renews from now
+	require.Equal(t, now.Add(config.Period), config.Extend(now.Add(-time.Hour), now, 1))
+	require.Equal(t, now.Add(time.Hour+config.Period), config.Extend(now.Add(time.Hour), now, 1))
+
+	require.Error(t, types.ValidateRenewalPeriods(0))
+	require.Error(t, types.ValidateRenewalPeriods(types.MaxRenewalPeriods+1))
+	require.NoError(t, types.ValidateRenewalPeriods(types.MaxRenewalPeriods))
+}
@@ -0,0 +1,97 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MaxSwapONFTs is the maximum number of oNFTs on each side of a swap
+const MaxSwapONFTs = 20
+
+func NewONFTRef(denomID, onftID string) ONFTRef {
+	return ONFTRef{DenomId: denomID, OnftId: onftID}
+}
+
+// ParseONFTRef parses an oNFT reference of the form denom-id/onft-id
+func ParseONFTRef(ref string) (ONFTRef, error) {
+	parts := strings.Split(strings.TrimSpace(ref), "/")
+	if len(parts) != 2 {
+		return ONFTRef{}, fmt.Errorf("invalid onft reference %s, expected denom-id/onft-id", ref)
+	}
+	return NewONFTRef(strings.ToLower(parts[0]), strings.ToLower(parts[1])), nil
+}
+
+func (r ONFTRef) String() string {
+	return r.DenomId + "/" + r.OnftId
+}
+
+func (r ONFTRef) Validate() error {
+	if err := ValidateDenomID(r.DenomId); err != nil {
+		return err
+	}
+	return ValidateONFTID(r.OnftId)
+}
+
+// ValidateONFTRefs validates a list of oNFT references without duplicates
+func ValidateONFTRefs(refs []ONFTRef) error {
+	if len(refs) > MaxSwapONFTs {
+		return errorsmod.Wrapf(ErrInvalidSwap, "at most %d onfts are allowed", MaxSwapONFTs)
+	}
+	seen := make(map[string]bool)
+	for _, ref := range refs {
+		if err := ref.Validate(); err != nil {
+			return err
+		}
+		if seen[ref.String()] {
+			return errorsmod.Wrapf(ErrInvalidSwap, "duplicate onft %s", ref)
+		}
+		seen[ref.String()] = true
+	}
+	return nil
+}
+
+// ValidateSwapAssets validates both sides of a swap. Each side must hold at
+// least one oNFT or coin.
+func ValidateSwapAssets(offeredONFTs []ONFTRef, offeredCoins sdk.Coins, requestedONFTs []ONFTRef, requestedCoins sdk.Coins) error {
+	if err := ValidateONFTRefs(offeredONFTs); err != nil {
+		return err
+	}
+	if err := ValidateONFTRefs(requestedONFTs); err != nil {
+		return err
+	}
+	if err := offeredCoins.Validate(); err != nil {
+		return errorsmod.Wrapf(ErrInvalidSwap, "invalid offered coins; %s", err)
+	}
+	if err := requestedCoins.Validate(); err != nil {
+		return errorsmod.Wrapf(ErrInvalidSwap, "invalid requested coins; %s", err)
+	}
+	if len(offeredONFTs) == 0 && offeredCoins.IsZero() {
+		return errorsmod.Wrap(ErrInvalidSwap, "nothing offered")
+	}
+	if len(requestedONFTs) == 0 && requestedCoins.IsZero() {
+		return errorsmod.Wrap(ErrInvalidSwap, "nothing requested")
+	}
+	return nil
+}
+
+// IsExpired returns true if the swap has an expiry that has passed
+func (s Swap) IsExpired(blockTime time.Time) bool {
+	return !s.Expiry.IsZero() && !blockTime.Before(s.Expiry)
+}
+
+// Validate checks the stateless consistency of a stored swap
+func (s Swap) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(s.Creator); err != nil {
+		return err
+	}
+	if len(s.Counterparty) > 0 {
+		if _, err := sdk.AccAddressFromBech32(s.Counterparty); err != nil {
+			return err
+		}
+	}
+	return ValidateSwapAssets(s.OfferedOnfts, s.OfferedCoins, s.RequestedOnfts, s.RequestedCoins)
+}
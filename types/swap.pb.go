@@ -0,0 +1,974 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: OmniFlix/onft/v1beta1/swap.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// ONFTRef references an oNFT of a denom
+type ONFTRef struct {
+	DenomId string `protobuf:"bytes,1,opt,name=denom_id,json=denomId,proto3" json:"denom_id,omitempty" yaml:"denom_id"`
+	OnftId  string `protobuf:"bytes,2,opt,name=onft_id,json=onftId,proto3" json:"onft_id,omitempty" yaml:"onft_id"`
+}
+
+func (m *ONFTRef) Reset()      { *m = ONFTRef{} }
+func (*ONFTRef) ProtoMessage() {}
+func (*ONFTRef) Descriptor() ([]byte, []int) {
+	return fileDescriptor_432befcb32c14ad7, []int{0}
+}
+func (m *ONFTRef) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ONFTRef) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ONFTRef.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ONFTRef) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ONFTRef.Merge(m, src)
+}
+func (m *ONFTRef) XXX_Size() int {
+	return m.Size()
+}
+func (m *ONFTRef) XXX_DiscardUnknown() {
+	xxx_messageInfo_ONFTRef.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ONFTRef proto.InternalMessageInfo
+
+// Swap is an escrowed offer of oNFTs and coins in exchange for the requested
+// oNFTs and coins. Only the counterparty can accept it when one is set.
+type Swap struct {
+	Id             uint64                                   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Creator        string                                   `protobuf:"bytes,2,opt,name=creator,proto3" json:"creator,omitempty"`
+	OfferedOnfts   []ONFTRef                                `protobuf:"bytes,3,rep,name=offered_onfts,json=offeredOnfts,proto3" json:"offered_onfts" yaml:"offered_onfts"`
+	OfferedCoins   github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=offered_coins,json=offeredCoins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"offered_coins" yaml:"offered_coins"`
+	RequestedOnfts []ONFTRef                                `protobuf:"bytes,5,rep,name=requested_onfts,json=requestedOnfts,proto3" json:"requested_onfts" yaml:"requested_onfts"`
+	RequestedCoins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,6,rep,name=requested_coins,json=requestedCoins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"requested_coins" yaml:"requested_coins"`
+	Counterparty   string                                   `protobuf:"bytes,7,opt,name=counterparty,proto3" json:"counterparty,omitempty"`
+	Expiry         time.Time                                `protobuf:"bytes,8,opt,name=expiry,proto3,stdtime" json:"expiry"`
+}
+
+func (m *Swap) Reset()         { *m = Swap{} }
+func (m *Swap) String() string { return proto.CompactTextString(m) }
+func (*Swap) ProtoMessage()    {}
+func (*Swap) Descriptor() ([]byte, []int) {
+	return fileDescriptor_432befcb32c14ad7, []int{1}
+}
+func (m *Swap) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Swap) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Swap.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Swap) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Swap.Merge(m, src)
+}
+func (m *Swap) XXX_Size() int {
+	return m.Size()
+}
+func (m *Swap) XXX_DiscardUnknown() {
+	xxx_messageInfo_Swap.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Swap proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*ONFTRef)(nil), "OmniFlix.onft.v1beta1.ONFTRef")
+	proto.RegisterType((*Swap)(nil), "OmniFlix.onft.v1beta1.Swap")
+}
+
+func init() { proto.RegisterFile("OmniFlix/onft/v1beta1/swap.proto", fileDescriptor_432befcb32c14ad7) }
+
+var fileDescriptor_432befcb32c14ad7 = []byte{
+	// 516 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x53, 0x3d, 0x6f, 0xd3, 0x40,
+	0x18, 0xf6, 0xa5, 0x69, 0x12, 0xae, 0x25, 0x95, 0x8e, 0x82, 0x4c, 0x84, 0xce, 0x91, 0xa7, 0x48,
+	0x88, 0xb3, 0x5a, 0xb6, 0xa8, 0x53, 0x90, 0x2a, 0xca, 0x40, 0x24, 0xd3, 0x89, 0xa5, 0x72, 0xe2,
+	0x8b, 0xb1, 0x88, 0x7d, 0xc6, 0x77, 0xa1, 0xcd, 0xc6, 0xc8, 0x84, 0x3a, 0x32, 0x76, 0xe6, 0x97,
+	0x64, 0xec, 0xc8, 0x94, 0x42, 0xb2, 0x30, 0xe7, 0x17, 0xa0, 0xfb, 0xb0, 0x9b, 0xa2, 0x22, 0x50,
+	0x27, 0xdf, 0x7b, 0xf7, 0xdc, 0xf3, 0xf1, 0xfa, 0x5e, 0xd8, 0xee, 0x27, 0x69, 0x7c, 0x38, 0x8e,
+	0xcf, 0x3c, 0x96, 0x8e, 0x84, 0xf7, 0x71, 0x6f, 0x40, 0x45, 0xb0, 0xe7, 0xf1, 0xd3, 0x20, 0x23,
+	0x59, 0xce, 0x04, 0x43, 0x0f, 0x0b, 0x04, 0x91, 0x08, 0x62, 0x10, 0xad, 0xdd, 0x88, 0x45, 0x4c,
+	0x21, 0x3c, 0xb9, 0xd2, 0xe0, 0x96, 0x13, 0x31, 0x16, 0x8d, 0xa9, 0xa7, 0xaa, 0xc1, 0x64, 0xe4,
+	0x89, 0x38, 0xa1, 0x5c, 0x04, 0x89, 0x61, 0x6b, 0xe1, 0x21, 0xe3, 0x09, 0xe3, 0xde, 0x20, 0xe0,
+	0xb4, 0x54, 0x1b, 0xb2, 0x38, 0xd5, 0xe7, 0x6e, 0x06, 0xeb, 0xfd, 0xd7, 0x87, 0xc7, 0x3e, 0x1d,
+	0x21, 0x02, 0x1b, 0x21, 0x4d, 0x59, 0x72, 0x12, 0x87, 0x36, 0x68, 0x83, 0xce, 0xbd, 0xde, 0x83,
+	0xd5, 0xdc, 0xd9, 0x99, 0x06, 0xc9, 0xb8, 0xeb, 0x16, 0x27, 0xae, 0x5f, 0x57, 0xcb, 0xa3, 0x10,
+	0x3d, 0x85, 0x75, 0xe9, 0x50, 0xc2, 0x2b, 0x0a, 0x8e, 0x56, 0x73, 0xa7, 0xa9, 0xe1, 0xe6, 0xc0,
+	0xf5, 0x6b, 0x72, 0x75, 0x14, 0x76, 0x1b, 0x5f, 0x2f, 0x1c, 0xeb, 0xd7, 0x85, 0x03, 0xdc, 0x4f,
+	0x9b, 0xb0, 0xfa, 0xe6, 0x34, 0xc8, 0x50, 0x13, 0x56, 0x8c, 0x52, 0xd5, 0xaf, 0xc4, 0x21, 0xb2,
+	0x61, 0x7d, 0x98, 0xd3, 0x40, 0xb0, 0x5c, 0xf3, 0xf9, 0x45, 0x89, 0x02, 0x78, 0x9f, 0x8d, 0x46,
+	0x34, 0xa7, 0xe1, 0x89, 0xa4, 0xe3, 0xf6, 0x46, 0x7b, 0xa3, 0xb3, 0xb5, 0x8f, 0xc9, 0xad, 0xad,
+	0x22, 0x26, 0x50, 0xef, 0xc9, 0x6c, 0xee, 0x58, 0xab, 0xb9, 0xb3, 0x6b, 0x3c, 0xad, 0x53, 0xb8,
+	0xfe, 0xb6, 0xa9, 0xfb, 0xb2, 0x44, 0x9f, 0xc1, 0xb5, 0x86, 0x6c, 0x0f, 0xb7, 0xab, 0x4a, 0xe3,
+	0x31, 0xd1, 0x0d, 0x24, 0xb2, 0x81, 0xa5, 0xc2, 0x0b, 0x16, 0xa7, 0xbd, 0x97, 0xb7, 0xd3, 0xab,
+	0xdb, 0xee, 0xb7, 0x2b, 0xa7, 0x13, 0xc5, 0xe2, 0xdd, 0x64, 0x40, 0x86, 0x2c, 0xf1, 0xcc, 0x5f,
+	0xd0, 0x9f, 0x67, 0x3c, 0x7c, 0xef, 0x89, 0x69, 0x46, 0xb9, 0x22, 0xe2, 0xa5, 0x15, 0x55, 0xa1,
+	0x08, 0xee, 0xe4, 0xf4, 0xc3, 0x84, 0x72, 0x51, 0xe6, 0xdd, 0xfc, 0xaf, 0xbc, 0xd8, 0x18, 0x7a,
+	0xa4, 0x0d, 0xfd, 0x41, 0xe2, 0xfa, 0xcd, 0x72, 0x47, 0x67, 0xfe, 0x02, 0xd6, 0x95, 0x74, 0xea,
+	0xda, 0xbf, 0x52, 0xbf, 0xfa, 0x9b, 0xc8, 0x1d, 0x72, 0x5f, 0x1b, 0xd2, 0xc9, 0x5d, 0xb8, 0x3d,
+	0x64, 0x93, 0x54, 0xd0, 0x3c, 0x0b, 0x72, 0x31, 0xb5, 0xeb, 0xea, 0x19, 0xdc, 0xd8, 0x43, 0x07,
+	0xb0, 0x46, 0xcf, 0xb2, 0x38, 0x9f, 0xda, 0x8d, 0x36, 0xe8, 0x6c, 0xed, 0xb7, 0x88, 0x1e, 0x01,
+	0x52, 0x8c, 0x00, 0x39, 0x2e, 0x46, 0xa0, 0xd7, 0x90, 0x5e, 0xcf, 0xaf, 0x1c, 0xe0, 0x9b, 0x3b,
+	0xdd, 0xaa, 0x7c, 0x82, 0xbd, 0x83, 0xd9, 0x4f, 0x6c, 0xcd, 0x16, 0x18, 0x5c, 0x2e, 0x30, 0xf8,
+	0xb1, 0xc0, 0xe0, 0x7c, 0x89, 0xad, 0xcb, 0x25, 0xb6, 0xbe, 0x2f, 0xb1, 0xf5, 0x16, 0xaf, 0xf9,
+	0xbf, 0x39, 0xad, 0xca, 0xfb, 0xa0, 0xa6, 0x94, 0x9e, 0xff, 0x1e, 0x00, 0x7a, 0x04, 0x0b, 0x78,
+	0xcb, 0x03, 0x00, 0x00,
+}
+
+func (this *ONFTRef) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ONFTRef)
+	if !ok {
+		that2, ok := that.(ONFTRef)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.DenomId != that1.DenomId {
+		return false
+	}
+	if this.OnftId != that1.OnftId {
+		return false
+	}
+	return true
+}
+func (this *Swap) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Swap)
+	if !ok {
+		that2, ok := that.(Swap)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Id != that1.Id {
+		return false
+	}
+	if this.Creator != that1.Creator {
+		return false
+	}
+	if len(this.OfferedOnfts) != len(that1.OfferedOnfts) {
+		return false
+	}
+	for i := range this.OfferedOnfts {
+		if !this.OfferedOnfts[i].Equal(&that1.OfferedOnfts[i]) {
+			return false
+		}
+	}
+	if len(this.OfferedCoins) != len(that1.OfferedCoins) {
+		return false
+	}
+	for i := range this.OfferedCoins {
+		if !this.OfferedCoins[i].Equal(&that1.OfferedCoins[i]) {
+			return false
+		}
+	}
+	if len(this.RequestedOnfts) != len(that1.RequestedOnfts) {
+		return false
+	}
+	for i := range this.RequestedOnfts {
+		if !this.RequestedOnfts[i].Equal(&that1.RequestedOnfts[i]) {
+			return false
+		}
+	}
+	if len(this.RequestedCoins) != len(that1.RequestedCoins) {
+		return false
+	}
+	for i := range this.RequestedCoins {
+		if !this.RequestedCoins[i].Equal(&that1.RequestedCoins[i]) {
+			return false
+		}
+	}
+	if this.Counterparty != that1.Counterparty {
+		return false
+	}
+	if !this.Expiry.Equal(that1.Expiry) {
+		return false
+	}
+	return true
+}
+func (m *ONFTRef) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ONFTRef) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ONFTRef) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.OnftId) > 0 {
+		i -= len(m.OnftId)
+		copy(dAtA[i:], m.OnftId)
+		i = encodeVarintSwap(dAtA, i, uint64(len(m.OnftId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DenomId) > 0 {
+		i -= len(m.DenomId)
+		copy(dAtA[i:], m.DenomId)
+		i = encodeVarintSwap(dAtA, i, uint64(len(m.DenomId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Swap) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Swap) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Swap) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Expiry, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Expiry):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintSwap(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x42
+	if len(m.Counterparty) > 0 {
+		i -= len(m.Counterparty)
+		copy(dAtA[i:], m.Counterparty)
+		i = encodeVarintSwap(dAtA, i, uint64(len(m.Counterparty)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.RequestedCoins) > 0 {
+		for iNdEx := len(m.RequestedCoins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RequestedCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSwap(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.RequestedOnfts) > 0 {
+		for iNdEx := len(m.RequestedOnfts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RequestedOnfts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSwap(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.OfferedCoins) > 0 {
+		for iNdEx := len(m.OfferedCoins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OfferedCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSwap(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.OfferedOnfts) > 0 {
+		for iNdEx := len(m.OfferedOnfts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OfferedOnfts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSwap(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintSwap(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintSwap(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintSwap(dAtA []byte, offset int, v uint64) int {
+	offset -= sovSwap(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ONFTRef) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DenomId)
+	if l > 0 {
+		n += 1 + l + sovSwap(uint64(l))
+	}
+	l = len(m.OnftId)
+	if l > 0 {
+		n += 1 + l + sovSwap(uint64(l))
+	}
+	return n
+}
+
+func (m *Swap) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovSwap(uint64(m.Id))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovSwap(uint64(l))
+	}
+	if len(m.OfferedOnfts) > 0 {
+		for _, e := range m.OfferedOnfts {
+			l = e.Size()
+			n += 1 + l + sovSwap(uint64(l))
+		}
+	}
+	if len(m.OfferedCoins) > 0 {
+		for _, e := range m.OfferedCoins {
+			l = e.Size()
+			n += 1 + l + sovSwap(uint64(l))
+		}
+	}
+	if len(m.RequestedOnfts) > 0 {
+		for _, e := range m.RequestedOnfts {
+			l = e.Size()
+			n += 1 + l + sovSwap(uint64(l))
+		}
+	}
+	if len(m.RequestedCoins) > 0 {
+		for _, e := range m.RequestedCoins {
+			l = e.Size()
+			n += 1 + l + sovSwap(uint64(l))
+		}
+	}
+	l = len(m.Counterparty)
+	if l > 0 {
+		n += 1 + l + sovSwap(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Expiry)
+	n += 1 + l + sovSwap(uint64(l))
+	return n
+}
+
+func sovSwap(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozSwap(x uint64) (n int) {
+	return sovSwap(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ONFTRef) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSwap
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ONFTRef: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ONFTRef: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSwap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OnftId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSwap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OnftId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSwap(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSwap
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Swap) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSwap
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Swap: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Swap: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSwap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OfferedOnfts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSwap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OfferedOnfts = append(m.OfferedOnfts, ONFTRef{})
+			if err := m.OfferedOnfts[len(m.OfferedOnfts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OfferedCoins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSwap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OfferedCoins = append(m.OfferedCoins, types.Coin{})
+			if err := m.OfferedCoins[len(m.OfferedCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestedOnfts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSwap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RequestedOnfts = append(m.RequestedOnfts, ONFTRef{})
+			if err := m.RequestedOnfts[len(m.RequestedOnfts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestedCoins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSwap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RequestedCoins = append(m.RequestedCoins, types.Coin{})
+			if err := m.RequestedCoins[len(m.RequestedCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Counterparty", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSwap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Counterparty = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiry", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSwap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Expiry, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSwap(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSwap
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipSwap(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowSwap
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthSwap
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupSwap
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthSwap
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthSwap        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowSwap          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupSwap = fmt.Errorf("proto: unexpected end of group")
+)
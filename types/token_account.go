@@ -0,0 +1,30 @@
+package types
+
+import (
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	sdktx "github.com/cosmos/cosmos-sdk/types/tx"
+)
+
+// TokenAccountAddress returns the address of the token account of an oNFT,
+// derived from the module name, denom id and oNFT id.
+func TokenAccountAddress(denomID, onftID string) sdk.AccAddress {
+	return address.Module(ModuleName, []byte(denomID), []byte(onftID))
+}
+
+// GetMessages returns the cached msgs of a MsgExecuteFromTokenAccount
+func (msg MsgExecuteFromTokenAccount) GetMessages() ([]sdk.Msg, error) {
+	return sdktx.GetMsgs(msg.Msgs, "MsgExecuteFromTokenAccount")
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (msg MsgExecuteFromTokenAccount) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	for _, x := range msg.Msgs {
+		var m sdk.Msg
+		if err := unpacker.UnpackAny(x, &m); err != nil {
+			return err
+		}
+	}
+	return nil
+}
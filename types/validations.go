@@ -101,7 +101,6 @@ func ValidateMediaURI(uri string) error {
 			ErrInvalidURI,
 			"invalid uri %s, media uri should not be empty",
 			uri,
-			0,
 		)
 	}
 	if len(uri) > MaxURILen {
@@ -115,6 +114,19 @@ func ValidateMediaURI(uri string) error {
 	return nil
 }
 
+func ValidateMetadata(metadata Metadata) error {
+	if err := ValidateName(metadata.Name); err != nil {
+		return err
+	}
+	if err := ValidateDescription(metadata.Description); err != nil {
+		return err
+	}
+	if err := ValidateMediaURI(metadata.MediaURI); err != nil {
+		return err
+	}
+	return ValidateURI(metadata.PreviewURI)
+}
+
 func ValidateCreationFee(fee sdk.Coin) error {
 	if !fee.IsValid() || fee.IsNil() {
 		return errorsmod.Wrapf(